@@ -0,0 +1,141 @@
+package recurly
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxCaptureSize is the number of bytes of the body a ResponseCapture
+// keeps when its MaxBodySize is 0
+const DefaultMaxCaptureSize = 64 * 1024
+
+// redacted replaces the values removed by a RedactionPolicy
+const redacted = "[REDACTED]"
+
+// ResponseCapture configures what a client keeps of every response in its
+// ResponseMetadata, for auditing and debugging. Set it on Client.Capture.
+type ResponseCapture struct {
+	// Body keeps the response body in ResponseMetadata.Body
+	Body bool
+	// Headers keeps every response header in ResponseMetadata.Header
+	Headers bool
+	// MaxBodySize is the number of bytes of the body kept. Longer bodies are
+	// cut and ResponseMetadata.BodyTruncated is set. It defaults to
+	// DefaultMaxCaptureSize, and a negative size keeps whole bodies.
+	MaxBodySize int
+	// Redaction is applied to the captured headers and body. It defaults to
+	// DefaultRedactionPolicy. Set it to an empty &RedactionPolicy{} to
+	// capture responses as they were received.
+	Redaction *RedactionPolicy
+}
+
+// RedactionPolicy removes sensitive values from captured responses
+type RedactionPolicy struct {
+	// Headers are the headers whose values are replaced, matched
+	// case-insensitively
+	Headers []string
+	// Fields are the fields of JSON bodies whose values are replaced, at any
+	// depth. Redacted bodies are encoded again, so their fields are sorted
+	// and their whitespace removed.
+	Fields []string
+}
+
+// DefaultRedactionPolicy redacts cookies, and the fields holding payment
+// details or tokens. It is applied to the responses of a ResponseCapture
+// without a Redaction.
+var DefaultRedactionPolicy = RedactionPolicy{
+	Headers: []string{"Authorization", "Set-Cookie"},
+	Fields: []string{
+		"account_number", "cvv", "iban", "routing_number", "token_id",
+		"verification_value",
+	},
+}
+
+// capture records the headers and body of a response according to the
+// capture settings of the client
+func (capture *ResponseCapture) capture(meta *ResponseMetadata, res *http.Response, body []byte) {
+	policy := capture.Redaction
+	if policy == nil {
+		policy = &DefaultRedactionPolicy
+	}
+	if capture.Headers {
+		meta.Header = policy.redactHeader(res.Header)
+	}
+	if capture.Body && len(body) > 0 {
+		if strings.HasPrefix(res.Header.Get("Content-type"), "application/json") {
+			body = policy.redactBody(body)
+		}
+		max := capture.MaxBodySize
+		if max == 0 {
+			max = DefaultMaxCaptureSize
+		}
+		if max > 0 && len(body) > max {
+			body = body[:max]
+			meta.BodyTruncated = true
+		}
+		meta.Body = append([]byte(nil), body...)
+	}
+}
+
+// redactHeader returns a copy of the header without the redacted values
+func (policy RedactionPolicy) redactHeader(header http.Header) http.Header {
+	copied := make(http.Header, len(header))
+	for key, values := range header {
+		copied[key] = append([]string(nil), values...)
+	}
+	for _, key := range policy.Headers {
+		key = http.CanonicalHeaderKey(key)
+		for i := range copied[key] {
+			copied[key][i] = redacted
+		}
+	}
+	return copied
+}
+
+// redactBody returns the JSON body without the redacted values. Bodies with
+// nothing to redact, or which aren't valid JSON, are returned as they are.
+func (policy RedactionPolicy) redactBody(body []byte) []byte {
+	if len(policy.Fields) == 0 {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	if !policy.redactValue(value) {
+		return body
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return data
+}
+
+// redactValue replaces the values of the redacted fields of a decoded JSON
+// value, and reports whether there were any
+func (policy RedactionPolicy) redactValue(value interface{}) bool {
+	found := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if containsString(policy.Fields, key) && field != nil {
+				v[key] = redacted
+				found = true
+			} else if policy.redactValue(field) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if policy.redactValue(item) {
+				found = true
+			}
+		}
+	}
+	return found
+}
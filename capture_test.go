@@ -0,0 +1,90 @@
+package recurly
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func captureScenario(t *T, code int, body string) *Client {
+	scenario := &Scenario{
+		T:             t,
+		AssertRequest: func(req *http.Request) {},
+		MakeResponse: func(req *http.Request) *http.Response {
+			res := mockResponse(req, code, String(body))
+			res.Header.Set("Set-Cookie", "session=secret")
+			res.Header.Set("X-Custom", "kept")
+			return res
+		},
+	}
+	return scenario.MockHTTPClient()
+}
+
+func TestResponsesAreNotCapturedByDefault(test *testing.T) {
+	t := &T{test}
+	client := captureScenario(t, 200, `{"id": "abcd1234"}`)
+
+	resource, err := client.GetResource("abcd1234")
+	t.Assert(err, nil, "Error not expected")
+	meta := resource.GetResponse()
+	t.Assert(meta.Body == nil, true, "ResponseMetadata.Body == nil")
+	t.Assert(meta.Header == nil, true, "ResponseMetadata.Header == nil")
+	t.Assert(meta.SentAt.IsZero(), false, "ResponseMetadata.SentAt.IsZero()")
+}
+
+func TestCaptureBodyAndHeaders(test *testing.T) {
+	t := &T{test}
+	client := captureScenario(t, 200, `{"id": "abcd1234"}`)
+	client.Capture = &ResponseCapture{Body: true, Headers: true}
+
+	resource, err := client.GetResource("abcd1234")
+	t.Assert(err, nil, "Error not expected")
+	meta := resource.GetResponse()
+	t.Assert(string(meta.Body), `{"id": "abcd1234"}`, "ResponseMetadata.Body")
+	t.Assert(meta.BodyTruncated, false, "ResponseMetadata.BodyTruncated")
+	t.Assert(meta.Header.Get("X-Custom"), "kept", "X-Custom")
+	t.Assert(meta.Header.Get("Set-Cookie"), "[REDACTED]", "Set-Cookie")
+
+	// an empty policy captures responses as they were received
+	client.Capture.Redaction = &RedactionPolicy{}
+	resource, err = client.GetResource("abcd1234")
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(resource.GetResponse().Header.Get("Set-Cookie"), "session=secret", "Set-Cookie")
+}
+
+func TestCaptureRedactsAndTruncates(test *testing.T) {
+	t := &T{test}
+	body := `{"id": "abcd1234", "number": "1001", "payment_method": {"account_number": "123456789", "last_four": "6789"}}`
+	client := captureScenario(t, 200, body)
+	client.Capture = &ResponseCapture{Body: true, Headers: true}
+
+	resource, err := client.GetResource("abcd1234")
+	t.Assert(err, nil, "Error not expected")
+	meta := resource.GetResponse()
+	t.Assert(string(meta.Body), `{"id":"abcd1234","number":"1001","payment_method":{"account_number":"[REDACTED]","last_four":"6789"}}`, "ResponseMetadata.Body")
+	t.Assert(meta.Header.Get("Set-Cookie"), "[REDACTED]", "Set-Cookie")
+	t.Assert(meta.Header.Get("X-Custom"), "kept", "X-Custom")
+
+	client.Capture.MaxBodySize = 10
+	resource, err = client.GetResource("abcd1234")
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(string(resource.GetResponse().Body), `{"id":"abc`, "ResponseMetadata.Body")
+	t.Assert(resource.GetResponse().BodyTruncated, true, "ResponseMetadata.BodyTruncated")
+}
+
+func TestCaptureOnErrors(test *testing.T) {
+	t := &T{test}
+	body := `{"error": {"type": "not_found", "message": "not found"}}`
+	client := captureScenario(t, 404, body)
+	client.Capture = &ResponseCapture{Body: true, Headers: true}
+
+	_, err := client.GetResource("idontexist")
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %v", err)
+	}
+	meta := e.GetResponse()
+	t.Assert(meta.StatusCode, 404, "ResponseMetadata.StatusCode")
+	t.Assert(string(meta.Body), body, "ResponseMetadata.Body")
+	t.Assert(strings.HasPrefix(meta.Header.Get("Content-Type"), "application/json"), true, "Content-Type")
+}
@@ -0,0 +1,134 @@
+package recurly
+
+import "time"
+
+// errorClass describes how an error is handled
+type errorClass struct {
+	// retryable errors are fixed by sending the same request again, after
+	// the delay
+	retryable bool
+	// temporary errors are caused by a condition which clears by itself,
+	// though the request may have to be sent later or differently
+	temporary bool
+	// userFacing errors have messages meant for customers, who can fix the
+	// error, such as by correcting a field or using another card
+	userFacing bool
+	// delay is the suggested wait before a retryable request is sent again
+	delay time.Duration
+}
+
+// errorClasses classifies the errors by type. Types which aren't listed are
+// neither retryable, temporary nor user facing.
+var errorClasses = map[ErrorType]errorClass{
+	// The request was rejected before being processed, so it can be sent
+	// again as it is. Rate limited requests wait for the limit to reset
+	// when the response says when it does.
+	ErrorTypeRateLimited:        {retryable: true, temporary: true, delay: 10 * time.Second},
+	ErrorTypeSimulaneousRequest: {retryable: true, temporary: true, delay: time.Second},
+	ErrorTypeServiceUnavailable: {retryable: true, temporary: true, delay: 5 * time.Second},
+
+	// The request may have been processed, so sending it again is only safe
+	// with the same idempotency key, and is left to the caller.
+	ErrorTypeBadGateway:     {temporary: true},
+	ErrorTypeTimeout:        {temporary: true},
+	ErrorTypeInternalServer: {temporary: true},
+
+	// The request has to be changed, by the customer who made it.
+	ErrorTypeValidation:   {userFacing: true},
+	ErrorTypeInvalidToken: {userFacing: true},
+	// Transaction errors are classified by their category, see
+	// transactionErrorClasses. This applies when there are no details.
+	ErrorTypeTransaction: {userFacing: true},
+}
+
+// transactionErrorClasses classifies the errors of declined transactions by
+// category
+var transactionErrorClasses = map[TransactionErrorCategory]errorClass{
+	// Declined for a reason which may clear, such as insufficient funds.
+	// The customer can try again later or use another payment method.
+	TransactionErrorCategorySoft: {temporary: true, userFacing: true},
+	// Declined for good, such as for a closed account. The customer has to
+	// use another payment method.
+	TransactionErrorCategoryHard: {userFacing: true},
+	// Declined as suspected fraud. The reasons shouldn't be shown to the
+	// customer.
+	TransactionErrorCategoryFraud: {},
+	// The gateway couldn't be reached, though it may have charged the
+	// payment method. Like a bad gateway, the transaction is only attempted
+	// again by the caller, with the same idempotency key.
+	TransactionErrorCategoryCommunication: {temporary: true},
+}
+
+// class returns the classification of the error
+func (e *Error) class() errorClass {
+	if e.Type == ErrorTypeTransaction && e.TransactionError != nil {
+		if class, ok := transactionErrorClasses[e.TransactionError.Category]; ok {
+			return class
+		}
+	}
+	return errorClasses[e.Type]
+}
+
+// IsRetryable reports whether sending the same request again, after
+// RetryDelay, can succeed. It is the case for rate limited and simultaneous
+// requests, and unavailable service, which are rejected before being
+// processed. Client.Retry retries these errors.
+func (e *Error) IsRetryable() bool {
+	return e.class().retryable
+}
+
+// IsTemporary reports whether the error is caused by a condition which clears
+// by itself, such as an unavailable service or a soft decline. Unlike
+// retryable errors, the request may have been processed, or may have to be
+// sent later or differently.
+func (e *Error) IsTemporary() bool {
+	return e.class().temporary
+}
+
+// IsUserFacing reports whether the message of the error is meant for the
+// customer who made the request, such as a validation error or a declined
+// card. Fraud declines aren't user facing.
+func (e *Error) IsUserFacing() bool {
+	return e.class().userFacing
+}
+
+// RetryDelay returns the suggested wait before sending a retryable request
+// again, or 0 if the error isn't retryable. Rate limited requests wait until
+// the rate limit resets when the response says when it does.
+func (e *Error) RetryDelay() time.Duration {
+	class := e.class()
+	if !class.retryable {
+		return 0
+	}
+	if e.Type == ErrorTypeRateLimited && e.recurlyResponse != nil {
+		if reset := e.recurlyResponse.RateLimit.ResetDate(); reset != nil {
+			if delay := time.Until(*reset); delay > 0 {
+				return delay
+			}
+		}
+	}
+	return class.delay
+}
+
+// IsSoft reports whether the transaction was declined for a reason which may
+// clear, such as insufficient funds
+func (e *TransactionError) IsSoft() bool {
+	return e.Category == TransactionErrorCategorySoft
+}
+
+// IsHard reports whether the transaction was declined for good, so another
+// payment method is needed
+func (e *TransactionError) IsHard() bool {
+	return e.Category == TransactionErrorCategoryHard
+}
+
+// IsFraud reports whether the transaction was declined as suspected fraud
+func (e *TransactionError) IsFraud() bool {
+	return e.Category == TransactionErrorCategoryFraud
+}
+
+// IsCommunication reports whether the transaction failed because the gateway
+// couldn't be reached
+func (e *TransactionError) IsCommunication() bool {
+	return e.Category == TransactionErrorCategoryCommunication
+}
@@ -0,0 +1,67 @@
+package recurly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestErrorClassification(test *testing.T) {
+	t := &T{test}
+	transaction := func(category TransactionErrorCategory) *Error {
+		return &Error{Type: ErrorTypeTransaction, TransactionError: &TransactionError{Category: category}}
+	}
+	tests := []struct {
+		name       string
+		err        *Error
+		retryable  bool
+		temporary  bool
+		userFacing bool
+		delay      time.Duration
+	}{
+		{"rate limited", &Error{Type: ErrorTypeRateLimited}, true, true, false, 10 * time.Second},
+		{"simultaneous request", &Error{Type: ErrorTypeSimulaneousRequest}, true, true, false, time.Second},
+		{"service unavailable", &Error{Type: ErrorTypeServiceUnavailable}, true, true, false, 5 * time.Second},
+		{"timeout", &Error{Type: ErrorTypeTimeout}, false, true, false, 0},
+		{"internal server", &Error{Type: ErrorTypeInternalServer}, false, true, false, 0},
+		{"validation", &Error{Type: ErrorTypeValidation}, false, false, true, 0},
+		{"not found", &Error{Type: ErrorTypeNotFound}, false, false, false, 0},
+		{"unknown type", &Error{Type: ErrorType("new_type")}, false, false, false, 0},
+		{"soft decline", transaction(TransactionErrorCategorySoft), false, true, true, 0},
+		{"hard decline", transaction(TransactionErrorCategoryHard), false, false, true, 0},
+		{"fraud", transaction(TransactionErrorCategoryFraud), false, false, false, 0},
+		{"communication", transaction(TransactionErrorCategoryCommunication), false, true, false, 0},
+		{"transaction without details", &Error{Type: ErrorTypeTransaction}, false, false, true, 0},
+	}
+	for _, tt := range tests {
+		t.Assert(tt.err.IsRetryable(), tt.retryable, tt.name+" IsRetryable()")
+		t.Assert(tt.err.IsTemporary(), tt.temporary, tt.name+" IsTemporary()")
+		t.Assert(tt.err.IsUserFacing(), tt.userFacing, tt.name+" IsUserFacing()")
+		t.Assert(tt.err.RetryDelay(), tt.delay, tt.name+" RetryDelay()")
+	}
+}
+
+func TestRetryDelayUntilRateLimitResets(test *testing.T) {
+	t := &T{test}
+	reset := time.Now().Add(time.Minute)
+	e := &Error{Type: ErrorTypeRateLimited}
+	e.setResponse(&ResponseMetadata{RateLimit: RateLimit{Limit: 2000, resetTimestamp: reset.Unix()}})
+
+	delay := e.RetryDelay()
+	t.Assert(delay > 50*time.Second && delay <= time.Minute, true, "RetryDelay() until the reset")
+}
+
+func TestTransactionErrorCategories(test *testing.T) {
+	t := &T{test}
+	for _, category := range []TransactionErrorCategory{
+		TransactionErrorCategorySoft,
+		TransactionErrorCategoryHard,
+		TransactionErrorCategoryFraud,
+		TransactionErrorCategoryCommunication,
+	} {
+		e := &TransactionError{Category: category}
+		t.Assert(e.IsSoft(), category == TransactionErrorCategorySoft, string(category)+" IsSoft()")
+		t.Assert(e.IsHard(), category == TransactionErrorCategoryHard, string(category)+" IsHard()")
+		t.Assert(e.IsFraud(), category == TransactionErrorCategoryFraud, string(category)+" IsFraud()")
+		t.Assert(e.IsCommunication(), category == TransactionErrorCategoryCommunication, string(category)+" IsCommunication()")
+	}
+}
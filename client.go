@@ -67,6 +67,25 @@ type Client struct {
 
 	Log        *Logger
 	HTTPClient *http.Client
+
+	// PrefetchDepth is the number of pages lists fetch in the background,
+	// ahead of the page being read. Zero, the default, fetches every page
+	// when it is needed. Lists which are left before their last page
+	// must be closed with Close to stop the background fetching at once.
+	PrefetchDepth int
+
+	// ValidateRequests checks request bodies with their Validate method
+	// before they are sent, so invalid bodies fail without a round trip.
+	ValidateRequests bool
+
+	// Capture keeps the raw body and every header of responses in their
+	// ResponseMetadata, available from GetResponse on resources and errors.
+	// Nothing is captured when it is nil.
+	Capture *ResponseCapture
+
+	// Retry sends requests again when they fail with a retryable error, see
+	// Error.IsRetryable. Requests are sent once when it is nil.
+	Retry *RetryPolicy
 }
 
 // NewClient returns a new API Client using the given APIKey
@@ -115,19 +134,36 @@ func (c *Client) Call(method string, path string, genericParams GenericParams, v
 		path = fmt.Sprintf("%s%s", c.baseURL, path)
 	}
 
+	if err := validateParams(genericParams); err != nil {
+		return err
+	}
 	path = BuildUrl(path, genericParams)
 
 	var params *Params
 	if genericParams != nil && !reflect.ValueOf(genericParams).IsNil() { // test if the interface is nil
 		params = genericParams.toParams()
 	}
-
-	req, err := c.NewRequest(method, path, params)
-	if err != nil {
-		return err
+	if c.ValidateRequests {
+		if err := validateRequest(params); err != nil {
+			return err
+		}
 	}
 
-	return c.Do(req, v)
+	for attempt := 1; ; attempt++ {
+		req, err := c.NewRequest(method, path, params)
+		if err != nil {
+			return err
+		}
+		err = c.Do(req, v)
+		delay, retry := c.Retry.retryDelay(attempt, err)
+		if !retry {
+			return err
+		}
+		c.Log.Warnf("Retrying %s %s in %s: %v", method, req.URL.Path, delay, err)
+		if err := waitRetry(params, delay); err != nil {
+			return err
+		}
+	}
 }
 
 // Append URL parameters
@@ -212,7 +248,10 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 
 	if err != nil {
 		c.Log.Errorf("Request failed: %v", err)
-		return err
+		return &RequestError{
+			Request: RequestMetadata{Method: req.Method, URL: req.URL},
+			Err:     err,
+		}
 	}
 	c.Log.Debugf("Response: %d, %5.3f sec", res.StatusCode, requestTime.Seconds())
 	defer res.Body.Close()
@@ -231,7 +270,7 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 		// in the gzipReader. For any other error, we want to return early.
 		if err != nil {
 			if err != io.EOF {
-				return err
+				return responseError(res, startTime, err)
 			}
 		} else {
 			bodyReader = gzipReader
@@ -241,10 +280,15 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 	body, err := ioutil.ReadAll(bodyReader)
 	if err != nil {
 		c.Log.Errorf("Cannot read response: %s", err)
-		return err
+		return responseError(res, startTime, err)
 	}
 
 	meta := parseResponseMetadata(res)
+	meta.SentAt = startTime
+	meta.Duration = time.Since(startTime)
+	if c.Capture != nil {
+		c.Capture.capture(meta, res, body)
+	}
 	v.(Resource).setResponse(meta)
 
 	if c.Log.IsLevel(LevelDebug) {
@@ -265,15 +309,35 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 
 	if successfulStatus(res.StatusCode) {
 		if len(body) > 0 {
+			// Binary responses, such as invoice PDFs, are not JSON encoded
+			if file, ok := v.(*BinaryFile); ok {
+				file.Data = string(body)
+				return nil
+			}
 			if err = json.Unmarshal(body, v); err != nil {
 				c.Log.Errorf("Failed to deserialize JSON:\n%s", body)
-				return err
+				return &RequestError{Request: meta.Request, Response: meta, Err: err}
+			}
+			if resource, ok := v.(clientAttacher); ok {
+				resource.attachClient(c)
 			}
 		}
 		return nil
 	}
 
-	return parseResponseToError(res, body)
+	err = parseResponseToError(res, body)
+	if e, ok := err.(*Error); ok {
+		e.setResponse(meta)
+	}
+	return err
+}
+
+// responseError returns the error of a response which couldn't be read
+func responseError(res *http.Response, startTime time.Time, err error) error {
+	meta := parseResponseMetadata(res)
+	meta.SentAt = startTime
+	meta.Duration = time.Since(startTime)
+	return &RequestError{Request: meta.Request, Response: meta, Err: err}
 }
 
 func successfulStatus(statusCode int) bool {
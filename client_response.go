@@ -33,6 +33,18 @@ type ResponseMetadata struct {
 	TotalRecords *int64
 	// Request is the metadata describing the request for this response
 	Request RequestMetadata
+	// SentAt is when the request was sent
+	SentAt time.Time
+	// Duration is the time from sending the request to reading the whole response
+	Duration time.Duration
+
+	// Header contains every response header, if the client captures them.
+	// See Client.Capture.
+	Header http.Header
+	// Body is the raw response body, if the client captures it
+	Body []byte
+	// BodyTruncated is set when Body was cut to the size limit of the capture
+	BodyTruncated bool
 }
 
 func parseIntPtr(str string) *int64 {
@@ -0,0 +1,79 @@
+package recurly
+
+import "net/http"
+
+// cloneBytes returns a copy of data, or nil
+func cloneBytes(data []byte) []byte {
+	if data == nil {
+		return nil
+	}
+	return append(make([]byte, 0, len(data)), data...)
+}
+
+// cloneJSONObject returns a deep copy of a decoded JSON object
+func cloneJSONObject(object map[string]interface{}) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+	clone := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		clone[key] = cloneJSONValue(value)
+	}
+	return clone
+}
+
+// cloneJSONValue returns a deep copy of a decoded JSON value. Strings,
+// numbers and booleans are immutable and returned as they are.
+func cloneJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return cloneJSONObject(v)
+	case []interface{}:
+		if v == nil {
+			return v
+		}
+		clone := make([]interface{}, len(v))
+		for i, item := range v {
+			clone[i] = cloneJSONValue(item)
+		}
+		return clone
+	}
+	return value
+}
+
+// cloneHeader returns a copy of the header, or nil
+func cloneHeader(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	clone := make(http.Header, len(header))
+	for key, values := range header {
+		clone[key] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+// clone returns a copy of the params with its own header. The context and
+// data are shared.
+func (params Params) clone() Params {
+	params.Header = cloneHeader(params.Header)
+	return params
+}
+
+// clone returns a copy of the state of a list, with its own params, chunks
+// and copy of the JSON of an embedded list. Pages fetched in the background
+// aren't shared: the copy fetches its own from its next page.
+func (p pager) clone() pager {
+	p.embedded = cloneBytes(p.embedded)
+	if p.params != nil {
+		params := p.params.clone()
+		p.params = &params
+	}
+	if p.chunks != nil {
+		p.chunks = append([]string(nil), p.chunks...)
+	}
+	p.chunkFetch = nil
+	p.prefetch = nil
+	p.prefetchDone = false
+	return p
+}
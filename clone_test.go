@@ -0,0 +1,161 @@
+package recurly
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// immutableTypes are shared by clones: their values are never changed in place
+var immutableTypes = map[reflect.Type]bool{
+	reflect.TypeOf(time.Time{}): true,
+	reflect.TypeOf(Money{}):     true,
+}
+
+// assertNoAliasing fails if a and b, two values of the same type, share a
+// pointer, slice or map. The ResponseMetadata, the client of a list and the
+// Null pointers may be shared.
+func assertNoAliasing(t *T, path string, a reflect.Value, b reflect.Value) {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() || isNullValue(a) {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			t.Errorf("%s: pointer shared", path)
+			return
+		}
+		assertNoAliasing(t, path, a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Len() > 0 && b.Len() > 0 && a.Pointer() == b.Pointer() {
+			t.Errorf("%s: slice shared", path)
+			return
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			assertNoAliasing(t, path+"[]", a.Index(i), b.Index(i))
+		}
+	case reflect.Map:
+		if !a.IsNil() && !b.IsNil() && a.Pointer() == b.Pointer() {
+			t.Errorf("%s: map shared", path)
+			return
+		}
+		for _, key := range a.MapKeys() {
+			if value := b.MapIndex(key); value.IsValid() {
+				assertNoAliasing(t, path+"[]", a.MapIndex(key), value)
+			}
+		}
+	case reflect.Interface:
+		if !a.IsNil() && !b.IsNil() {
+			assertNoAliasing(t, path, a.Elem(), b.Elem())
+		}
+	case reflect.Struct:
+		if immutableTypes[a.Type()] {
+			return
+		}
+		for i := 0; i < a.NumField(); i++ {
+			name := a.Type().Field(i).Name
+			if name != "recurlyResponse" && name != "client" {
+				assertNoAliasing(t, path+"."+name, a.Field(i), b.Field(i))
+			}
+		}
+	}
+}
+
+func TestCloneResourceExamples(test *testing.T) {
+	t := &T{test}
+	for _, example := range resourceExamples {
+		for _, document := range example.documents {
+			resource := example.new()
+			if err := json.Unmarshal([]byte(document), resource); err != nil {
+				t.Fatalf("%s: Error decoding %s: %v", example.name, document, err)
+			}
+			original := reflect.ValueOf(resource)
+			clone := original.MethodByName("Clone").Call(nil)[0]
+			if !reflect.DeepEqual(clone.Interface(), resource) {
+				t.Errorf("%s: Clone() differs from %s", example.name, document)
+			}
+			assertNoAliasing(t, example.name, original, clone)
+		}
+	}
+}
+
+func TestCloneKeepsResponseMetadata(test *testing.T) {
+	t := &T{test}
+	account := &Account{Code: "acct"}
+	account.setResponse(&ResponseMetadata{StatusCode: 200})
+
+	clone := account.Clone()
+	t.Assert(clone.GetResponse(), account.GetResponse(), "Clone().GetResponse()")
+	clone.Code = "other"
+	t.Assert(account.Code, "acct", "Account.Code")
+
+	var missing *Account
+	t.Assert(missing.Clone() == nil, true, "Clone() of nil")
+}
+
+func TestCloneRequest(test *testing.T) {
+	t := &T{test}
+	header := http.Header{}
+	header.Set("X-Trace", "1")
+	request := &SubscriptionCreate{
+		Params:   Params{Header: header},
+		PlanCode: String("gold"),
+		Account: &AccountCreate{
+			Code:         String("acct"),
+			CustomFields: NewCustomFields(map[string]string{"tier": "gold"}),
+		},
+		AddOns:     []SubscriptionAddOnCreate{{Code: String("extra"), Quantity: Int(2)}},
+		CouponCode: NullString(),
+		UnitAmount: NewAmount("10"),
+	}
+
+	clone := request.Clone()
+	t.Assert(reflect.DeepEqual(clone, request), true, "Clone() equal to the request")
+	assertNoAliasing(t, "SubscriptionCreate", reflect.ValueOf(request), reflect.ValueOf(clone))
+	t.Assert(IsNull(clone.CouponCode), true, "IsNull(Clone().CouponCode)")
+
+	clone.Account.CustomFields.Set("tier", "silver")
+	*clone.AddOns[0].Quantity = 3
+	clone.Header.Set("X-Trace", "2")
+	value, _ := request.Account.CustomFields.Get("tier")
+	t.Assert(value, "gold", "CustomFields.Get(tier)")
+	t.Assert(*request.AddOns[0].Quantity, 2, "AddOns[0].Quantity")
+	t.Assert(request.Header.Get("X-Trace"), "1", "Header X-Trace")
+}
+
+func TestCloneListWhilePrefetching(test *testing.T) {
+	t := &T{test}
+	pages := map[string]string{
+		"/invoices/inv1":                     `{"id":"inv1","line_items":{"object":"list","has_more":true,"next":"/invoices/inv1/line_items?cursor=2","data":[{"id":"a"},{"id":"b"}]}}`,
+		"/invoices/inv1/line_items?cursor=2": `{"object":"list","has_more":true,"next":"/invoices/inv1/line_items?cursor=3","data":[{"id":"c"}]}`,
+		"/invoices/inv1/line_items?cursor=3": `{"object":"list","has_more":false,"next":null,"data":[{"id":"d"}]}`,
+	}
+	client := newClient("APIKEY", &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		return mockResponse(req, 200, String(pages[req.URL.RequestURI()]))
+	})})
+	client.Log = NewLogger(LevelError)
+	client.PrefetchDepth = 1
+
+	invoice, err := client.GetInvoice("inv1")
+	t.Assert(err, nil, "Error not expected")
+	defer invoice.LineItems.Close()
+	for i := 0; i < 3; i++ {
+		t.Assert(invoice.LineItems.Next(), true, "LineItems.Next()")
+	}
+	t.Assert(invoice.LineItems.Item().Id, "c", "LineItems.Item().Id")
+	t.Assert(invoice.LineItems.prefetch != nil, true, "LineItems prefetching")
+
+	clone := invoice.Clone()
+	defer clone.LineItems.Close()
+	assertNoAliasing(t, "Invoice", reflect.ValueOf(invoice), reflect.ValueOf(clone))
+	t.Assert(clone.LineItems.Item().Id, "c", "Clone().LineItems.Item().Id")
+
+	cloneIDs := lineItemIDs(t, &clone.LineItems)
+	ids := lineItemIDs(t, &invoice.LineItems)
+	t.Assert(len(cloneIDs), 1, "Number of line items left in the clone")
+	t.Assert(cloneIDs[0], "d", "Last line item of the clone")
+	t.Assert(len(ids), 1, "Number of line items left")
+	t.Assert(ids[0], "d", "Last line item")
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// clones sets the statements of the Clone methods of the resources and
+// requests, which copy the fields a plain copy of the struct would share
+func (g *generator) clones() {
+	structs := map[string]bool{}
+	for _, resource := range g.resources {
+		structs[resource.Name] = true
+	}
+	for _, request := range g.requests {
+		structs[request.Name] = true
+	}
+	// the hand-written lists are slices of generated structs
+	sliceOf := map[string]string{}
+	for name, lists := range handWrittenLists {
+		items := g.doc.Schema(name).Items
+		sliceOf[lists[0]] = schemaName(items)
+		sliceOf[lists[1]] = g.requestName(items)
+	}
+
+	for _, resource := range g.resources {
+		for _, field := range resource.Fields {
+			if statement := cloneStatement("resource", field, structs, sliceOf); statement != "" {
+				resource.Clones = append(resource.Clones, statement)
+			}
+		}
+	}
+	for _, request := range g.requests {
+		for _, field := range request.Fields {
+			if statement := cloneStatement("attr", field, structs, sliceOf); statement != "" {
+				request.Clones = append(request.Clones, statement)
+			}
+		}
+	}
+}
+
+// cloneStatement returns the statement which copies a field of receiver into
+// clone, or an empty string if copying the struct already copies the field.
+// Amounts and timestamps are immutable values, so they are copied as they
+// are, and so are the Null pointers of requests.
+func cloneStatement(receiver string, field *Field, structs map[string]bool, sliceOf map[string]string) string {
+	name := receiver + "." + field.Name
+	goType := field.Type
+	switch {
+	case isEmbeddedList(goType):
+		return fmt.Sprintf("clone.%s = %s.clone()", field.Name, name)
+	case structs[goType]:
+		return fmt.Sprintf("clone.%s = *%s.Clone()", field.Name, name)
+	case strings.HasPrefix(goType, "*") && structs[goType[1:]]:
+		return fmt.Sprintf("clone.%s = %s.Clone()", field.Name, name)
+	case strings.HasPrefix(goType, "*"):
+		return fmt.Sprintf(`if %s {
+			value := *%s
+			clone.%s = &value
+		}`, notNull(name, goType), name, field.Name)
+	case goType == "map[string]interface{}":
+		return fmt.Sprintf("clone.%s = cloneJSONObject(%s)", field.Name, name)
+	}
+
+	element := strings.TrimPrefix(goType, "[]")
+	if item, ok := sliceOf[goType]; ok {
+		element = item
+	} else if element == goType {
+		return ""
+	}
+	var copyItem string
+	switch {
+	case structs[element]:
+		copyItem = fmt.Sprintf("clone.%s[i] = *%s[i].Clone()", field.Name, name)
+	case element == "map[string]interface{}":
+		copyItem = fmt.Sprintf("clone.%s[i] = cloneJSONObject(%s[i])", field.Name, name)
+	default:
+		// slices of values are copied at once, keeping empty slices empty
+		return fmt.Sprintf(`if %s != nil {
+			clone.%s = append(make(%s, 0, len(%s)), %s...)
+		}`, name, field.Name, goType, name, name)
+	}
+	return fmt.Sprintf(`if %s != nil {
+		clone.%s = make(%s, len(%s))
+		for i := range %s {
+			%s
+		}
+	}`, name, field.Name, goType, name, name, copyItem)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffSkipped are the properties left out of diffs. Billing info is updated
+// with its own operations, and needs a token or the full payment details.
+var diffSkipped = map[string]bool{
+	"billing_info": true,
+}
+
+// Diff builds the update request of a resource from two versions of it,
+// generated in diffs.go. Update requests, named after their resource, get an
+// exported Diff function. The nested requests they use get unexported ones.
+type Diff struct {
+	Name     string
+	Resource string
+	Request  string
+	Exported bool
+	// Statements set the fields of the request which changed
+	Statements []string
+}
+
+// diffs returns the diffs of every resource with an update request, followed
+// by the diffs of the nested requests they use
+func (g *generator) diffs() []*Diff {
+	resources := map[string]*Resource{}
+	for _, resource := range g.resources {
+		resources[resource.Name] = resource
+	}
+	requests := map[string]*Request{}
+	for _, request := range g.requests {
+		requests[request.Name] = request
+	}
+
+	var diffs []*Diff
+	names := map[string]*Diff{}
+	var diff func(resource *Resource, request *Request) *Diff
+	diff = func(resource *Resource, request *Request) *Diff {
+		key := resource.Name + "." + request.Name
+		if d, ok := names[key]; ok {
+			return d
+		}
+		d := &Diff{
+			Name:     "Diff" + resource.Name,
+			Resource: resource.Name,
+			Request:  request.Name,
+			Exported: request.Name == resource.Name+"Update",
+		}
+		if !d.Exported {
+			d.Name = "diff" + request.Name
+		}
+		names[key] = d
+		diffs = append(diffs, d)
+
+		for i, field := range request.Fields {
+			// read only properties, such as the id of a plan, identify
+			// the resource rather than change it
+			if diffSkipped[field.JSONName] || g.doc.Resolve(request.properties[i].Schema).ReadOnly {
+				continue
+			}
+			var original *Field
+			for _, f := range resource.Fields {
+				if f.JSONName == field.JSONName {
+					original = f
+				}
+			}
+			if original == nil {
+				continue
+			}
+			nested := func(resourceType string, requestType string) string {
+				r, q := resources[resourceType], requests[requestType]
+				if r == nil || q == nil {
+					return ""
+				}
+				return diff(r, q).Name
+			}
+			if statement := diffStatement(original, field, nested); statement != "" {
+				d.Statements = append(d.Statements, statement)
+			}
+		}
+		return d
+	}
+
+	for _, resource := range g.resources {
+		if request := requests[resource.Name+"Update"]; request != nil {
+			diff(resource, request)
+		}
+	}
+	return diffs
+}
+
+// diffStatement returns the statement which sets the field of the update
+// request when the field of the resource changed, or an empty string if the
+// types of the fields don't match. nested returns the diff function of a
+// nested resource and request.
+func diffStatement(original *Field, field *Field, nested func(string, string) string) string {
+	name := field.Name
+	switch {
+	case original.Type == "string" && field.Type == "*string":
+		// strings are cleared with null
+		return fmt.Sprintf(`if value := desired.%s; value != original.%s {
+			update.%s = stringOrNull(value)
+		}`, original.Name, original.Name, name)
+	case "*"+original.Type == field.Type && !strings.HasPrefix(original.Type, "[]"):
+		// emptied enums point to their empty value, which is sent as null
+		return fmt.Sprintf(`if value := desired.%s; value != original.%s {
+			update.%s = &value
+		}`, original.Name, original.Name, name)
+	case strings.HasPrefix(field.Type, "*"):
+		if function := nested(original.Type, field.Type[1:]); function != "" {
+			return fmt.Sprintf("update.%s = %s(&original.%s, &desired.%s)", name, function, original.Name, original.Name)
+		}
+	case original.Type == "CustomFields" && field.Type == "CustomFieldsCreate":
+		return fmt.Sprintf("update.%s = diffCustomFields(original.%s, desired.%s)", name, original.Name, original.Name)
+	case strings.HasPrefix(original.Type, "[]") && strings.HasPrefix(field.Type, "[]"):
+		return fmt.Sprintf(`if !jsonEqual(original.%s, desired.%s) {
+			convertResource(desired.%s, &update.%s)
+		}`, original.Name, original.Name, original.Name, name)
+	}
+	return ""
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// embeddedLists finds the lists embedded in resources, such as the line items
+// of an invoice, and the resources which have to attach the client to them
+// once decoded, directly or through the resources they contain
+func (g *generator) embeddedLists() {
+	resources := map[string]*Resource{}
+	for _, resource := range g.resources {
+		resources[resource.Name] = resource
+	}
+
+	attached := map[string]bool{}
+	for _, resource := range g.resources {
+		for _, field := range resource.Fields {
+			if !isEmbeddedList(field.Type) {
+				continue
+			}
+			if item := resources[strings.TrimSuffix(field.Type, "List")]; item != nil {
+				item.Embedded = true
+			}
+			attached[resource.Name] = true
+		}
+	}
+	// resources containing an attached resource are attached too
+	for changed := true; changed; {
+		changed = false
+		for _, resource := range g.resources {
+			if attached[resource.Name] {
+				continue
+			}
+			for _, field := range resource.Fields {
+				if attached[elementType(field.Type)] {
+					attached[resource.Name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	for _, resource := range g.resources {
+		if !attached[resource.Name] {
+			continue
+		}
+		for _, field := range resource.Fields {
+			switch {
+			case isEmbeddedList(field.Type):
+				resource.Attach = append(resource.Attach, fmt.Sprintf(
+					"resource.%s.attach(c, %s)", field.Name, g.embeddedListPath(resource, field)))
+			case !attached[elementType(field.Type)]:
+			case strings.HasPrefix(field.Type, "[]"):
+				resource.Attach = append(resource.Attach, fmt.Sprintf(`for i := range resource.%s {
+					resource.%s[i].attachClient(c)
+				}`, field.Name, field.Name))
+			case strings.HasPrefix(field.Type, "*"):
+				resource.Attach = append(resource.Attach, fmt.Sprintf(`if resource.%s != nil {
+					resource.%s.attachClient(c)
+				}`, field.Name, field.Name))
+			default:
+				resource.Attach = append(resource.Attach, fmt.Sprintf("resource.%s.attachClient(c)", field.Name))
+			}
+		}
+	}
+}
+
+// isEmbeddedList reports whether a field type is a list embedded in its
+// resource
+func isEmbeddedList(goType string) bool {
+	return strings.HasSuffix(goType, "List") && !strings.HasPrefix(goType, "[]")
+}
+
+// elementType returns the resource type of a field, without its slice or
+// pointer
+func elementType(goType string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(goType, "[]"), "*")
+}
+
+// embeddedListPath returns the expression of the path listing every item of
+// a list embedded in a resource: the list operation of the same type whose
+// only path parameter is the ID of the resource, and whose path ends with the
+// name of the field. It is
+// an empty string if the API has no such operation.
+func (g *generator) embeddedListPath(resource *Resource, field *Field) string {
+	hasID := false
+	for _, f := range resource.Fields {
+		if f.Name == "Id" && f.Type == "string" {
+			hasID = true
+		}
+	}
+	if !hasID {
+		return `""`
+	}
+	for _, op := range g.operations {
+		if op.IsList && op.Result == field.Type && len(op.PathParams) == 1 &&
+			op.PathParams[0] == lowerFirst(resource.Name)+"Id" && strings.HasSuffix(op.Path, "}/"+field.JSONName) {
+			return fmt.Sprintf(`c.InterpolatePath("%s", resource.Id)`, op.Path)
+		}
+	}
+	return `""`
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+// handWrittenTypes are the names of the types declared by hand in the client,
+// which enums can't be named after
+var handWrittenTypes = []string{
+	"CustomFields", "CustomFieldsCreate", "Empty", "Error",
+	"ErrorClass", "ErrorParam", "ErrorType", "ListMetadata", "ListOrder",
+	"Money", "NullTime", "Params", "RateLimit", "Resource", "SortField",
+	"TransactionError",
+}
+
+// handWrittenFieldEnums are the enum properties whose type is declared by hand,
+// by schema and property
+var handWrittenFieldEnums = map[string]string{
+	"ErrorMayHaveTransaction.type": "ErrorType",
+}
+
+// enumUse is a string property of a resource or request with a fixed set of
+// values
+type enumUse struct {
+	schema   string
+	property string
+	values   []string
+	field    *Field
+}
+
+// enumSet is the set of values of a property shared by the uses whose values
+// are all part of it. Its owner is the first schema which uses it.
+type enumSet struct {
+	values []string
+	owner  string
+	uses   []*enumUse
+}
+
+// visitEnum records a property of a resource or request with an enum
+func (g *generator) visitEnum(schemaName string, property *openapi.Property, field *Field) {
+	schema := property.Schema
+	if schema.Type == "array" && schema.Items != nil {
+		schema = schema.Items
+	}
+	schema = g.doc.Resolve(schema)
+	if schema.Type != "string" || len(schema.Enum) == 0 || handWritten[schemaName] {
+		return
+	}
+	if name, ok := handWrittenFieldEnums[schemaName+"."+property.Name]; ok {
+		field.Type = strings.Replace(field.Type, "string", name, 1)
+		return
+	}
+	g.enumUses = append(g.enumUses, &enumUse{
+		schema:   schemaName,
+		property: property.Name,
+		values:   schema.Enum,
+		field:    field,
+	})
+}
+
+// nameFieldEnums turns the enum properties into named types. Properties with
+// the same name share a type when the values of one are part of the other.
+// Properties which have a single type across the spec are named after it,
+// e.g. CollectionMethod. Others get a type for each schema which uses them,
+// named after it, e.g. AccountState and PlanState, so that no schema uses a
+// type named after another.
+func (g *generator) nameFieldEnums() {
+	taken := map[string]bool{}
+	for _, name := range handWrittenTypes {
+		taken[name] = true
+	}
+	for _, resource := range g.resources {
+		taken[resource.Name] = true
+		taken[resource.Name+"List"] = true
+	}
+	for _, request := range g.requests {
+		taken[request.Name] = true
+	}
+	for _, enum := range g.enums {
+		taken[enum.Name] = true
+	}
+
+	// group the uses by property, in the order they were visited
+	var properties []string
+	byProperty := map[string][]*enumUse{}
+	for _, use := range g.enumUses {
+		if byProperty[use.property] == nil {
+			properties = append(properties, use.property)
+		}
+		byProperty[use.property] = append(byProperty[use.property], use)
+	}
+
+	type candidate struct {
+		set      *enumSet
+		property string
+		shared   bool
+	}
+	var candidates []candidate
+	for _, property := range properties {
+		sets := mergeEnumSets(byProperty[property])
+		for _, set := range sets {
+			shared := len(sets) == 1 && strings.Contains(property, "_")
+			candidates = append(candidates, candidate{set, property, shared})
+		}
+	}
+	// types named after their schema get their name first, so that
+	// Transaction.Type is a TransactionType
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return !candidates[i].shared && candidates[j].shared
+	})
+
+	for _, c := range candidates {
+		base := goName(c.property)
+		if strings.HasPrefix(c.set.uses[0].field.Type, "[]") {
+			base = strings.TrimSuffix(base, "s")
+		}
+		if c.shared && !taken[base] {
+			taken[base] = true
+			g.addFieldEnum(base, c.property, c.set.values, c.set.uses, ownerList(c.set.uses, true))
+			continue
+		}
+		for _, set := range splitByOwner(c.set) {
+			names := []string{ownerName(set.owner) + base, set.owner + base}
+			name := names[len(names)-1] + "Value"
+			for _, n := range names {
+				if !taken[n] {
+					name = n
+					break
+				}
+			}
+			taken[name] = true
+			// the schemas are named in full when the enum is
+			owners := ownerList(set.uses, name == names[0])
+			g.addFieldEnum(name, c.property, set.values, set.uses, owners)
+		}
+	}
+	sort.Slice(g.fieldEnums, func(i, j int) bool {
+		return g.fieldEnums[i].Name < g.fieldEnums[j].Name
+	})
+}
+
+// addFieldEnum adds the enum type of the uses of a property
+func (g *generator) addFieldEnum(name string, property string, values []string, uses []*enumUse, owners string) {
+	enum := &Enum{
+		Name:    name,
+		Comment: []string{name + " is a value of the `" + property + "` of " + owners},
+	}
+	for _, value := range values {
+		enum.Values = append(enum.Values, &EnumValue{
+			Name:  name + constName(value),
+			Value: value,
+		})
+	}
+	g.fieldEnums = append(g.fieldEnums, enum)
+	for _, use := range uses {
+		use.field.Type = strings.Replace(use.field.Type, "string", name, 1)
+	}
+}
+
+// splitByOwner splits the uses of a set by the schema which uses them, where
+// requests belong to the schema they are named after, e.g. AccountCreate to
+// Account. The sets keep the values of the whole set.
+func splitByOwner(set *enumSet) []*enumSet {
+	var sets []*enumSet
+	byOwner := map[string]*enumSet{}
+	for _, use := range set.uses {
+		owner := ownerName(use.schema)
+		s := byOwner[owner]
+		if s == nil {
+			s = &enumSet{values: set.values, owner: use.schema}
+			byOwner[owner] = s
+			sets = append(sets, s)
+		}
+		s.uses = append(s.uses, use)
+	}
+	return sets
+}
+
+// mergeEnumSets groups the uses of a property by their values. Uses whose
+// values are all part of the values of another use share its set.
+func mergeEnumSets(uses []*enumUse) []*enumSet {
+	sorted := append([]*enumUse{}, uses...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].values) > len(sorted[j].values)
+	})
+
+	var sets []*enumSet
+	for _, use := range sorted {
+		var set *enumSet
+		for _, s := range sets {
+			if isSubset(use.values, s.values) {
+				set = s
+				break
+			}
+		}
+		if set == nil {
+			set = &enumSet{values: use.values}
+			sets = append(sets, set)
+		}
+		set.uses = append(set.uses, use)
+	}
+
+	// keep the uses of each set, and the sets, in the order they were visited
+	order := map[*enumUse]int{}
+	for i, use := range uses {
+		order[use] = i
+	}
+	for _, set := range sets {
+		sort.SliceStable(set.uses, func(i, j int) bool {
+			return order[set.uses[i]] < order[set.uses[j]]
+		})
+		set.owner = set.uses[0].schema
+	}
+	sort.SliceStable(sets, func(i, j int) bool {
+		return order[sets[i].uses[0]] < order[sets[j].uses[0]]
+	})
+	return sets
+}
+
+func isSubset(values []string, of []string) bool {
+	for _, value := range values {
+		found := false
+		for _, v := range of {
+			if v == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// schemaSuffixes are stripped from the names of the schemas enums are named
+// after, so that AccountCreate and Account share the AccountState name
+var schemaSuffixes = []string{"Create", "Update", "Updatable", "Mini", "ReadOnly", "Purchase", "Response", "Cancel"}
+
+// ownerName returns the name of a schema without its request suffix
+func ownerName(schema string) string {
+	for _, suffix := range schemaSuffixes {
+		if strings.HasSuffix(schema, suffix) && schema != suffix {
+			return strings.TrimSuffix(schema, suffix)
+		}
+	}
+	return schema
+}
+
+// ownerList lists the schemas which use an enum, without their request
+// suffixes when strip is set
+func ownerList(uses []*enumUse, strip bool) string {
+	var names []string
+	seen := map[string]bool{}
+	for _, use := range uses {
+		name := use.schema
+		if strip {
+			name = ownerName(name)
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+// constName converts an enum value to the suffix of its constant, e.g.
+// "past_due" to PastDue and "en-US" to EnUS
+func constName(value string) string {
+	var buf strings.Builder
+	upper := true
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
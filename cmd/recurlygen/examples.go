@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+// exampleDepth is the depth up to which nested resources of the full example
+// of a resource are filled in. Deeper ones are empty objects.
+const exampleDepth = 2
+
+// exampleTime is the timestamp of the full examples
+const exampleTime = "2020-01-01T00:00:00Z"
+
+// Example holds the JSON documents of a resource used by the round-trip tests
+// generated in examples_test.go
+type Example struct {
+	Name string
+	// Documents are the resource with zero values, with every value set,
+	// taken from the examples of the spec where it has some, and with null
+	// values
+	Documents []string
+}
+
+// exampleMode is the kind of values an example is built with
+type exampleMode int
+
+const (
+	exampleZero exampleMode = iota
+	exampleFull
+	exampleNull
+)
+
+// examples returns the examples of every resource which is a JSON object
+func (g *generator) examples() []*Example {
+	var examples []*Example
+	for _, resource := range g.resources {
+		if g.doc.Resolve(resource.schema).Type != "object" {
+			continue
+		}
+		example := &Example{Name: resource.Name}
+		for _, mode := range []exampleMode{exampleZero, exampleFull, exampleNull} {
+			data, err := json.Marshal(g.exampleObject(resource.schema, mode, 0))
+			if err != nil {
+				panic(fmt.Sprintf("example of %s: %v", resource.Name, err))
+			}
+			example.Documents = append(example.Documents, string(data))
+		}
+		examples = append(examples, example)
+	}
+	return examples
+}
+
+// exampleObject returns an example of an object schema, with a value for
+// every property
+func (g *generator) exampleObject(schema *openapi.Schema, mode exampleMode, depth int) map[string]interface{} {
+	object := map[string]interface{}{}
+	for _, property := range g.properties(schema) {
+		object[property.Name] = g.exampleValue(property.Schema, mode, depth)
+	}
+	return object
+}
+
+// exampleValue returns an example of the value of a property
+func (g *generator) exampleValue(schema *openapi.Schema, mode exampleMode, depth int) interface{} {
+	if mode == exampleNull {
+		return nil
+	}
+	resolved := g.doc.Resolve(schema)
+	if mode == exampleFull && resolved.Example != nil {
+		return jsonValue(resolved.Example)
+	}
+
+	if items := g.listItems(schema); items != nil {
+		list := map[string]interface{}{"object": "list", "has_more": false, "next": nil, "data": []interface{}{}}
+		if mode == exampleFull && depth < exampleDepth {
+			list["has_more"] = true
+			list["next"] = "/next"
+			list["data"] = []interface{}{g.exampleValue(items, mode, depth+1)}
+		}
+		return list
+	}
+
+	switch resolved.Type {
+	case "array":
+		if mode == exampleZero || resolved.Items == nil {
+			return []interface{}{}
+		}
+		return []interface{}{g.exampleValue(resolved.Items, mode, depth+1)}
+	case "integer":
+		if mode == exampleZero {
+			return 0
+		}
+		return 1
+	case "number":
+		if mode == exampleZero {
+			return 0
+		}
+		return 1.5
+	case "boolean":
+		return mode == exampleFull
+	case "string":
+		switch {
+		case resolved.Format == "date-time" && mode == exampleZero:
+			return nil
+		case mode == exampleZero:
+			return ""
+		case resolved.Format == "date-time":
+			return exampleTime
+		case len(resolved.Enum) > 0:
+			return resolved.Enum[0]
+		}
+		return "string"
+	}
+
+	if len(g.properties(schema)) == 0 {
+		// free-form objects, such as gateway response values
+		if mode == exampleZero {
+			return map[string]interface{}{}
+		}
+		return map[string]interface{}{"key": "value"}
+	}
+	if mode == exampleZero || depth >= exampleDepth {
+		return map[string]interface{}{}
+	}
+	return g.exampleObject(schema, mode, depth+1)
+}
+
+// jsonValue converts a value decoded from YAML, whose maps have keys of any
+// type, to one which can be encoded as JSON
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		object := map[string]interface{}{}
+		for key, item := range v {
+			object[fmt.Sprint(key)] = jsonValue(item)
+		}
+		return object
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, item := range v {
+			values[i] = jsonValue(item)
+		}
+		return values
+	}
+	return value
+}
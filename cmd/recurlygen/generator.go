@@ -0,0 +1,659 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+// File is a generated source file
+type File struct {
+	Name   string
+	Source []byte
+}
+
+// sitePrefix is stripped from the paths in the spec. The client always talks to
+// the site the API key belongs to.
+const sitePrefix = "/sites/{site_id}"
+
+// handWritten are schemas implemented by hand in the client. They are used as
+// field types but never generated.
+var handWritten = map[string]bool{
+	"Empty":            true,
+	"Error":            true,
+	"TransactionError": true,
+}
+
+// handWrittenLists are the array schemas declared by hand as slice types with
+// helpers, by name: the type of resource fields, then of request fields
+var handWrittenLists = map[string][2]string{
+	"CustomFields": {"CustomFields", "CustomFieldsCreate"},
+}
+
+// Resource is a response schema, generated in resources.go
+type Resource struct {
+	Name   string
+	Fields []*Field
+	// Embedded is set when the list of the resource is embedded in other
+	// resources, and so is decoded from their JSON
+	Embedded bool
+	// Attach are the statements of the attachClient method of the resource,
+	// which lets the lists embedded in it fetch their next pages
+	Attach []string
+	// Clones are the statements of the Clone method of the resource
+	Clones []string
+
+	schema *openapi.Schema
+}
+
+// Request is a request body schema, generated in requests.go
+type Request struct {
+	Name   string
+	Fields []*Field
+	// Checks are the statements of the validate method of the request
+	Checks []string
+	// Clones are the statements of the Clone method of the request
+	Clones []string
+	// Money is set when the request has amounts of money, whose exact
+	// values it holds
+	Money bool
+
+	schema     *openapi.Schema
+	properties openapi.Properties
+}
+
+// Field is a single property of a resource or request
+type Field struct {
+	Name     string
+	Type     string
+	JSONName string
+	Comment  []string
+	// Money is set on the amounts of money. Resources have a Money accessor
+	// returning their exact value, and requests a setter sending it.
+	Money bool
+}
+
+// Operation is a single API operation, generated in client_operations.go
+type Operation struct {
+	Name       string
+	Summary    string
+	Returns    string
+	Method     string
+	Path       string
+	PathParams []string
+	Body       string
+	Params     *ParamsType
+	Result     string
+	IsList     bool
+}
+
+// ParamsType holds the optional parameters of an operation
+type ParamsType struct {
+	Name   string
+	Fields []*QueryParam
+	Body   string
+}
+
+// QueryParam is a single query string parameter of an operation
+type QueryParam struct {
+	Name    string
+	Key     string
+	Type    string
+	Value   string
+	Comment []string
+
+	// Enum is the type of the typed field of a string parameter with a fixed
+	// set of values, which is sent instead of the string field when set
+	Enum *Enum
+	// EnumField is the name of the typed field, the name of the string
+	// field followed by Value
+	EnumField string
+}
+
+// Enum is the set of values of a query parameter, generated in
+// client_operations.go unless it is implemented by hand in params.go
+type Enum struct {
+	Name    string
+	Comment []string
+	Values  []*EnumValue
+	// HandWritten enums are declared in params.go, only their values and
+	// IsValid are generated
+	HandWritten bool
+}
+
+// EnumValue is a single value of an Enum
+type EnumValue struct {
+	Name  string
+	Value string
+}
+
+// handWrittenEnums are the query parameter enums implemented by hand in
+// params.go, by the name of the parameter in the components of the spec
+var handWrittenEnums = map[string]string{
+	"order":      "ListOrder",
+	"sort_dates": "SortField",
+}
+
+type generator struct {
+	doc *openapi.Document
+
+	resources     []*Resource
+	resourceNames map[string]bool
+	requests      []*Request
+	requestNames  map[string]bool
+	operations    []*Operation
+	enums         []*Enum
+	enumUses      []*enumUse
+	fieldEnums    []*Enum
+	patterns      []*Pattern
+}
+
+// Generate builds the generated files from the spec
+func Generate(doc *openapi.Document) ([]*File, error) {
+	g := &generator{
+		doc:           doc,
+		resourceNames: map[string]bool{},
+		requestNames:  map[string]bool{},
+	}
+
+	ops := doc.Operations()
+	// Resources have to be known first, since requests which embed them are
+	// named after them.
+	for _, op := range ops {
+		for _, res := range op.Responses {
+			if schema, _ := res.Schema(); schema != nil {
+				g.visitResource(schema)
+			}
+		}
+	}
+	for _, op := range ops {
+		if schema := op.RequestSchema(); schema != nil {
+			g.visitRequest(schema)
+		}
+		g.operations = append(g.operations, g.operation(op))
+	}
+	g.nameFieldEnums()
+	g.embeddedLists()
+	g.clones()
+	// checks depend on the types of enum fields
+	for _, request := range g.requests {
+		request.Checks = g.checks(request)
+	}
+
+	data := map[string]interface{}{
+		"APIVersion": doc.Info.Version,
+		"Resources":  g.resources,
+		"Requests":   g.requests,
+		"Operations": g.operations,
+		"Enums":      g.enums,
+		"FieldEnums": g.fieldEnums,
+		"Patterns":   g.patterns,
+		"Diffs":      g.diffs(),
+		"Examples":   g.examples(),
+	}
+	var files []*File
+	for _, name := range []string{"client_operations.go", "resources.go", "requests.go", "enums.go", "diffs.go", "examples_test.go", "recurlytest/enums.go"} {
+		source, err := render(name, data)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &File{Name: name, Source: source})
+	}
+	return files, nil
+}
+
+func render(name string, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return source, nil
+}
+
+// properties returns the properties of an object schema, including the ones
+// it inherits through `allOf`
+func (g *generator) properties(schema *openapi.Schema) openapi.Properties {
+	schema = g.doc.Resolve(schema)
+	properties := append(openapi.Properties{}, schema.Properties...)
+	for _, parent := range schema.AllOf {
+		properties = append(properties, g.properties(parent)...)
+	}
+	return properties
+}
+
+// schemaName returns the name of a schema which is generated as its own type,
+// or an empty string for scalars and anonymous objects
+func schemaName(schema *openapi.Schema) string {
+	if schema.Ref != "" {
+		return openapi.RefName(schema.Ref)
+	}
+	return schema.ClassName
+}
+
+// listItems returns the schema of the items of a paginated list schema, or nil
+func (g *generator) listItems(schema *openapi.Schema) *openapi.Schema {
+	name := schemaName(schema)
+	if !strings.HasSuffix(name, "List") {
+		return nil
+	}
+	for _, property := range g.properties(schema) {
+		if property.Name == "data" && property.Schema.Items != nil {
+			return property.Schema.Items
+		}
+	}
+	return nil
+}
+
+// visitResource collects the resource for the schema, followed by every
+// resource it references, depth first
+func (g *generator) visitResource(schema *openapi.Schema) {
+	if items := g.listItems(schema); items != nil {
+		g.visitResource(items)
+		return
+	}
+	resolved := g.doc.Resolve(schema)
+	if resolved.Type == "array" {
+		g.visitResource(resolved.Items)
+		return
+	}
+	name := schemaName(schema)
+	if name == "" || g.resourceNames[name] {
+		return
+	}
+	g.resourceNames[name] = true
+
+	resource := &Resource{Name: name, schema: schema}
+	if !handWritten[name] {
+		g.resources = append(g.resources, resource)
+	}
+
+	if resolved.Type != "" && resolved.Type != "object" {
+		// scalar schemas such as binary files are wrapped in a struct
+		resource.Fields = append(resource.Fields, &Field{
+			Name:     "Data",
+			Type:     scalarType(resolved),
+			JSONName: "data",
+		})
+		return
+	}
+
+	for _, property := range g.properties(schema) {
+		field := &Field{
+			Name:     goName(property.Name),
+			Type:     g.resourceType(property.Schema),
+			JSONName: property.Name,
+			Comment:  g.comment(property.Schema),
+		}
+		field.Money = isMoney(property, field.Type)
+		resource.Fields = append(resource.Fields, field)
+		g.visitEnum(name, property, field)
+	}
+	for _, property := range g.properties(schema) {
+		g.visitNested(property.Schema, g.visitResource)
+	}
+}
+
+// visitNested visits the schemas referenced by a property
+func (g *generator) visitNested(schema *openapi.Schema, visit func(*openapi.Schema)) {
+	if schema.Type == "array" && schema.Items != nil {
+		schema = schema.Items
+	}
+	if schemaName(schema) != "" {
+		visit(schema)
+	}
+}
+
+// resourceType returns the Go type of a property of a resource
+func (g *generator) resourceType(schema *openapi.Schema) string {
+	if items := g.listItems(schema); items != nil {
+		return schemaName(items) + "List"
+	}
+	if list, ok := handWrittenLists[schemaName(schema)]; ok {
+		return list[0]
+	}
+	resolved := g.doc.Resolve(schema)
+	if resolved.Type == "array" {
+		return "[]" + g.resourceType(resolved.Items)
+	}
+	if name := schemaName(schema); name != "" {
+		return name
+	}
+	// timestamps of resources tell apart null and absent values
+	return strings.Replace(scalarType(resolved), "time.Time", "NullTime", 1)
+}
+
+// visitRequest collects the request for the schema, followed by every request
+// it references, depth first
+func (g *generator) visitRequest(schema *openapi.Schema) {
+	resolved := g.doc.Resolve(schema)
+	if resolved.Type == "array" {
+		g.visitRequest(resolved.Items)
+		return
+	}
+	name := g.requestName(schema)
+	if name == "" || g.requestNames[name] {
+		return
+	}
+	g.requestNames[name] = true
+
+	properties := g.properties(schema)
+	request := &Request{Name: name, schema: schema, properties: properties}
+	g.requests = append(g.requests, request)
+
+	for _, property := range properties {
+		field := &Field{
+			Name:     goName(property.Name),
+			Type:     g.requestType(property.Schema),
+			JSONName: property.Name,
+			Comment:  g.comment(property.Schema),
+		}
+		field.Money = isMoney(property, strings.TrimPrefix(field.Type, "*"))
+		request.Money = request.Money || field.Money
+		request.Fields = append(request.Fields, field)
+		g.visitEnum(name, property, field)
+	}
+	for _, property := range properties {
+		g.visitNested(property.Schema, g.visitRequest)
+	}
+}
+
+// requestName returns the name of the request type for a schema. Requests
+// which embed a resource get their own type named after the resource.
+func (g *generator) requestName(schema *openapi.Schema) string {
+	name := schemaName(schema)
+	if name != "" && g.resourceNames[name] && !handWritten[name] {
+		return name + "Create"
+	}
+	return name
+}
+
+// requestType returns the Go type of a property of a request
+func (g *generator) requestType(schema *openapi.Schema) string {
+	if list, ok := handWrittenLists[schemaName(schema)]; ok {
+		return list[1]
+	}
+	resolved := g.doc.Resolve(schema)
+	if resolved.Type == "array" {
+		return "[]" + strings.TrimPrefix(g.requestType(resolved.Items), "*")
+	}
+	if name := g.requestName(schema); name != "" {
+		return "*" + name
+	}
+	goType := scalarType(resolved)
+	if strings.HasPrefix(goType, "map[") {
+		return goType
+	}
+	return "*" + goType
+}
+
+// nullableTypes are the types of the fields of requests which can be sent as
+// null, with the pointers returned by the Null functions of null.go. Enums are
+// null when they point to their empty value, and nested requests are either
+// set or left out.
+var nullableTypes = map[string]bool{
+	"*string":    true,
+	"*int":       true,
+	"*float64":   true,
+	"*bool":      true,
+	"*time.Time": true,
+}
+
+// notNull returns the expression which tells whether a pointer field of a
+// request is set to a value, and not null
+func notNull(value string, goType string) string {
+	if nullableTypes[goType] {
+		return value + " != nil && !IsNull(" + value + ")"
+	}
+	return value + " != nil"
+}
+
+// setValue returns the expression which tells whether a pointer field of a
+// request is set to a value, and not null. Unlike notNull, it knows the
+// enums, which are null when they point to their empty value.
+func (g *generator) setValue(value string, goType string) string {
+	for _, enum := range g.fieldEnums {
+		if goType == "*"+enum.Name {
+			return value + " != nil && *" + value + ` != ""`
+		}
+	}
+	return notNull(value, goType)
+}
+
+// notMoney are the number properties which aren't amounts of money
+var notMoney = map[string]bool{
+	"gateway_response_time": true,
+	"proration_rate":        true,
+	"rate":                  true,
+}
+
+// isMoney reports whether a field of a resource, or the value of a field of a
+// request, is an amount of money
+func isMoney(property *openapi.Property, goType string) bool {
+	return goType == "float64" && !notMoney[property.Name]
+}
+
+// scalarType returns the Go type of a schema which isn't generated as its own type
+func scalarType(schema *openapi.Schema) string {
+	switch schema.Type {
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "string":
+		if schema.Format == "date-time" {
+			return "time.Time"
+		}
+		return "string"
+	case "array":
+		return "[]" + scalarType(schema.Items)
+	}
+	return "map[string]interface{}"
+}
+
+// operation builds the client method for a spec operation
+func (g *generator) operation(op *openapi.Operation) *Operation {
+	o := &Operation{
+		Name:    goName(op.ID),
+		Summary: op.Summary,
+		Method:  strings.Title(strings.ToLower(op.Method)),
+		Path:    clientPath(op.Path),
+		Result:  "Empty",
+	}
+
+	for _, param := range op.PathParameters() {
+		if strings.Contains(o.Path, "{"+param.Name+"}") {
+			o.PathParams = append(o.PathParams, lowerFirst(goName(param.Name)))
+		}
+	}
+
+	if success := op.SuccessResponse(); success != nil {
+		o.Returns = singleLine(success.Description)
+		if schema, _ := success.Schema(); schema != nil {
+			if items := g.listItems(schema); items != nil {
+				o.Result = schemaName(items) + "List"
+				o.IsList = true
+			} else {
+				o.Result = schemaName(schema)
+			}
+		}
+	}
+
+	var body string
+	if schema := op.RequestSchema(); schema != nil {
+		body = g.requestName(schema)
+	}
+	query := op.QueryParameters()
+	if len(query) == 0 && (op.RequestBody == nil || op.RequestBody.Required) {
+		o.Body = body
+		return o
+	}
+
+	o.Params = &ParamsType{Name: o.Name + "Params"}
+	if op.RequestBody != nil {
+		o.Params.Body = body
+	}
+	for _, param := range query {
+		field := queryParam(param)
+		g.enumParam(o, param, field)
+		o.Params.Fields = append(o.Params.Fields, field)
+	}
+	return o
+}
+
+// enumParam adds a typed field to a string query parameter with a fixed set of
+// values. Parameters shared between operations share their type.
+func (g *generator) enumParam(o *Operation, param *openapi.Parameter, field *QueryParam) {
+	if param.Schema.Type != "string" || len(param.Schema.Enum) == 0 {
+		return
+	}
+
+	var name string
+	switch {
+	case handWrittenEnums[param.Component] != "":
+		name = handWrittenEnums[param.Component]
+	case !o.IsList:
+		name = goName(param.Name) + "Type"
+	case param.Component != "":
+		name = goName(strings.TrimPrefix(param.Component, "filter_")) + "Filter"
+	default:
+		name = goName(param.Name) + "Filter"
+	}
+	field.EnumField = field.Name + "Value"
+
+	for _, enum := range g.enums {
+		if enum.Name == name {
+			field.Enum = enum
+			return
+		}
+	}
+	enum := &Enum{
+		Name:        name,
+		Comment:     lines(name + " - " + param.Description),
+		HandWritten: handWrittenEnums[param.Component] != "",
+	}
+	for _, value := range param.Schema.Enum {
+		enum.Values = append(enum.Values, &EnumValue{
+			Name:  name + goName(strings.Replace(value, "-", "_", -1)),
+			Value: value,
+		})
+	}
+	g.enums = append(g.enums, enum)
+	field.Enum = enum
+}
+
+func queryParam(param *openapi.Parameter) *QueryParam {
+	name := goName(param.Name)
+	p := &QueryParam{
+		Name:    name,
+		Key:     param.Name,
+		Comment: lines(name + " - " + param.Description),
+	}
+	switch {
+	case param.Schema.Type == "array":
+		p.Type = "[]string"
+		p.Value = fmt.Sprintf("strings.Join(list.%s, \",\")", name)
+	case param.Schema.Type == "integer":
+		p.Type = "*int"
+		p.Value = fmt.Sprintf("strconv.Itoa(*list.%s)", name)
+	case param.Schema.Type == "boolean":
+		p.Type = "*bool"
+		p.Value = fmt.Sprintf("strconv.FormatBool(*list.%s)", name)
+	case param.Schema.Format == "date-time":
+		p.Type = "*time.Time"
+		p.Value = fmt.Sprintf("formatTime(*list.%s)", name)
+	default:
+		p.Type = "*string"
+		p.Value = fmt.Sprintf("*list.%s", name)
+	}
+	return p
+}
+
+func clientPath(path string) string {
+	if strings.HasPrefix(path, sitePrefix+"/") {
+		return strings.TrimPrefix(path, sitePrefix)
+	}
+	return path
+}
+
+// goName converts a snake_case name from the spec to its exported Go name
+func goName(name string) string {
+	var buf strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			buf.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return buf.String()
+}
+
+func lowerFirst(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// comment returns the doc comment lines of a property. The description of the
+// property is preferred over the one of the schema it references.
+func (g *generator) comment(schema *openapi.Schema) []string {
+	for _, text := range []string{schema.Description, schema.Title} {
+		if text != "" {
+			return lines(text)
+		}
+	}
+	if schema.Ref != "" {
+		return g.comment(g.doc.Resolve(schema))
+	}
+	return nil
+}
+
+// lines splits text into its non-blank lines
+func lines(text string) []string {
+	var result []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			result = append(result, strings.TrimRight(line, " "))
+		}
+	}
+	return result
+}
+
+func singleLine(text string) string {
+	return strings.Join(lines(text), " ")
+}
+
+// HasEnums reports whether the params have typed fields to validate
+func (params *ParamsType) HasEnums() bool {
+	for _, field := range params.Fields {
+		if field.Enum != nil {
+			return true
+		}
+	}
+	return false
+}
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"lowerFirst": lowerFirst,
+	"goString":   goString,
+}).Parse(operationsTemplate + resourcesTemplate + requestsTemplate + enumsTemplate + diffsTemplate + examplesTemplate +
+	fixtureEnumsTemplate))
+
+// goString returns a Go string literal of the text, raw unless it contains a
+// backquote
+func goString(text string) string {
+	if strings.Contains(text, "`") {
+		return strconv.Quote(text)
+	}
+	return "`" + text + "`"
+}
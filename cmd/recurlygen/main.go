@@ -0,0 +1,70 @@
+// Command recurlygen generates the Recurly client from the OpenAPI spec.
+//
+// It reads openapi/api.yaml and writes client_operations.go, resources.go,
+// requests.go, enums.go, diffs.go, examples_test.go and recurlytest/enums.go.
+// The output only depends on the spec, so running it twice produces identical
+// files.
+//
+// Usage:
+//
+//	go run ./cmd/recurlygen [-spec openapi/api.yaml] [-out .] [-check]
+//
+// With -check, nothing is written. Instead, the command exits with a non-zero
+// status if any generated file on disk differs from what the spec produces.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+func main() {
+	specPath := flag.String("spec", "openapi/api.yaml", "path to the OpenAPI spec")
+	outDir := flag.String("out", ".", "directory the generated files are written to")
+	check := flag.Bool("check", false, "fail if the generated files are out of date instead of writing them")
+	flag.Parse()
+
+	if err := run(*specPath, *outDir, *check); err != nil {
+		fmt.Fprintf(os.Stderr, "recurlygen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath string, outDir string, check bool) error {
+	doc, err := openapi.Load(specPath)
+	if err != nil {
+		return err
+	}
+	files, err := Generate(doc)
+	if err != nil {
+		return err
+	}
+
+	var stale []string
+	for _, file := range files {
+		path := filepath.Join(outDir, file.Name)
+		if check {
+			existing, err := ioutil.ReadFile(path)
+			if err != nil || !bytes.Equal(existing, file.Source) {
+				stale = append(stale, file.Name)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path, file.Source, 0644); err != nil {
+			return err
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("generated files are out of date, run `go generate`: %v", stale)
+	}
+	return nil
+}
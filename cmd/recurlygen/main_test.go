@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+const (
+	specPath = "../../openapi/api.yaml"
+	rootDir  = "../.."
+)
+
+func TestCheckedInFilesAreUpToDate(t *testing.T) {
+	if err := run(specPath, rootDir, true); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestCheckDetectsStaleFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recurlygen")
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := run(specPath, dir, true); err == nil {
+		t.Fatalf("Expected missing files to be reported")
+	}
+	if err := run(specPath, dir, false); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if err := run(specPath, dir, true); err != nil {
+		t.Fatalf("Expected freshly generated files to pass the check: %v", err)
+	}
+
+	path := filepath.Join(dir, "resources.go")
+	if err := ioutil.WriteFile(path, []byte("package recurly\n"), 0644); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	err = run(specPath, dir, true)
+	if err == nil || !strings.Contains(err.Error(), "resources.go") {
+		t.Fatalf("Expected resources.go to be reported as stale, got %v", err)
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	doc, err := openapi.Load(specPath)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	first, err := Generate(doc)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	second, err := Generate(doc)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	for i := range first {
+		if !bytes.Equal(first[i].Source, second[i].Source) {
+			t.Errorf("Expected %s to be generated identically twice", first[i].Name)
+		}
+	}
+}
+
+func TestNotNull(t *testing.T) {
+	for goType, expected := range map[string]string{
+		"*string":                 "attr.X != nil && !IsNull(attr.X)",
+		"*time.Time":              "attr.X != nil && !IsNull(attr.X)",
+		"*AccountPreferredLocale": "attr.X != nil",
+		"*AccountCreate":          "attr.X != nil",
+	} {
+		if actual := notNull("attr.X", goType); actual != expected {
+			t.Errorf("notNull(%q) = %q, expected %q", goType, actual, expected)
+		}
+	}
+}
+
+func TestGoRegexp(t *testing.T) {
+	for pattern, expected := range map[string]string{
+		"/^[a-z0-9_+-]+$/":  "^[a-z0-9_+-]+$",
+		"/^[a-z0-9_+-]+$/i": "(?i)^[a-z0-9_+-]+$",
+		"^[a-z]+$":          "^[a-z]+$",
+	} {
+		if actual := goRegexp(pattern); actual != expected {
+			t.Errorf("goRegexp(%q) = %q, expected %q", pattern, actual, expected)
+		}
+	}
+}
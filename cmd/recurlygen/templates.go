@@ -0,0 +1,557 @@
+package main
+
+const operationsTemplate = `
+{{- define "client_operations.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// APIVersion is the current Recurly API Version
+	APIVersion = "{{ .APIVersion }}"
+)
+{{ range .Operations }}
+{{- if .Params }}
+type {{ .Params.Name }} struct {
+	Params
+{{ range .Params.Fields }}
+	{{- range .Comment }}
+	// {{ . }}
+	{{- end }}
+	{{ .Name }} {{ .Type }}
+{{ if .Enum }}
+	// {{ .EnumField }} - {{ .Name }} as one of the {{ .Enum.Name }} values. It is
+	// validated before the request is sent, and sent instead of {{ .Name }}
+	// when set.
+	{{ .EnumField }} {{ .Enum.Name }}
+{{ end }}
+{{- end }}
+{{- if .Params.Body }}
+	// Body - The body of the request.
+	Body *{{ .Params.Body }}
+{{ end -}}
+}
+
+func (list *{{ .Params.Name }}) toParams() *Params {
+{{- if .Params.Body }}
+	params := &Params{
+		IdempotencyKey: list.IdempotencyKey,
+		Header:         list.Header,
+		Context:        list.Context,
+		RequestParams:  list,
+	}
+	if list.Body != nil {
+		params.Data = list.Body
+	}
+	return params
+{{- else }}
+	return &Params{
+		IdempotencyKey: list.IdempotencyKey,
+		Header:         list.Header,
+		Context:        list.Context,
+		RequestParams:  list,
+	}
+{{- end }}
+}
+{{ if .Params.Fields }}
+func (list *{{ .Params.Name }}) URLParams() []KeyValue {
+	var options []KeyValue
+{{ range .Params.Fields }}
+	{{- if .Enum }}
+	if list.{{ .EnumField }} != "" {
+		options = append(options, KeyValue{Key: "{{ .Key }}", Value: string(list.{{ .EnumField }})})
+	} else if list.{{ .Name }} != nil {
+	{{- else }}
+	if list.{{ .Name }} != nil {
+	{{- end }}
+		options = append(options, KeyValue{Key: "{{ .Key }}", Value: {{ .Value }}})
+	}
+{{ end }}
+	return options
+}
+{{ end }}
+{{- if .Params.HasEnums }}
+// validate checks the parameters with a fixed set of values before the
+// request is sent
+func (list *{{ .Params.Name }}) validate() error {
+	var invalid []ErrorParam
+{{- range .Params.Fields }}
+{{- if .Enum }}
+	invalid = checkEnumParam(invalid, "{{ .Key }}", list.{{ .Name }}, string(list.{{ .EnumField }}), {{ lowerFirst .Enum.Name }}Values)
+{{- end }}
+{{- end }}
+	return paramsError(invalid)
+}
+{{ end }}
+{{- end }}
+// {{ .Name }} {{ .Summary }}
+// Returns: {{ .Returns }}
+func (c *Client) {{ .Name }}({{ template "arguments" . }}) {{ if .IsList }}*{{ .Result }}{{ else }}(*{{ .Result }}, error){{ end }} {
+{{- if .IsList }}
+	path := {{ template "path" . }}
+	return &{{ .Result }}{
+		pager:   newPager(c, path, {{ if .Params }}params{{ else }}nil{{ end }}),
+		HasMore: true,
+	}
+{{- else }}
+	path := c.InterpolatePath("{{ .Path }}"{{ range .PathParams }}, {{ . }}{{ end }})
+	result := &{{ .Result }}{}
+	err := c.Call(http.Method{{ .Method }}, path, {{ if .Params }}params{{ else if .Body }}body{{ else }}nil{{ end }}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, err
+{{- end }}
+}
+{{ end -}}
+{{ range $enum := .Enums }}
+{{- if not .HandWritten }}
+{{- range .Comment }}
+// {{ . }}
+{{- end }}
+type {{ .Name }} string
+
+const (
+{{- range .Values }}
+	{{ .Name }} {{ $enum.Name }} = "{{ .Value }}"
+{{- end }}
+)
+{{ end }}
+// {{ lowerFirst .Name }}Values are the values of {{ .Name }} the API accepts
+var {{ lowerFirst .Name }}Values = []string{ {{- range $i, $v := .Values }}{{ if $i }}, {{ end }}"{{ $v.Value }}"{{ end -}} }
+
+// IsValid reports whether the {{ .Name }} is one of the values the API accepts
+func (value {{ .Name }}) IsValid() bool {
+	return containsString({{ lowerFirst .Name }}Values, string(value))
+}
+{{ end -}}
+{{- end }}
+
+{{- define "arguments" -}}
+{{- range $i, $param := .PathParams }}{{ if $i }}, {{ end }}{{ $param }} string{{ end -}}
+{{- if and .PathParams (or .Params .Body) }}, {{ end -}}
+{{- if .Params }}params *{{ .Params.Name }}{{ else if .Body }}body *{{ .Body }}{{ end -}}
+{{- end }}
+
+{{- define "path" -}}
+{{- if .PathParams }}c.InterpolatePath("{{ .Path }}"{{ range .PathParams }}, {{ . }}{{ end }}){{ else }}"{{ .Path }}"{{ end -}}
+{{- end }}
+`
+
+const resourcesTemplate = `
+{{- define "resources.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+import "encoding/json"
+{{ range .Resources }}
+type {{ .Name }} struct {
+	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
+{{ range .Fields }}
+	{{- range .Comment }}
+	// {{ . }}
+	{{- end }}
+	{{ .Name }} {{ .Type }} ` + "`" + `json:"{{ .JSONName }},omitempty"` + "`" + `
+{{ end -}}
+}
+
+// GetResponse returns the ResponseMetadata that generated this resource
+func (resource *{{ .Name }}) GetResponse() *ResponseMetadata {
+	return resource.recurlyResponse
+}
+
+// setResponse sets the ResponseMetadata that generated this resource
+func (resource *{{ .Name }}) setResponse(res *ResponseMetadata) {
+	resource.recurlyResponse = res
+}
+
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource {{ .Name }}) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *{{ .Name }}) UnmarshalJSON(data []byte) error {
+	type plain {{ .Name }}
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *{{ .Name }}) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *{{ .Name }}) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *{{ .Name }}) Clone() *{{ .Name }} {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+{{- range .Clones }}
+	{{ . }}
+{{- end }}
+	return &clone
+}
+{{- $resource := .Name }}
+{{- range .Fields }}
+{{- if .Money }}
+
+// {{ .Name }}Money returns the {{ .Name }} as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *{{ $resource }}) {{ .Name }}Money() Money {
+	return receivedMoney(resource.rawJSON, "{{ .JSONName }}", resource.{{ .Name }})
+}
+{{- end }}
+{{- end }}
+{{ if .Attach }}
+// attachClient lets the lists embedded in the resource fetch their next pages
+// with the client
+func (resource *{{ .Name }}) attachClient(c *Client) {
+{{- range .Attach }}
+	{{ . }}
+{{- end }}
+}
+{{ end }}
+// internal struct for deserializing accounts
+type {{ lowerFirst .Name }}List struct {
+	ListMetadata
+	Data            []{{ .Name }} ` + "`" + `json:"data"` + "`" + `
+	recurlyResponse *ResponseMetadata
+}
+
+// GetResponse returns the ResponseMetadata that generated this resource
+func (resource *{{ lowerFirst .Name }}List) GetResponse() *ResponseMetadata {
+	return resource.recurlyResponse
+}
+
+// setResponse sets the ResponseMetadata that generated this resource
+func (resource *{{ lowerFirst .Name }}List) setResponse(res *ResponseMetadata) {
+	resource.recurlyResponse = res
+}
+{{ if .Attach }}
+// attachClient lets the lists embedded in the resources fetch their next pages
+// with the client
+func (resource *{{ lowerFirst .Name }}List) attachClient(c *Client) {
+	for i := range resource.Data {
+		resource.Data[i].attachClient(c)
+	}
+}
+{{ end }}
+// {{ .Name }}List allows you to paginate {{ .Name }} objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
+type {{ .Name }}List struct {
+	pager
+
+	HasMore bool
+	Data    []{{ .Name }}
+}
+
+{{ if .Embedded }}
+// UnmarshalJSON decodes the list embedded in a resource. The embedded items
+// are its first page, and Next fetches the rest from the client which
+// returned the resource.
+func (list *{{ .Name }}List) UnmarshalJSON(data []byte) error {
+	page := &{{ lowerFirst .Name }}List{}
+	if err := json.Unmarshal(data, page); err != nil {
+		return err
+	}
+	list.embed(page.ListMetadata, len(page.Data), data)
+	list.HasMore = page.HasMore
+	list.Data = page.Data
+	return nil
+}
+
+// MarshalJSON encodes the list as it was embedded in its resource
+func (list {{ .Name }}List) MarshalJSON() ([]byte, error) {
+	return list.embeddedJSON()
+}
+
+// clone returns a copy of the embedded list and its items
+func (list *{{ .Name }}List) clone() {{ .Name }}List {
+	clone := *list
+	clone.pager = list.pager.clone()
+	if list.Data != nil {
+		clone.Data = make([]{{ .Name }}, len(list.Data))
+		for i := range list.Data {
+			clone.Data[i] = *list.Data[i].Clone()
+		}
+	}
+	return clone
+}
+{{ end }}
+// Fetch fetches the next page of data into the ` + "`Data`" + ` property
+func (list *{{ .Name }}List) Fetch() error {
+	page, err := list.fetchPage(func() listPage { return &{{ lowerFirst .Name }}List{} })
+	if err != nil {
+		return err
+	}
+	resources := page.(*{{ lowerFirst .Name }}List)
+	// copy over properties from the response
+	list.HasMore = resources.HasMore
+	list.Data = resources.Data
+	return nil
+}
+
+// Count returns the count of items on the server that match this pager
+func (list *{{ .Name }}List) Count() (*int64, error) {
+	return list.count(func() listPage { return &{{ lowerFirst .Name }}List{} })
+}
+
+// Next advances to the next {{ .Name }}, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *{{ .Name }}List) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current {{ .Name }}, or nil if Next has not returned true
+func (list *{{ .Name }}List) Item() *{{ .Name }} {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with Resume{{ .Name }}List.
+func (list *{{ .Name }}List) Cursor() string {
+	return list.cursor("{{ .Name }}List", list.HasMore)
+}
+
+// Resume{{ .Name }}List returns the {{ .Name }}List saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) Resume{{ .Name }}List(cursor string, params *Params) (*{{ .Name }}List, error) {
+	p, hasMore, err := resumePager(c, "{{ .Name }}List", cursor, params)
+	if err != nil {
+		return nil, err
+	}
+	return &{{ .Name }}List{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
+}
+{{ end -}}
+{{- end }}
+`
+
+const requestsTemplate = `
+{{- define "requests.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+import (
+	"regexp"
+	"time"
+)
+{{ range .Requests }}
+type {{ .Name }} struct {
+	Params ` + "`" + `json:"-"` + "`" + `
+{{- if .Money }}
+	exactAmounts
+{{- end }}
+{{ range .Fields }}
+	{{- range .Comment }}
+	// {{ . }}
+	{{- end }}
+	{{ .Name }} {{ .Type }} ` + "`" + `json:"{{ .JSONName }},omitempty"` + "`" + `
+{{ end -}}
+}
+
+func (attr *{{ .Name }}) toParams() *Params {
+	return &Params{
+		IdempotencyKey: attr.IdempotencyKey,
+		Header:         attr.Header,
+		Context:        attr.Context,
+		Data:           attr,
+	}
+}
+
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *{{ .Name }}) Clone() *{{ .Name }} {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+{{- if .Money }}
+	clone.exactAmounts = attr.exactAmounts.clone()
+{{- end }}
+{{- range .Clones }}
+	{{ . }}
+{{- end }}
+	return &clone
+}
+{{- $request := .Name }}
+{{- range .Fields }}
+{{- if .Money }}
+
+// Set{{ .Name }}Money sets {{ .Name }} to the exact amount m, which is sent
+// with every digit of m, unless {{ .Name }} is changed since.
+func (attr *{{ $request }}) Set{{ .Name }}Money(m Money) {
+	attr.{{ .Name }} = attr.setMoney("{{ .JSONName }}", m)
+}
+{{- end }}
+{{- end }}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr {{ .Name }}) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *{{ .Name }}) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *{{ .Name }}) validate(invalid []ErrorParam, path string) []ErrorParam {
+{{- range .Checks }}
+	{{ . }}
+{{- end }}
+	return invalid
+}
+{{ end }}
+var (
+{{- range .Patterns }}
+	{{ .Name }} = regexp.MustCompile(` + "`" + `{{ .Regexp }}` + "`" + `)
+{{- end }}
+)
+{{- end }}
+`
+
+const enumsTemplate = `
+{{- define "enums.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+{{ range $enum := .FieldEnums }}
+{{- range .Comment }}
+// {{ . }}
+{{- end }}
+type {{ .Name }} string
+
+const (
+{{- range .Values }}
+	{{ .Name }} {{ $enum.Name }} = "{{ .Value }}"
+{{- end }}
+)
+
+// {{ lowerFirst .Name }}Values are the values of {{ .Name }} listed in the API spec
+var {{ lowerFirst .Name }}Values = []string{ {{- range $i, $v := .Values }}{{ if $i }}, {{ end }}"{{ $v.Value }}"{{ end -}} }
+
+// IsKnown reports whether the {{ .Name }} is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value {{ .Name }}) IsKnown() bool {
+	return containsString({{ lowerFirst .Name }}Values, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value {{ .Name }}) Ptr() *{{ .Name }} {
+	return &value
+}
+{{ end -}}
+{{- end }}
+`
+
+const diffsTemplate = `
+{{- define "diffs.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+{{ range .Diffs }}
+{{- if .Exported }}
+// {{ .Name }} returns the {{ .Request }} which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+{{- else }}
+// {{ .Name }} returns the {{ .Request }} with the fields which differ, or nil
+{{- end }}
+func {{ .Name }}(original *{{ .Resource }}, desired *{{ .Resource }}) *{{ .Request }} {
+	update := &{{ .Request }}{}
+{{- range .Statements }}
+	{{ . }}
+{{- end }}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+{{ end -}}
+{{- end }}
+`
+
+const examplesTemplate = `
+{{- define "examples_test.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+// resourceExamples are JSON documents of every resource for the round-trip
+// tests: with zero values, with every value set, and with null values
+var resourceExamples = []resourceExample{
+{{- range .Examples }}
+	{
+		name: "{{ .Name }}",
+		new:  func() interface{} { return &{{ .Name }}{} },
+		documents: []string{
+		{{- range .Documents }}
+			{{ goString . }},
+		{{- end }}
+		},
+	},
+{{- end }}
+}
+{{ end }}
+`
+
+const fixtureEnumsTemplate = `
+{{- define "recurlytest/enums.go" -}}
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurlytest
+
+import (
+	"reflect"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+// enumValues are the first value of every enum type of the fields of
+// resources and requests, which Populate sets their fields to
+var enumValues = map[reflect.Type]string{
+{{- range .FieldEnums }}
+	reflect.TypeOf(recurly.{{ .Name }}("")): {{ printf "%q" (index .Values 0).Value }},
+{{- end }}
+}
+{{ end }}
+`
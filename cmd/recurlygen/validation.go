@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+// oneOfFields are the properties of requests of which exactly one must be set.
+// The spec only says so in their descriptions.
+var oneOfFields = map[string][][]string{
+	"SubscriptionCreate":   {{"plan_code", "plan_id"}},
+	"SubscriptionPurchase": {{"plan_code", "plan_id"}},
+}
+
+// propertyPatterns are the patterns of properties which the spec only limits
+// in length, by property name
+var propertyPatterns = map[string]string{
+	// ISO 4217 currency codes
+	"currency": "/^[a-z]{3}$/i",
+}
+
+// Pattern is a regular expression of the spec, generated in requests.go
+type Pattern struct {
+	Name   string
+	Regexp string
+}
+
+// required returns the required properties of an object schema, including the
+// ones of the schemas it inherits through `allOf`
+func (g *generator) required(schema *openapi.Schema) []string {
+	schema = g.doc.Resolve(schema)
+	required := append([]string{}, schema.Required...)
+	for _, parent := range schema.AllOf {
+		required = append(required, g.required(parent)...)
+	}
+	return required
+}
+
+// checks returns the statements of the validate method of a request, which
+// append the invalid properties to `invalid`
+func (g *generator) checks(request *Request) []string {
+	name := request.Name
+	oneOf := map[string]bool{}
+	var checks []string
+	for _, group := range oneOfFields[name] {
+		var names, set []string
+		for _, property := range group {
+			oneOf[property] = true
+			names = append(names, strconv.Quote(property))
+			set = append(set, "attr."+goName(property)+" != nil")
+		}
+		checks = append(checks, fmt.Sprintf("invalid = checkOneOf(invalid, path, []string{%s}, %s)",
+			strings.Join(names, ", "), strings.Join(set, ", ")))
+	}
+
+	required := map[string]bool{}
+	for _, property := range g.required(request.schema) {
+		required[property] = !oneOf[property]
+	}
+	for i, property := range request.properties {
+		field := request.Fields[i]
+		if required[property.Name] {
+			checks = append(checks, fmt.Sprintf("invalid = checkRequired(invalid, path+%q, %s)",
+				property.Name, g.isSet(field)))
+		}
+		if check := g.check(name, property, field); check != "" {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// isSet returns the expression which tells whether a field of a request is
+// set to a value, and not null
+func (g *generator) isSet(field *Field) string {
+	if strings.HasPrefix(field.Type, "*") {
+		return g.setValue("attr."+field.Name, field.Type)
+	}
+	return "len(attr." + field.Name + ") > 0"
+}
+
+// check returns the statement validating the value of a field of a request,
+// or an empty string if the spec has no rules for it
+func (g *generator) check(name string, property *openapi.Property, field *Field) string {
+	schema := g.doc.Resolve(property.Schema)
+	key := strconv.Quote(property.Name)
+	value := "attr." + field.Name
+
+	if schema.Type == "array" && schema.Items != nil {
+		items := g.doc.Resolve(schema.Items)
+		if g.requestName(schema.Items) != "" {
+			return fmt.Sprintf(`for i := range %s {
+				invalid = %s[i].validate(invalid, indexPath(path, %s, i)+".")
+			}`, value, value, key)
+		}
+		item := strings.TrimPrefix(field.Type, "[]")
+		if check := g.valueCheck(name, property.Name, items, item, "value", "indexPath(path, "+key+", i)"); check != "" {
+			return fmt.Sprintf(`for i, value := range %s {
+				%s
+			}`, value, check)
+		}
+		return ""
+	}
+	if g.requestName(property.Schema) != "" {
+		return fmt.Sprintf(`if %s != nil {
+			invalid = %s.validate(invalid, path+%s)
+		}`, value, value, strconv.Quote(property.Name+"."))
+	}
+	if check := g.valueCheck(name, property.Name, schema, field.Type[1:], "*"+value, "path+"+key); check != "" {
+		return fmt.Sprintf(`if %s {
+			%s
+		}`, g.setValue(value, field.Type), check)
+	}
+	return ""
+}
+
+// stringValue converts the value of an enum type to a string
+func stringValue(goType string, value string) string {
+	if goType == "string" {
+		return value
+	}
+	return "string(" + value + ")"
+}
+
+// valueCheck returns the statement validating a scalar value of the Go type
+// against the rules of its schema, or an empty string if there are none
+func (g *generator) valueCheck(name string, property string, schema *openapi.Schema, goType string, value string, path string) string {
+	switch schema.Type {
+	case "string":
+		pattern := schema.Pattern
+		if pattern == "" {
+			pattern = propertyPatterns[property]
+		}
+		if schema.MinLength == nil && schema.MaxLength == nil && pattern == "" && len(schema.Enum) == 0 {
+			return ""
+		}
+		values := "nil"
+		if len(schema.Enum) > 0 {
+			var quoted []string
+			for _, v := range schema.Enum {
+				quoted = append(quoted, strconv.Quote(v))
+			}
+			values = "[]string{" + strings.Join(quoted, ", ") + "}"
+		}
+		regexp := "nil"
+		if pattern != "" {
+			regexp = g.pattern(name, property, pattern)
+		}
+		return fmt.Sprintf("invalid = checkString(invalid, %s, %s, %d, %d, %s, %s)",
+			path, stringValue(goType, value), intOrZero(schema.MinLength), intOrZero(schema.MaxLength), regexp, values)
+	case "integer", "number":
+		if schema.Minimum == nil && schema.Maximum == nil {
+			return ""
+		}
+		return fmt.Sprintf("invalid = checkNumber(invalid, %s, float64(%s), %s, %s)",
+			path, value, floatOrNil(schema.Minimum), floatOrNil(schema.Maximum))
+	}
+	return ""
+}
+
+// pattern returns the name of the variable holding the compiled pattern of the
+// spec. Patterns are named after the first property which uses them.
+func (g *generator) pattern(schemaName string, property string, pattern string) string {
+	for _, p := range g.patterns {
+		if p.Regexp == goRegexp(pattern) {
+			return p.Name
+		}
+	}
+	name := lowerFirst(goName(property)) + "Pattern"
+	for _, p := range g.patterns {
+		if p.Name == name {
+			name = lowerFirst(schemaName) + goName(property) + "Pattern"
+		}
+	}
+	g.patterns = append(g.patterns, &Pattern{Name: name, Regexp: goRegexp(pattern)})
+	return name
+}
+
+// goRegexp converts a JavaScript regular expression literal of the spec, such
+// as `/^[a-z]+$/i`, to the syntax of the regexp package
+func goRegexp(pattern string) string {
+	if !strings.HasPrefix(pattern, "/") {
+		return pattern
+	}
+	end := strings.LastIndex(pattern, "/")
+	flags := pattern[end+1:]
+	pattern = pattern[1:end]
+	if flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
+	return pattern
+}
+
+func intOrZero(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func floatOrNil(v *float64) string {
+	if v == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("Float(%s)", strconv.FormatFloat(*v, 'f', -1, 64))
+}
@@ -0,0 +1,322 @@
+package recurly
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/recurly/recurly-client-go/v3/internal/openapi"
+)
+
+// The contract tests check every operation in openapi/api.yaml against the
+// client: the method must exist, take the path parameters, body and query
+// parameters the spec describes, send the right HTTP method to the right
+// path, and return the type of the success response.
+
+const specPath = "openapi/api.yaml"
+
+var (
+	specOnce sync.Once
+	spec     *openapi.Document
+	specErr  error
+)
+
+func loadSpec(t *testing.T) *openapi.Document {
+	specOnce.Do(func() {
+		spec, specErr = openapi.Load(specPath)
+	})
+	if specErr != nil {
+		t.Fatalf("Cannot load %s: %v", specPath, specErr)
+	}
+	return spec
+}
+
+// goName converts a snake_case name from the spec to its Go name
+func goName(name string) string {
+	var buf strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part != "" {
+			buf.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return buf.String()
+}
+
+// sitePrefix is stripped from spec paths. The client always talks to the site
+// the API key belongs to.
+const sitePrefix = "/sites/{site_id}"
+
+func clientPath(path string) string {
+	if strings.HasPrefix(path, sitePrefix+"/") {
+		return strings.TrimPrefix(path, sitePrefix)
+	}
+	return path
+}
+
+// clientPathParameters returns the path parameters the client method takes
+func clientPathParameters(op *openapi.Operation) []string {
+	var names []string
+	path := clientPath(op.Path)
+	for _, param := range op.PathParameters() {
+		if strings.Contains(path, "{"+param.Name+"}") {
+			names = append(names, param.Name)
+		}
+	}
+	return names
+}
+
+// takesParams reports whether the operation is generated with a *Params argument
+// rather than a body argument
+func takesParams(op *openapi.Operation) bool {
+	return len(op.QueryParameters()) > 0 || (op.RequestBody != nil && !op.RequestBody.Required)
+}
+
+// contractRecorder records the request sent by an operation and answers it
+// with a minimal valid response
+type contractRecorder struct {
+	op       *openapi.Operation
+	requests []*http.Request
+	bodies   []string
+}
+
+func (rec *contractRecorder) roundTrip(req *http.Request) *http.Response {
+	body := ""
+	if req.Body != nil {
+		body = bodyToString(req.Body)
+	}
+	rec.requests = append(rec.requests, req)
+	rec.bodies = append(rec.bodies, body)
+
+	success := rec.op.SuccessResponse()
+	statusCode := http.StatusOK
+	fmt.Sscanf(success.Code, "%d", &statusCode)
+	schema, contentType := success.Schema()
+
+	res := mockResponse(req, statusCode, String(contractResponseBody(schema, statusCode)))
+	if contentType != "" {
+		res.Header.Set("Content-Type", contentType)
+	}
+	return res
+}
+
+func contractResponseBody(schema *openapi.Schema, statusCode int) string {
+	if statusCode == http.StatusNoContent || schema == nil {
+		return ""
+	}
+	switch openapi.RefName(schema.Ref) {
+	case "Empty":
+		return ""
+	case "BinaryFile":
+		return "%PDF-1.4"
+	}
+	if strings.HasSuffix(schema.Ref, "List") {
+		return `{"object":"list","has_more":false,"next":"","data":[]}`
+	}
+	return "{}"
+}
+
+// populateParams sets every query parameter field of a *Params struct so each
+// one shows up in the request URL. `ids` cannot be combined with the other
+// parameters, so it is left out. Parameters with a fixed set of values are set
+// to their first value.
+func populateParams(params reflect.Value, op *openapi.Operation) {
+	enums := map[string][]string{}
+	for _, param := range op.QueryParameters() {
+		if param.Schema != nil && len(param.Schema.Enum) > 0 {
+			enums[goName(param.Name)] = param.Schema.Enum
+		}
+	}
+	value := params.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Anonymous || field.Name == "Body" || field.Name == "Ids" {
+			continue
+		}
+		if field.Type.Kind() == reflect.String {
+			// typed alternatives to the string fields, which they would override
+			continue
+		}
+		switch field.Type {
+		case reflect.TypeOf([]string{}):
+			value.Field(i).Set(reflect.ValueOf([]string{"a", "b"}))
+		case reflect.TypeOf((*string)(nil)):
+			if values := enums[field.Name]; len(values) > 0 {
+				value.Field(i).Set(reflect.ValueOf(String(values[0])))
+			} else {
+				value.Field(i).Set(reflect.ValueOf(String("value")))
+			}
+		case reflect.TypeOf((*int)(nil)):
+			value.Field(i).Set(reflect.ValueOf(Int(1)))
+		case reflect.TypeOf((*bool)(nil)):
+			value.Field(i).Set(reflect.ValueOf(Bool(true)))
+		case reflect.TypeOf((*time.Time)(nil)):
+			value.Field(i).Set(reflect.ValueOf(Time(time.Now())))
+		default:
+			panic(fmt.Sprintf("unsupported query parameter type %v for %s", field.Type, field.Name))
+		}
+	}
+}
+
+// typeName returns the name of a type in this package, e.g. `*Account`
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		return "*" + typeName(t.Elem())
+	}
+	return t.Name()
+}
+
+func TestContractOperationsExist(test *testing.T) {
+	doc := loadSpec(test)
+	clientType := reflect.TypeOf(&Client{})
+
+	var missing []string
+	for _, op := range doc.Operations() {
+		if _, ok := clientType.MethodByName(goName(op.ID)); !ok {
+			missing = append(missing, op.ID)
+		}
+	}
+	if len(missing) > 0 {
+		test.Errorf("Spec operations without a client method: %s", strings.Join(missing, ", "))
+	}
+}
+
+func TestContractOperations(test *testing.T) {
+	doc := loadSpec(test)
+	clientType := reflect.TypeOf(&Client{})
+
+	for _, op := range doc.Operations() {
+		op := op
+		method, ok := clientType.MethodByName(goName(op.ID))
+		if !ok {
+			// reported by TestContractOperationsExist
+			continue
+		}
+		test.Run(op.ID, func(test *testing.T) {
+			checkContract(test, op, method)
+		})
+	}
+}
+
+func checkContract(test *testing.T, op *openapi.Operation, method reflect.Method) {
+	methodType := method.Type
+	stringType := reflect.TypeOf("")
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+
+	// Path parameters come first
+	pathParams := clientPathParameters(op)
+	args := []reflect.Value{}
+	in := 1 // skip the receiver
+	for _, name := range pathParams {
+		if in >= methodType.NumIn() || methodType.In(in) != stringType {
+			test.Fatalf("Expected string argument for path parameter %q in %v", name, methodType)
+		}
+		args = append(args, reflect.ValueOf(name))
+		in++
+	}
+
+	// Then the request body or the params
+	requestSchema := op.RequestSchema()
+	var bodyType string
+	if requestSchema != nil {
+		bodyType = "*" + openapi.RefName(requestSchema.Ref)
+	}
+	if takesParams(op) {
+		expected := "*" + goName(op.ID) + "Params"
+		if in >= methodType.NumIn() || typeName(methodType.In(in)) != expected {
+			test.Fatalf("Expected %s argument in %v", expected, methodType)
+		}
+		params := reflect.New(methodType.In(in).Elem())
+		populateParams(params, op)
+		if bodyType != "" {
+			body, ok := params.Elem().Type().FieldByName("Body")
+			if !ok || typeName(body.Type) != bodyType {
+				test.Fatalf("Expected %s to have a Body of type %s", expected, bodyType)
+			}
+			params.Elem().FieldByName("Body").Set(reflect.New(body.Type.Elem()))
+		}
+		args = append(args, params)
+		in++
+	} else if bodyType != "" {
+		if in >= methodType.NumIn() || typeName(methodType.In(in)) != bodyType {
+			test.Fatalf("Expected %s argument in %v", bodyType, methodType)
+		}
+		args = append(args, reflect.New(methodType.In(in).Elem()))
+		in++
+	}
+	if in != methodType.NumIn() {
+		test.Fatalf("Unexpected arguments in %v", methodType)
+	}
+
+	// The return type matches the success response. Responses without content
+	// are returned as *Empty.
+	resultType := "*Empty"
+	if responseSchema, _ := op.SuccessResponse().Schema(); responseSchema != nil {
+		resultType = "*" + openapi.RefName(responseSchema.Ref)
+	}
+	isList := methodType.NumOut() == 1
+	if typeName(methodType.Out(0)) != resultType {
+		test.Fatalf("Expected return type %s in %v", resultType, methodType)
+	}
+	if !isList && (methodType.NumOut() != 2 || methodType.Out(1) != errorType) {
+		test.Fatalf("Expected (%s, error) return in %v", resultType, methodType)
+	}
+
+	// Call the operation and check the request
+	rec := &contractRecorder{op: op}
+	client := newClient("APIKEY", &http.Client{Transport: roundTripFunc(rec.roundTrip)})
+	client.Log = NewLogger(LevelWarn)
+
+	results := method.Func.Call(append([]reflect.Value{reflect.ValueOf(client)}, args...))
+	if isList {
+		results = results[0].MethodByName("Fetch").Call(nil)
+	}
+	if err := results[len(results)-1]; !err.IsNil() {
+		test.Fatalf("Unexpected error: %v", err.Interface())
+	}
+	if len(rec.requests) != 1 {
+		test.Fatalf("Expected 1 request, got %d", len(rec.requests))
+	}
+	req, body := rec.requests[0], rec.bodies[0]
+
+	if req.Method != op.Method {
+		test.Errorf("Expected method %s, got %s", op.Method, req.Method)
+	}
+	expectedPath := strings.NewReplacer("{", "", "}", "").Replace(clientPath(op.Path))
+	if req.URL.Path != expectedPath {
+		test.Errorf("Expected path %s, got %s", expectedPath, req.URL.Path)
+	}
+
+	var expectedQuery, query []string
+	for _, param := range op.QueryParameters() {
+		if param.Name != "ids" {
+			expectedQuery = append(expectedQuery, param.Name)
+		}
+	}
+	for key := range req.URL.Query() {
+		query = append(query, key)
+	}
+	sort.Strings(expectedQuery)
+	sort.Strings(query)
+	if strings.Join(query, ",") != strings.Join(expectedQuery, ",") {
+		test.Errorf("Expected query parameters [%s], got [%s]", strings.Join(expectedQuery, ","), strings.Join(query, ","))
+	}
+
+	if bodyType != "" && body == "" {
+		test.Errorf("Expected a %s request body, none was sent", bodyType)
+	}
+	if bodyType == "" && body != "" {
+		test.Errorf("Expected no request body, got %s", body)
+	}
+
+	if resultType == "*BinaryFile" {
+		file := results[0].Interface().(*BinaryFile)
+		if file.Data != "%PDF-1.4" {
+			test.Errorf("Expected the binary response in BinaryFile.Data, got %q", file.Data)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package recurly
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// cursorPrefix marks the version of the cursor format. The format of a version
+// never changes, so cursors stay valid across client versions.
+const cursorPrefix = "rc1."
+
+// ErrInvalidCursor is returned when resuming a list from a malformed cursor, or
+// from a cursor of another type of list
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// cursorData is the content of a version 1 cursor
+type cursorData struct {
+	// List is the name of the list type, e.g. "AccountList"
+	List string `json:"list"`
+	// Next is the path of the next page, including the query string
+	Next string `json:"next"`
+	// Done is set once the last page was fetched
+	Done bool `json:"done,omitempty"`
+	// Chunks are the paths of the first page of the remaining chunks of a
+	// list filtered by more IDs than the API accepts at once
+	Chunks []string `json:"chunks,omitempty"`
+}
+
+// cursor encodes the position of the pager
+func (p *pager) cursor(list string, hasMore bool) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(cursorData{
+		List:   list,
+		Next:   p.nextPagePath,
+		Done:   !hasMore,
+		Chunks: p.chunks,
+	})
+	return cursorPrefix + base64.RawURLEncoding.EncodeToString(bytes.TrimSpace(buf.Bytes()))
+}
+
+// resumePager decodes a cursor of the given list type. The headers and
+// context of the params are used to fetch every page.
+func resumePager(client *Client, list string, cursor string, params *Params) (pager, bool, error) {
+	if !strings.HasPrefix(cursor, cursorPrefix) {
+		return pager{}, false, ErrInvalidCursor
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(cursor, cursorPrefix))
+	if err != nil {
+		return pager{}, false, ErrInvalidCursor
+	}
+	var decoded cursorData
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return pager{}, false, ErrInvalidCursor
+	}
+	if decoded.List != list || (!decoded.Done && !strings.HasPrefix(decoded.Next, "/")) {
+		return pager{}, false, ErrInvalidCursor
+	}
+	for _, chunk := range decoded.Chunks {
+		if !strings.HasPrefix(chunk, "/") {
+			return pager{}, false, ErrInvalidCursor
+		}
+	}
+	p := newPager(client, decoded.Next, params)
+	p.chunks = decoded.Chunks
+	return p, !decoded.Done, nil
+}
@@ -0,0 +1,91 @@
+package recurly
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCursorResume(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"object":"list","has_more":true,"next":"/accounts?cursor=2&limit=1","data":[{"id":"a"}]}`,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"b"}]}`,
+	)
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(&ListAccountsParams{Limit: Int(1)})
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	cursor := accounts.Cursor()
+
+	// resume on another client, as after a restart
+	ctx := context.Background()
+	resumed, err := scenario.MockHTTPClient().ResumeAccountList(cursor, &Params{Context: ctx})
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(resumed.HasMore, true, "HasMore")
+	t.Assert(resumed.Next(), true, "Next")
+	t.Assert(resumed.Item().Id, "b", "Item().Id")
+	t.Assert((*requested)[1], "/accounts?cursor=2&limit=1", "Resumed page")
+	t.Assert(resumed.Next(), false, "Next after the last page")
+
+	// a cursor saved after the last page resumes a finished list
+	done, err := client.ResumeAccountList(resumed.Cursor(), nil)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(done.HasMore, false, "HasMore")
+	t.Assert(done.Next(), false, "Next")
+	t.Assert(len(*requested), 2, "Number of requests")
+}
+
+func TestCursorFormatIsStable(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			t.Assert(req.URL.String(), "https://v3.recurly.com/accounts?cursor=abc&limit=2", "Request URL")
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			return mockResponse(req, 200, String(`{"object":"list","has_more":false,"next":null,"data":[]}`))
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	// saved by an earlier version: {"list":"AccountList","next":"/accounts?cursor=abc&limit=2"}
+	const saved = "rc1.eyJsaXN0IjoiQWNjb3VudExpc3QiLCJuZXh0IjoiL2FjY291bnRzP2N1cnNvcj1hYmMmbGltaXQ9MiJ9"
+	accounts, err := client.ResumeAccountList(saved, nil)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(accounts.Cursor(), saved, "Cursor")
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+}
+
+func TestCursorInvalid(test *testing.T) {
+	t := &T{test}
+	client := NewClient("APIKEY")
+
+	accounts := client.ListAccounts(nil)
+	cursors := map[string]string{
+		"empty":        "",
+		"no version":   "eyJsaXN0IjoiQWNjb3VudExpc3QifQ",
+		"not base64":   "rc1.!!!",
+		"not JSON":     "rc1.bm90IGpzb24",
+		"no path":      "rc1.eyJsaXN0IjoiQWNjb3VudExpc3QifQ",
+		"another list": client.ListInvoices(nil).Cursor(),
+	}
+	for name, cursor := range cursors {
+		if _, err := client.ResumeAccountList(cursor, nil); err != ErrInvalidCursor {
+			t.Errorf("Expected ErrInvalidCursor for %s cursor, got %v", name, err)
+		}
+	}
+	if _, err := client.ResumeAccountList(accounts.Cursor(), nil); err != nil {
+		t.Errorf("Error not expected: %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package recurly
+
+import (
+	"sort"
+	"sync"
+)
+
+// CustomFields are the custom fields of a resource, such as an account or a
+// subscription
+type CustomFields []CustomField
+
+// Get returns the value of the custom field, and whether it is set
+func (fields CustomFields) Get(name string) (string, bool) {
+	for _, field := range fields {
+		if field.Name == name {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// Map returns the values of the custom fields by name
+func (fields CustomFields) Map() map[string]string {
+	values := make(map[string]string, len(fields))
+	for _, field := range fields {
+		values[field.Name] = field.Value
+	}
+	return values
+}
+
+// CustomFieldsCreate are the custom fields of a request. Only the fields
+// listed are changed: sending no custom fields doesn't remove any. Use Clear
+// to remove the value of a field.
+type CustomFieldsCreate []CustomFieldCreate
+
+// NewCustomFields returns the custom fields of a request with the values,
+// sorted by name
+func NewCustomFields(values map[string]string) CustomFieldsCreate {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fields := make(CustomFieldsCreate, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, CustomFieldCreate{Name: String(name), Value: String(values[name])})
+	}
+	return fields
+}
+
+// Get returns the value of the custom field, and whether it is set. A field
+// being cleared has an empty value.
+func (fields CustomFieldsCreate) Get(name string) (string, bool) {
+	if i := fields.index(name); i >= 0 {
+		if fields[i].Value == nil {
+			return "", true
+		}
+		return *fields[i].Value, true
+	}
+	return "", false
+}
+
+// Set sets the value of the custom field, replacing its value if it is
+// already in the list
+func (fields *CustomFieldsCreate) Set(name string, value string) {
+	if i := fields.index(name); i >= 0 {
+		(*fields)[i].Value = String(value)
+		return
+	}
+	*fields = append(*fields, CustomFieldCreate{Name: String(name), Value: String(value)})
+}
+
+// Clear removes the value of the custom field when the request is sent,
+// which the API expects as the name of the field with an empty value
+func (fields *CustomFieldsCreate) Clear(name string) {
+	fields.Set(name, "")
+}
+
+// index returns the position of the custom field, or -1
+func (fields CustomFieldsCreate) index(name string) int {
+	for i, field := range fields {
+		if field.Name != nil && *field.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// CustomFieldValidator checks custom fields against the custom field
+// definitions of the site. The definitions are listed the first time they
+// are needed, and kept until Reset is called. It is safe for concurrent use.
+type CustomFieldValidator struct {
+	client *Client
+
+	mu sync.Mutex
+	// definitions are by related type, then name
+	definitions map[RelatedType]map[string]*CustomFieldDefinition
+}
+
+// NewCustomFieldValidator returns a validator using the definitions of the
+// site of the client
+func NewCustomFieldValidator(client *Client) *CustomFieldValidator {
+	return &CustomFieldValidator{client: client}
+}
+
+// Reset drops the cached definitions, so they are listed again by the next
+// validation
+func (validator *CustomFieldValidator) Reset() {
+	validator.mu.Lock()
+	defer validator.mu.Unlock()
+	validator.definitions = nil
+}
+
+// load lists the definitions unless they are cached
+func (validator *CustomFieldValidator) load() (map[RelatedType]map[string]*CustomFieldDefinition, error) {
+	validator.mu.Lock()
+	defer validator.mu.Unlock()
+	if validator.definitions != nil {
+		return validator.definitions, nil
+	}
+
+	definitions := map[RelatedType]map[string]*CustomFieldDefinition{}
+	list := validator.client.ListCustomFieldDefinitions(&ListCustomFieldDefinitionsParams{Limit: Int(200)})
+	for list.Next() {
+		definition := list.Item()
+		if definitions[definition.RelatedType] == nil {
+			definitions[definition.RelatedType] = map[string]*CustomFieldDefinition{}
+		}
+		definitions[definition.RelatedType][definition.Name] = definition
+	}
+	if err := list.Err(); err != nil {
+		return nil, err
+	}
+	validator.definitions = definitions
+	return definitions, nil
+}
+
+// Validate checks the custom fields of a request for a resource of the
+// related type, e.g. RelatedTypeAccount for an AccountCreate. Every field must
+// have a definition which isn't deleted, and follow the rules of the API spec
+// for names and values. It returns an *Error of type ErrorTypeValidation
+// listing the invalid fields, the error of listing the definitions, or nil.
+func (validator *CustomFieldValidator) Validate(relatedType RelatedType, fields CustomFieldsCreate) error {
+	definitions, err := validator.load()
+	if err != nil {
+		return err
+	}
+
+	var invalid []ErrorParam
+	for i := range fields {
+		path := indexPath("", "custom_fields", i) + "."
+		invalid = fields[i].validate(invalid, path)
+		if fields[i].Name == nil {
+			continue
+		}
+		definition := definitions[relatedType][*fields[i].Name]
+		switch {
+		case definition == nil:
+			invalid = append(invalid, ErrorParam{
+				Property: path + "name",
+				Message:  "has no definition for " + string(relatedType) + "s",
+			})
+		case definition.DeletedAt.Valid:
+			invalid = append(invalid, ErrorParam{
+				Property: path + "name",
+				Message:  "has a deleted definition",
+			})
+		}
+	}
+	return requestError(invalid)
+}
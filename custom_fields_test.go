@@ -0,0 +1,83 @@
+package recurly
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCustomFieldsGet(test *testing.T) {
+	t := &T{test}
+	fields := CustomFields{{Name: "tier", Value: "gold"}, {Name: "source", Value: ""}}
+
+	value, ok := fields.Get("tier")
+	t.Assert(value, "gold", "CustomFields.Get(tier)")
+	t.Assert(ok, true, "CustomFields.Get(tier) ok")
+	_, ok = fields.Get("source")
+	t.Assert(ok, true, "CustomFields.Get(source) ok")
+	_, ok = fields.Get("missing")
+	t.Assert(ok, false, "CustomFields.Get(missing) ok")
+	t.Assert(len(fields.Map()), 2, "len(CustomFields.Map())")
+	t.Assert(fields.Map()["tier"], "gold", "CustomFields.Map()[tier]")
+}
+
+func TestCustomFieldsCreateSetAndClear(test *testing.T) {
+	t := &T{test}
+	fields := NewCustomFields(map[string]string{"tier": "gold", "region": "south"})
+	fields.Set("tier", "platinum")
+	fields.Set("team", "blue")
+	fields.Clear("region")
+
+	value, ok := fields.Get("tier")
+	t.Assert(value, "platinum", "CustomFieldsCreate.Get(tier)")
+	t.Assert(ok, true, "CustomFieldsCreate.Get(tier) ok")
+
+	req := &AccountUpdate{CustomFields: fields}
+	data, _ := json.Marshal(req)
+	t.Assert(string(data), `{"custom_fields":[{"name":"region","value":""},{"name":"tier","value":"platinum"},{"name":"team","value":"blue"}]}`, "json.Marshal")
+}
+
+func customFieldDefinitionsScenario(t *T, requests *int) *Client {
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			*requests++
+			t.Assert(req.URL.Path, "/custom_field_definitions", "Request path")
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			body := `{"object": "list", "has_more": false, "data": [
+				{"id": "d1", "related_type": "account", "name": "tier"},
+				{"id": "d2", "related_type": "account", "name": "legacy", "deleted_at": "2020-01-01T00:00:00Z"},
+				{"id": "d3", "related_type": "subscription", "name": "team"}
+			]}`
+			return mockResponse(req, 200, String(body))
+		},
+	}
+	return scenario.MockHTTPClient()
+}
+
+func TestCustomFieldValidator(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	validator := NewCustomFieldValidator(customFieldDefinitionsScenario(t, &requests))
+
+	fields := NewCustomFields(map[string]string{"tier": "gold"})
+	t.Assert(validator.Validate(RelatedTypeAccount, fields), nil, "Validate()")
+
+	fields = CustomFieldsCreate{}
+	fields.Set("tier", "gold")
+	fields.Set("team", "blue")
+	fields.Set("legacy", "yes")
+	fields.Set("bad name", "x")
+	assertInvalid(t, validator.Validate(RelatedTypeAccount, fields),
+		ErrorParam{Property: "custom_fields[1].name", Message: "has no definition for accounts"},
+		ErrorParam{Property: "custom_fields[2].name", Message: "has a deleted definition"},
+		ErrorParam{Property: "custom_fields[3].name", Message: "is invalid"},
+		ErrorParam{Property: "custom_fields[3].name", Message: "has no definition for accounts"},
+	)
+	t.Assert(requests, 1, "Definitions listed once")
+
+	validator.Reset()
+	t.Assert(validator.Validate(RelatedTypeSubscription, NewCustomFields(map[string]string{"team": "blue"})), nil, "Validate()")
+	t.Assert(requests, 2, "Definitions listed again after Reset")
+}
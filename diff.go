@@ -0,0 +1,63 @@
+package recurly
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// emptyRequest reports whether no field of a request is set
+func emptyRequest(request interface{}) bool {
+	value := reflect.Indirect(reflect.ValueOf(request))
+	for i := 0; i < value.NumField(); i++ {
+		if value.Type().Field(i).Name == "Params" {
+			continue
+		}
+		if !isEmptyValue(value.Field(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// stringOrNull returns a pointer to the string, or NullString if it is empty
+func stringOrNull(value string) *string {
+	if value == "" {
+		return NullString()
+	}
+	return &value
+}
+
+// jsonEqual reports whether two values encode to the same JSON
+func jsonEqual(a interface{}, b interface{}) bool {
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
+
+// convertResource sets a request to the fields of a resource it has, through
+// their JSON encoding
+func convertResource(resource interface{}, request interface{}) {
+	data, err := json.Marshal(resource)
+	if err == nil {
+		json.Unmarshal(data, request)
+	}
+}
+
+// diffCustomFields returns the custom fields whose value changed, by name.
+// Fields which were removed are cleared with an empty value. The API keeps
+// the custom fields which aren't sent.
+func diffCustomFields(original CustomFields, desired CustomFields) CustomFieldsCreate {
+	var changed CustomFieldsCreate
+	for _, field := range desired {
+		if value, ok := original.Get(field.Name); !ok || value != field.Value {
+			changed.Set(field.Name, field.Value)
+		}
+	}
+	for _, field := range original {
+		if _, ok := desired.Get(field.Name); !ok && field.Value != "" {
+			changed.Clear(field.Name)
+		}
+	}
+	return changed
+}
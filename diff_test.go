@@ -0,0 +1,92 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiffUnchanged(test *testing.T) {
+	t := &T{test}
+	original := &Account{
+		Code:         "acct",
+		Email:        "a@example.com",
+		Address:      Address{City: "New Orleans"},
+		CustomFields: []CustomField{{Name: "tier", Value: "gold"}},
+	}
+	desired := *original
+	t.Assert(DiffAccount(original, &desired) == nil, true, "DiffAccount() == nil")
+}
+
+func TestDiffAccount(test *testing.T) {
+	t := &T{test}
+	original := &Account{
+		Code:            "acct",
+		Email:           "a@example.com",
+		PreferredLocale: PreferredLocaleEnUS,
+		FirstName:       "Ann",
+		TaxExempt:       true,
+		Address:         Address{City: "New Orleans", Country: "US"},
+		CustomFields: []CustomField{
+			{Name: "tier", Value: "gold"},
+			{Name: "source", Value: "web"},
+			{Name: "team", Value: "blue"},
+		},
+		BillingInfo: BillingInfo{FirstName: "Ann"},
+	}
+	desired := *original
+	desired.Email = "b@example.com"
+	desired.PreferredLocale = ""
+	desired.FirstName = ""
+	desired.TaxExempt = false
+	desired.Address.City = "Baton Rouge"
+	desired.CustomFields = []CustomField{
+		{Name: "tier", Value: "platinum"},
+		{Name: "source", Value: "web"},
+		{Name: "region", Value: "south"},
+	}
+	desired.BillingInfo = BillingInfo{FirstName: "Bob"}
+
+	update := DiffAccount(original, &desired)
+	t.Assert(update.Validate(), nil, "AccountUpdate.Validate()")
+	data, err := json.Marshal(update)
+	t.Assert(err, nil, "json.Marshal")
+	expected := `{"email":"b@example.com","preferred_locale":null,"first_name":null,"tax_exempt":false,` +
+		`"address":{"city":"Baton Rouge"},` +
+		`"custom_fields":[{"name":"tier","value":"platinum"},{"name":"region","value":"south"},{"name":"team","value":""}]}`
+	t.Assert(string(data), expected, "AccountUpdate")
+
+	// the update doesn't share memory with desired
+	desired.Email = "c@example.com"
+	t.Assert(*update.Email, "b@example.com", "AccountUpdate.Email")
+}
+
+func TestDiffPlanAmountsAndLists(test *testing.T) {
+	t := &T{test}
+	original := &Plan{
+		Code:       "gold",
+		Currencies: []PlanPricing{{Currency: "USD", UnitAmount: *NewAmount("10")}},
+	}
+	desired := *original
+	desired.Currencies = []PlanPricing{{Currency: "USD", UnitAmount: *NewAmount("10")}}
+	t.Assert(DiffPlan(original, &desired) == nil, true, "DiffPlan() == nil")
+
+	// the id is read only, so it is never sent
+	desired.Id = "other"
+	t.Assert(DiffPlan(original, &desired) == nil, true, "DiffPlan() with another Id == nil")
+
+	desired.Currencies = []PlanPricing{{Currency: "USD", UnitAmount: *NewAmount("12")}, {Currency: "EUR", UnitAmount: *NewAmount("11")}}
+	update := DiffPlan(original, &desired)
+	t.Assert(len(update.Currencies), 2, "len(PlanUpdate.Currencies)")
+	t.Assert(*update.Currencies[1].Currency, "EUR", "PlanUpdate.Currencies[1].Currency")
+	t.Assert(update.Code == nil, true, "PlanUpdate.Code == nil")
+}
+
+func TestDiffSubscriptionAddOnAmount(test *testing.T) {
+	t := &T{test}
+	original := &SubscriptionAddOn{Quantity: 1, UnitAmount: *NewAmount("5")}
+	desired := *original
+	desired.UnitAmount = *NewAmount("7.5")
+	update := DiffSubscriptionAddOn(original, &desired)
+	t.Assert(update.Quantity == nil, true, "SubscriptionAddOnUpdate.Quantity == nil")
+	t.Assert(*update.UnitAmount, 7.5, "SubscriptionAddOnUpdate.UnitAmount")
+}
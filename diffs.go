@@ -0,0 +1,433 @@
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+// DiffAccount returns the AccountUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffAccount(original *Account, desired *Account) *AccountUpdate {
+	update := &AccountUpdate{}
+	if value := desired.Username; value != original.Username {
+		update.Username = stringOrNull(value)
+	}
+	if value := desired.Email; value != original.Email {
+		update.Email = stringOrNull(value)
+	}
+	if value := desired.PreferredLocale; value != original.PreferredLocale {
+		update.PreferredLocale = &value
+	}
+	if value := desired.CcEmails; value != original.CcEmails {
+		update.CcEmails = stringOrNull(value)
+	}
+	if value := desired.FirstName; value != original.FirstName {
+		update.FirstName = stringOrNull(value)
+	}
+	if value := desired.LastName; value != original.LastName {
+		update.LastName = stringOrNull(value)
+	}
+	if value := desired.Company; value != original.Company {
+		update.Company = stringOrNull(value)
+	}
+	if value := desired.VatNumber; value != original.VatNumber {
+		update.VatNumber = stringOrNull(value)
+	}
+	if value := desired.TaxExempt; value != original.TaxExempt {
+		update.TaxExempt = &value
+	}
+	if value := desired.ExemptionCertificate; value != original.ExemptionCertificate {
+		update.ExemptionCertificate = stringOrNull(value)
+	}
+	if value := desired.ParentAccountId; value != original.ParentAccountId {
+		update.ParentAccountId = stringOrNull(value)
+	}
+	if value := desired.BillTo; value != original.BillTo {
+		update.BillTo = &value
+	}
+	update.Address = diffAddressCreate(&original.Address, &desired.Address)
+	update.CustomFields = diffCustomFields(original.CustomFields, desired.CustomFields)
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// diffAddressCreate returns the AddressCreate with the fields which differ, or nil
+func diffAddressCreate(original *Address, desired *Address) *AddressCreate {
+	update := &AddressCreate{}
+	if value := desired.FirstName; value != original.FirstName {
+		update.FirstName = stringOrNull(value)
+	}
+	if value := desired.LastName; value != original.LastName {
+		update.LastName = stringOrNull(value)
+	}
+	if value := desired.Phone; value != original.Phone {
+		update.Phone = stringOrNull(value)
+	}
+	if value := desired.Street1; value != original.Street1 {
+		update.Street1 = stringOrNull(value)
+	}
+	if value := desired.Street2; value != original.Street2 {
+		update.Street2 = stringOrNull(value)
+	}
+	if value := desired.City; value != original.City {
+		update.City = stringOrNull(value)
+	}
+	if value := desired.Region; value != original.Region {
+		update.Region = stringOrNull(value)
+	}
+	if value := desired.PostalCode; value != original.PostalCode {
+		update.PostalCode = stringOrNull(value)
+	}
+	if value := desired.Country; value != original.Country {
+		update.Country = stringOrNull(value)
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffShippingAddress returns the ShippingAddressUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffShippingAddress(original *ShippingAddress, desired *ShippingAddress) *ShippingAddressUpdate {
+	update := &ShippingAddressUpdate{}
+	if value := desired.Nickname; value != original.Nickname {
+		update.Nickname = stringOrNull(value)
+	}
+	if value := desired.FirstName; value != original.FirstName {
+		update.FirstName = stringOrNull(value)
+	}
+	if value := desired.LastName; value != original.LastName {
+		update.LastName = stringOrNull(value)
+	}
+	if value := desired.Company; value != original.Company {
+		update.Company = stringOrNull(value)
+	}
+	if value := desired.Email; value != original.Email {
+		update.Email = stringOrNull(value)
+	}
+	if value := desired.VatNumber; value != original.VatNumber {
+		update.VatNumber = stringOrNull(value)
+	}
+	if value := desired.Phone; value != original.Phone {
+		update.Phone = stringOrNull(value)
+	}
+	if value := desired.Street1; value != original.Street1 {
+		update.Street1 = stringOrNull(value)
+	}
+	if value := desired.Street2; value != original.Street2 {
+		update.Street2 = stringOrNull(value)
+	}
+	if value := desired.City; value != original.City {
+		update.City = stringOrNull(value)
+	}
+	if value := desired.Region; value != original.Region {
+		update.Region = stringOrNull(value)
+	}
+	if value := desired.PostalCode; value != original.PostalCode {
+		update.PostalCode = stringOrNull(value)
+	}
+	if value := desired.Country; value != original.Country {
+		update.Country = stringOrNull(value)
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffCoupon returns the CouponUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffCoupon(original *Coupon, desired *Coupon) *CouponUpdate {
+	update := &CouponUpdate{}
+	if value := desired.Name; value != original.Name {
+		update.Name = stringOrNull(value)
+	}
+	if value := desired.MaxRedemptions; value != original.MaxRedemptions {
+		update.MaxRedemptions = &value
+	}
+	if value := desired.MaxRedemptionsPerAccount; value != original.MaxRedemptionsPerAccount {
+		update.MaxRedemptionsPerAccount = &value
+	}
+	if value := desired.InvoiceDescription; value != original.InvoiceDescription {
+		update.InvoiceDescription = stringOrNull(value)
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffSubscription returns the SubscriptionUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffSubscription(original *Subscription, desired *Subscription) *SubscriptionUpdate {
+	update := &SubscriptionUpdate{}
+	if value := desired.CollectionMethod; value != original.CollectionMethod {
+		update.CollectionMethod = &value
+	}
+	update.CustomFields = diffCustomFields(original.CustomFields, desired.CustomFields)
+	if value := desired.RemainingBillingCycles; value != original.RemainingBillingCycles {
+		update.RemainingBillingCycles = &value
+	}
+	if value := desired.RenewalBillingCycles; value != original.RenewalBillingCycles {
+		update.RenewalBillingCycles = &value
+	}
+	if value := desired.AutoRenew; value != original.AutoRenew {
+		update.AutoRenew = &value
+	}
+	if value := desired.RevenueScheduleType; value != original.RevenueScheduleType {
+		update.RevenueScheduleType = &value
+	}
+	if value := desired.TermsAndConditions; value != original.TermsAndConditions {
+		update.TermsAndConditions = stringOrNull(value)
+	}
+	if value := desired.CustomerNotes; value != original.CustomerNotes {
+		update.CustomerNotes = stringOrNull(value)
+	}
+	if value := desired.PoNumber; value != original.PoNumber {
+		update.PoNumber = stringOrNull(value)
+	}
+	if value := desired.NetTerms; value != original.NetTerms {
+		update.NetTerms = &value
+	}
+	update.Shipping = DiffSubscriptionShipping(&original.Shipping, &desired.Shipping)
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffSubscriptionShipping returns the SubscriptionShippingUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffSubscriptionShipping(original *SubscriptionShipping, desired *SubscriptionShipping) *SubscriptionShippingUpdate {
+	update := &SubscriptionShippingUpdate{}
+	if value := desired.Object; value != original.Object {
+		update.Object = stringOrNull(value)
+	}
+	update.Address = diffShippingAddressCreate(&original.Address, &desired.Address)
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// diffShippingAddressCreate returns the ShippingAddressCreate with the fields which differ, or nil
+func diffShippingAddressCreate(original *ShippingAddress, desired *ShippingAddress) *ShippingAddressCreate {
+	update := &ShippingAddressCreate{}
+	if value := desired.Nickname; value != original.Nickname {
+		update.Nickname = stringOrNull(value)
+	}
+	if value := desired.FirstName; value != original.FirstName {
+		update.FirstName = stringOrNull(value)
+	}
+	if value := desired.LastName; value != original.LastName {
+		update.LastName = stringOrNull(value)
+	}
+	if value := desired.Company; value != original.Company {
+		update.Company = stringOrNull(value)
+	}
+	if value := desired.Email; value != original.Email {
+		update.Email = stringOrNull(value)
+	}
+	if value := desired.VatNumber; value != original.VatNumber {
+		update.VatNumber = stringOrNull(value)
+	}
+	if value := desired.Phone; value != original.Phone {
+		update.Phone = stringOrNull(value)
+	}
+	if value := desired.Street1; value != original.Street1 {
+		update.Street1 = stringOrNull(value)
+	}
+	if value := desired.Street2; value != original.Street2 {
+		update.Street2 = stringOrNull(value)
+	}
+	if value := desired.City; value != original.City {
+		update.City = stringOrNull(value)
+	}
+	if value := desired.Region; value != original.Region {
+		update.Region = stringOrNull(value)
+	}
+	if value := desired.PostalCode; value != original.PostalCode {
+		update.PostalCode = stringOrNull(value)
+	}
+	if value := desired.Country; value != original.Country {
+		update.Country = stringOrNull(value)
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffSubscriptionAddOn returns the SubscriptionAddOnUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffSubscriptionAddOn(original *SubscriptionAddOn, desired *SubscriptionAddOn) *SubscriptionAddOnUpdate {
+	update := &SubscriptionAddOnUpdate{}
+	if value := desired.Id; value != original.Id {
+		update.Id = stringOrNull(value)
+	}
+	if value := desired.Quantity; value != original.Quantity {
+		update.Quantity = &value
+	}
+	if value := desired.UnitAmount; value != original.UnitAmount {
+		update.UnitAmount = &value
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffItem returns the ItemUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffItem(original *Item, desired *Item) *ItemUpdate {
+	update := &ItemUpdate{}
+	if value := desired.Code; value != original.Code {
+		update.Code = stringOrNull(value)
+	}
+	if value := desired.Name; value != original.Name {
+		update.Name = stringOrNull(value)
+	}
+	if value := desired.Description; value != original.Description {
+		update.Description = stringOrNull(value)
+	}
+	if value := desired.ExternalSku; value != original.ExternalSku {
+		update.ExternalSku = stringOrNull(value)
+	}
+	if value := desired.AccountingCode; value != original.AccountingCode {
+		update.AccountingCode = stringOrNull(value)
+	}
+	if value := desired.RevenueScheduleType; value != original.RevenueScheduleType {
+		update.RevenueScheduleType = &value
+	}
+	if value := desired.TaxCode; value != original.TaxCode {
+		update.TaxCode = stringOrNull(value)
+	}
+	if value := desired.TaxExempt; value != original.TaxExempt {
+		update.TaxExempt = &value
+	}
+	update.CustomFields = diffCustomFields(original.CustomFields, desired.CustomFields)
+	if !jsonEqual(original.Currencies, desired.Currencies) {
+		convertResource(desired.Currencies, &update.Currencies)
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffPlan returns the PlanUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffPlan(original *Plan, desired *Plan) *PlanUpdate {
+	update := &PlanUpdate{}
+	if value := desired.Code; value != original.Code {
+		update.Code = stringOrNull(value)
+	}
+	if value := desired.Name; value != original.Name {
+		update.Name = stringOrNull(value)
+	}
+	if value := desired.Description; value != original.Description {
+		update.Description = stringOrNull(value)
+	}
+	if value := desired.AccountingCode; value != original.AccountingCode {
+		update.AccountingCode = stringOrNull(value)
+	}
+	if value := desired.TrialUnit; value != original.TrialUnit {
+		update.TrialUnit = &value
+	}
+	if value := desired.TrialLength; value != original.TrialLength {
+		update.TrialLength = &value
+	}
+	if value := desired.TotalBillingCycles; value != original.TotalBillingCycles {
+		update.TotalBillingCycles = &value
+	}
+	if value := desired.AutoRenew; value != original.AutoRenew {
+		update.AutoRenew = &value
+	}
+	if value := desired.RevenueScheduleType; value != original.RevenueScheduleType {
+		update.RevenueScheduleType = &value
+	}
+	if value := desired.SetupFeeRevenueScheduleType; value != original.SetupFeeRevenueScheduleType {
+		update.SetupFeeRevenueScheduleType = &value
+	}
+	if value := desired.SetupFeeAccountingCode; value != original.SetupFeeAccountingCode {
+		update.SetupFeeAccountingCode = stringOrNull(value)
+	}
+	if value := desired.TaxCode; value != original.TaxCode {
+		update.TaxCode = stringOrNull(value)
+	}
+	if value := desired.TaxExempt; value != original.TaxExempt {
+		update.TaxExempt = &value
+	}
+	if !jsonEqual(original.Currencies, desired.Currencies) {
+		convertResource(desired.Currencies, &update.Currencies)
+	}
+	update.HostedPages = diffPlanHostedPagesCreate(&original.HostedPages, &desired.HostedPages)
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// diffPlanHostedPagesCreate returns the PlanHostedPagesCreate with the fields which differ, or nil
+func diffPlanHostedPagesCreate(original *PlanHostedPages, desired *PlanHostedPages) *PlanHostedPagesCreate {
+	update := &PlanHostedPagesCreate{}
+	if value := desired.SuccessUrl; value != original.SuccessUrl {
+		update.SuccessUrl = stringOrNull(value)
+	}
+	if value := desired.CancelUrl; value != original.CancelUrl {
+		update.CancelUrl = stringOrNull(value)
+	}
+	if value := desired.BypassConfirmation; value != original.BypassConfirmation {
+		update.BypassConfirmation = &value
+	}
+	if value := desired.DisplayQuantity; value != original.DisplayQuantity {
+		update.DisplayQuantity = &value
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
+
+// DiffAddOn returns the AddOnUpdate which changes original into
+// desired. It only sets the fields which differ, and returns nil if there are
+// none.
+func DiffAddOn(original *AddOn, desired *AddOn) *AddOnUpdate {
+	update := &AddOnUpdate{}
+	if value := desired.Code; value != original.Code {
+		update.Code = stringOrNull(value)
+	}
+	if value := desired.Name; value != original.Name {
+		update.Name = stringOrNull(value)
+	}
+	if value := desired.AccountingCode; value != original.AccountingCode {
+		update.AccountingCode = stringOrNull(value)
+	}
+	if value := desired.RevenueScheduleType; value != original.RevenueScheduleType {
+		update.RevenueScheduleType = &value
+	}
+	if value := desired.TaxCode; value != original.TaxCode {
+		update.TaxCode = stringOrNull(value)
+	}
+	if value := desired.DisplayQuantity; value != original.DisplayQuantity {
+		update.DisplayQuantity = &value
+	}
+	if value := desired.DefaultQuantity; value != original.DefaultQuantity {
+		update.DefaultQuantity = &value
+	}
+	if !jsonEqual(original.Currencies, desired.Currencies) {
+		convertResource(desired.Currencies, &update.Currencies)
+	}
+	if emptyRequest(update) {
+		return nil
+	}
+	return update
+}
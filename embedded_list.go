@@ -0,0 +1,68 @@
+package recurly
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// errDetachedList stops the paging of an embedded list whose resource wasn't
+// returned by a client, e.g. one decoded with json.Unmarshal
+var errDetachedList = errors.New("recurly: the embedded list has more items, but its resource was not returned by a client")
+
+// clientAttacher is implemented by the resources, and pages of resources,
+// which embed lists. The client attaches itself to them once they are decoded,
+// so their lists can fetch the pages after the embedded one.
+type clientAttacher interface {
+	attachClient(c *Client)
+}
+
+// embed sets up the pager of a list embedded in a resource. The items of the
+// embedded page were decoded with the resource, so it counts as the first
+// page fetched. data is the JSON of the embedded list.
+func (p *pager) embed(meta ListMetadata, items int, data []byte) {
+	p.embedded = append(json.RawMessage(nil), data...)
+	p.nextPagePath = meta.Next
+	p.page = 1
+	p.position = 0
+	p.skip = 0
+	if meta.HasMore && meta.Next == "" {
+		// without a link to the next page, the list is fetched again from
+		// the start, skipping the items which were embedded
+		p.skip = items
+	}
+}
+
+// attach lets an embedded list fetch its next pages with the client. path
+// lists every item of the list, and is used when the embedded list has more
+// items but no link to its next page. It is empty when the API has no such
+// operation.
+func (p *pager) attach(c *Client, path string) {
+	if p.embedded == nil {
+		return
+	}
+	p.client = c
+	if p.nextPagePath == "" && p.skip > 0 {
+		p.nextPagePath = path
+	}
+}
+
+// detached reports whether the list is embedded in a resource which can't
+// fetch its next pages: one which wasn't returned by a client, or whose list
+// has no link to them
+func (p *pager) detached() bool {
+	return p.embedded != nil && (p.client == nil || p.nextPagePath == "")
+}
+
+// absent reports whether the list wasn't decoded from the JSON of its
+// resource, which leaves it out when the resource is encoded
+func (p pager) absent() bool {
+	return p.embedded == nil
+}
+
+// embeddedJSON returns the JSON the embedded list was decoded from
+func (p pager) embeddedJSON() ([]byte, error) {
+	if p.embedded == nil {
+		return []byte("null"), nil
+	}
+	return p.embedded, nil
+}
@@ -0,0 +1,85 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// lineItemIDs reads the rest of the line items, and returns their IDs
+func lineItemIDs(t *T, list *LineItemList) []string {
+	var ids []string
+	for list.Next() {
+		ids = append(ids, list.Item().Id)
+	}
+	if err := list.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	return ids
+}
+
+func TestEmbeddedLineItemsFollowNext(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"id":"inv1","line_items":{"object":"list","has_more":true,"next":"/invoices/inv1/line_items?cursor=2","data":[{"id":"a"},{"id":"b"}]}}`,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"c"}]}`,
+	)
+	client := scenario.MockHTTPClient()
+
+	invoice, err := client.GetInvoice("inv1")
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(invoice.LineItems.HasMore, true, "LineItems.HasMore")
+	t.Assert(len(invoice.LineItems.Data), 2, "len(LineItems.Data)")
+	t.Assert(invoice.LineItems.Page(), 1, "LineItems.Page()")
+
+	ids := lineItemIDs(t, &invoice.LineItems)
+	t.Assert(len(ids), 3, "Number of line items")
+	t.Assert(ids[2], "c", "Last line item")
+	t.Assert(invoice.LineItems.Page(), 2, "LineItems.Page()")
+	t.Assert(len(*requested), 2, "Number of requests")
+	t.Assert((*requested)[1], "/invoices/inv1/line_items?cursor=2", "Second page")
+}
+
+func TestEmbeddedLineItemsWithoutNext(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"object":"list","has_more":false,"data":[{"id":"inv1","line_items":{"object":"list","has_more":true,"data":[{"id":"a"},{"id":"b"}]}}]}`,
+		`{"object":"list","has_more":true,"next":"/invoices/inv1/line_items?cursor=2","data":[{"id":"a"}]}`,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"b"},{"id":"c"}]}`,
+	)
+	client := scenario.MockHTTPClient()
+
+	invoices := client.ListInvoices(nil)
+	if !invoices.Next() {
+		t.Fatalf("Expected an invoice, got %v", invoices.Err())
+	}
+	// the list is fetched again from the start, skipping the embedded items
+	ids := lineItemIDs(t, &invoices.Item().LineItems)
+	t.Assert(len(ids), 3, "Number of line items")
+	for i, id := range []string{"a", "b", "c"} {
+		t.Assert(ids[i], id, "Line item")
+	}
+	t.Assert((*requested)[1], "/invoices/inv1/line_items", "First page listed again")
+	t.Assert(len(*requested), 3, "Number of requests")
+}
+
+func TestEmbeddedLineItemsDecodedWithoutClient(test *testing.T) {
+	t := &T{test}
+	data := `{"id":"inv1","line_items":{"object":"list","has_more":true,"next":"/invoices/inv1/line_items?cursor=2","data":[{"id":"a"}]}}`
+	invoice := &Invoice{}
+	if err := json.Unmarshal([]byte(data), invoice); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	t.Assert(invoice.LineItems.Next(), true, "Next on the embedded item")
+	t.Assert(invoice.LineItems.Item().Id, "a", "Embedded line item")
+	t.Assert(invoice.LineItems.Next(), false, "Next after the embedded items")
+	t.Assert(invoice.LineItems.Err(), errDetachedList, "Err")
+
+	encoded, err := json.Marshal(invoice)
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(string(encoded), data, "json.Marshal")
+
+	encoded, err = json.Marshal(&Invoice{Id: "inv2"})
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(string(encoded), `{"id":"inv2"}`, "json.Marshal without line items")
+}
@@ -0,0 +1,1472 @@
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+// AccountAcquisitionChannel is a value of the `channel` of AccountAcquisition
+type AccountAcquisitionChannel string
+
+const (
+	AccountAcquisitionChannelReferral         AccountAcquisitionChannel = "referral"
+	AccountAcquisitionChannelSocialMedia      AccountAcquisitionChannel = "social_media"
+	AccountAcquisitionChannelEmail            AccountAcquisitionChannel = "email"
+	AccountAcquisitionChannelPaidSearch       AccountAcquisitionChannel = "paid_search"
+	AccountAcquisitionChannelOrganicSearch    AccountAcquisitionChannel = "organic_search"
+	AccountAcquisitionChannelDirectTraffic    AccountAcquisitionChannel = "direct_traffic"
+	AccountAcquisitionChannelMarketingContent AccountAcquisitionChannel = "marketing_content"
+	AccountAcquisitionChannelBlog             AccountAcquisitionChannel = "blog"
+	AccountAcquisitionChannelEvents           AccountAcquisitionChannel = "events"
+	AccountAcquisitionChannelOutboundSales    AccountAcquisitionChannel = "outbound_sales"
+	AccountAcquisitionChannelAdvertising      AccountAcquisitionChannel = "advertising"
+	AccountAcquisitionChannelPublicRelations  AccountAcquisitionChannel = "public_relations"
+	AccountAcquisitionChannelOther            AccountAcquisitionChannel = "other"
+)
+
+// accountAcquisitionChannelValues are the values of AccountAcquisitionChannel listed in the API spec
+var accountAcquisitionChannelValues = []string{"referral", "social_media", "email", "paid_search", "organic_search", "direct_traffic", "marketing_content", "blog", "events", "outbound_sales", "advertising", "public_relations", "other"}
+
+// IsKnown reports whether the AccountAcquisitionChannel is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value AccountAcquisitionChannel) IsKnown() bool {
+	return containsString(accountAcquisitionChannelValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value AccountAcquisitionChannel) Ptr() *AccountAcquisitionChannel {
+	return &value
+}
+
+// AccountState is a value of the `state` of Account
+type AccountState string
+
+const (
+	AccountStateActive   AccountState = "active"
+	AccountStateInactive AccountState = "inactive"
+)
+
+// accountStateValues are the values of AccountState listed in the API spec
+var accountStateValues = []string{"active", "inactive"}
+
+// IsKnown reports whether the AccountState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value AccountState) IsKnown() bool {
+	return containsString(accountStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value AccountState) Ptr() *AccountState {
+	return &value
+}
+
+// AccountTransactionType is a value of the `transaction_type` of Account
+type AccountTransactionType string
+
+const (
+	AccountTransactionTypeMoto AccountTransactionType = "moto"
+)
+
+// accountTransactionTypeValues are the values of AccountTransactionType listed in the API spec
+var accountTransactionTypeValues = []string{"moto"}
+
+// IsKnown reports whether the AccountTransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value AccountTransactionType) IsKnown() bool {
+	return containsString(accountTransactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value AccountTransactionType) Ptr() *AccountTransactionType {
+	return &value
+}
+
+// AccountType is a value of the `account_type` of PaymentMethod and BillingInfo
+type AccountType string
+
+const (
+	AccountTypeChecking AccountType = "checking"
+	AccountTypeSavings  AccountType = "savings"
+)
+
+// accountTypeValues are the values of AccountType listed in the API spec
+var accountTypeValues = []string{"checking", "savings"}
+
+// IsKnown reports whether the AccountType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value AccountType) IsKnown() bool {
+	return containsString(accountTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value AccountType) Ptr() *AccountType {
+	return &value
+}
+
+// AddOnState is a value of the `state` of AddOn
+type AddOnState string
+
+const (
+	AddOnStateActive   AddOnState = "active"
+	AddOnStateInactive AddOnState = "inactive"
+)
+
+// addOnStateValues are the values of AddOnState listed in the API spec
+var addOnStateValues = []string{"active", "inactive"}
+
+// IsKnown reports whether the AddOnState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value AddOnState) IsKnown() bool {
+	return containsString(addOnStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value AddOnState) Ptr() *AddOnState {
+	return &value
+}
+
+// AvsCheck is a value of the `avs_check` of Transaction
+type AvsCheck string
+
+const (
+	AvsCheckA AvsCheck = "A"
+	AvsCheckB AvsCheck = "B"
+	AvsCheckC AvsCheck = "C"
+	AvsCheckD AvsCheck = "D"
+	AvsCheckE AvsCheck = "E"
+	AvsCheckF AvsCheck = "F"
+	AvsCheckG AvsCheck = "G"
+	AvsCheckH AvsCheck = "H"
+	AvsCheckI AvsCheck = "I"
+	AvsCheckJ AvsCheck = "J"
+	AvsCheckK AvsCheck = "K"
+	AvsCheckL AvsCheck = "L"
+	AvsCheckM AvsCheck = "M"
+	AvsCheckN AvsCheck = "N"
+	AvsCheckO AvsCheck = "O"
+	AvsCheckP AvsCheck = "P"
+	AvsCheckQ AvsCheck = "Q"
+	AvsCheckR AvsCheck = "R"
+	AvsCheckS AvsCheck = "S"
+	AvsCheckT AvsCheck = "T"
+	AvsCheckU AvsCheck = "U"
+	AvsCheckV AvsCheck = "V"
+	AvsCheckW AvsCheck = "W"
+	AvsCheckX AvsCheck = "X"
+	AvsCheckY AvsCheck = "Y"
+	AvsCheckZ AvsCheck = "Z"
+)
+
+// avsCheckValues are the values of AvsCheck listed in the API spec
+var avsCheckValues = []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z"}
+
+// IsKnown reports whether the AvsCheck is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value AvsCheck) IsKnown() bool {
+	return containsString(avsCheckValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value AvsCheck) Ptr() *AvsCheck {
+	return &value
+}
+
+// BillTo is a value of the `bill_to` of Account
+type BillTo string
+
+const (
+	BillToSelf   BillTo = "self"
+	BillToParent BillTo = "parent"
+)
+
+// billToValues are the values of BillTo listed in the API spec
+var billToValues = []string{"self", "parent"}
+
+// IsKnown reports whether the BillTo is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value BillTo) IsKnown() bool {
+	return containsString(billToValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value BillTo) Ptr() *BillTo {
+	return &value
+}
+
+// BillingAddressRequirement is a value of the `billing_address_requirement` of Settings
+type BillingAddressRequirement string
+
+const (
+	BillingAddressRequirementFull      BillingAddressRequirement = "full"
+	BillingAddressRequirementStreetzip BillingAddressRequirement = "streetzip"
+	BillingAddressRequirementZip       BillingAddressRequirement = "zip"
+	BillingAddressRequirementNone      BillingAddressRequirement = "none"
+)
+
+// billingAddressRequirementValues are the values of BillingAddressRequirement listed in the API spec
+var billingAddressRequirementValues = []string{"full", "streetzip", "zip", "none"}
+
+// IsKnown reports whether the BillingAddressRequirement is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value BillingAddressRequirement) IsKnown() bool {
+	return containsString(billingAddressRequirementValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value BillingAddressRequirement) Ptr() *BillingAddressRequirement {
+	return &value
+}
+
+// BillingInfoTransactionType is a value of the `transaction_type` of BillingInfo
+type BillingInfoTransactionType string
+
+const (
+	BillingInfoTransactionTypeMoto BillingInfoTransactionType = "moto"
+)
+
+// billingInfoTransactionTypeValues are the values of BillingInfoTransactionType listed in the API spec
+var billingInfoTransactionTypeValues = []string{"moto"}
+
+// IsKnown reports whether the BillingInfoTransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value BillingInfoTransactionType) IsKnown() bool {
+	return containsString(billingInfoTransactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value BillingInfoTransactionType) Ptr() *BillingInfoTransactionType {
+	return &value
+}
+
+// CardType is a value of the `card_type` of PaymentMethod
+type CardType string
+
+const (
+	CardTypeAmericanExpress    CardType = "American Express"
+	CardTypeDankort            CardType = "Dankort"
+	CardTypeDinersClub         CardType = "Diners Club"
+	CardTypeDiscover           CardType = "Discover"
+	CardTypeForbrugsforeningen CardType = "Forbrugsforeningen"
+	CardTypeJCB                CardType = "JCB"
+	CardTypeLaser              CardType = "Laser"
+	CardTypeMaestro            CardType = "Maestro"
+	CardTypeMasterCard         CardType = "MasterCard"
+	CardTypeTestCard           CardType = "Test Card"
+	CardTypeUnknown            CardType = "Unknown"
+	CardTypeVisa               CardType = "Visa"
+)
+
+// cardTypeValues are the values of CardType listed in the API spec
+var cardTypeValues = []string{"American Express", "Dankort", "Diners Club", "Discover", "Forbrugsforeningen", "JCB", "Laser", "Maestro", "MasterCard", "Test Card", "Unknown", "Visa"}
+
+// IsKnown reports whether the CardType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CardType) IsKnown() bool {
+	return containsString(cardTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CardType) Ptr() *CardType {
+	return &value
+}
+
+// CollectionMethod is a value of the `collection_method` of Transaction, Invoice, Subscription, SubscriptionChange and Purchase
+type CollectionMethod string
+
+const (
+	CollectionMethodAutomatic CollectionMethod = "automatic"
+	CollectionMethodManual    CollectionMethod = "manual"
+)
+
+// collectionMethodValues are the values of CollectionMethod listed in the API spec
+var collectionMethodValues = []string{"automatic", "manual"}
+
+// IsKnown reports whether the CollectionMethod is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CollectionMethod) IsKnown() bool {
+	return containsString(collectionMethodValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CollectionMethod) Ptr() *CollectionMethod {
+	return &value
+}
+
+// CouponDiscountTrialUnit is a value of the `unit` of CouponDiscountTrial
+type CouponDiscountTrialUnit string
+
+const (
+	CouponDiscountTrialUnitDay   CouponDiscountTrialUnit = "day"
+	CouponDiscountTrialUnitWeek  CouponDiscountTrialUnit = "week"
+	CouponDiscountTrialUnitMonth CouponDiscountTrialUnit = "month"
+)
+
+// couponDiscountTrialUnitValues are the values of CouponDiscountTrialUnit listed in the API spec
+var couponDiscountTrialUnitValues = []string{"day", "week", "month"}
+
+// IsKnown reports whether the CouponDiscountTrialUnit is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CouponDiscountTrialUnit) IsKnown() bool {
+	return containsString(couponDiscountTrialUnitValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CouponDiscountTrialUnit) Ptr() *CouponDiscountTrialUnit {
+	return &value
+}
+
+// CouponDiscountType is a value of the `type` of CouponDiscount
+type CouponDiscountType string
+
+const (
+	CouponDiscountTypePercent   CouponDiscountType = "percent"
+	CouponDiscountTypeFixed     CouponDiscountType = "fixed"
+	CouponDiscountTypeFreeTrial CouponDiscountType = "free_trial"
+)
+
+// couponDiscountTypeValues are the values of CouponDiscountType listed in the API spec
+var couponDiscountTypeValues = []string{"percent", "fixed", "free_trial"}
+
+// IsKnown reports whether the CouponDiscountType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CouponDiscountType) IsKnown() bool {
+	return containsString(couponDiscountTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CouponDiscountType) Ptr() *CouponDiscountType {
+	return &value
+}
+
+// CouponDuration is a value of the `duration` of Coupon
+type CouponDuration string
+
+const (
+	CouponDurationForever   CouponDuration = "forever"
+	CouponDurationSingleUse CouponDuration = "single_use"
+	CouponDurationTemporal  CouponDuration = "temporal"
+)
+
+// couponDurationValues are the values of CouponDuration listed in the API spec
+var couponDurationValues = []string{"forever", "single_use", "temporal"}
+
+// IsKnown reports whether the CouponDuration is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CouponDuration) IsKnown() bool {
+	return containsString(couponDurationValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CouponDuration) Ptr() *CouponDuration {
+	return &value
+}
+
+// CouponRedemptionState is a value of the `state` of CouponRedemption
+type CouponRedemptionState string
+
+const (
+	CouponRedemptionStateActive   CouponRedemptionState = "active"
+	CouponRedemptionStateInactive CouponRedemptionState = "inactive"
+)
+
+// couponRedemptionStateValues are the values of CouponRedemptionState listed in the API spec
+var couponRedemptionStateValues = []string{"active", "inactive"}
+
+// IsKnown reports whether the CouponRedemptionState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CouponRedemptionState) IsKnown() bool {
+	return containsString(couponRedemptionStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CouponRedemptionState) Ptr() *CouponRedemptionState {
+	return &value
+}
+
+// CouponState is a value of the `state` of Coupon
+type CouponState string
+
+const (
+	CouponStateRedeemable CouponState = "redeemable"
+	CouponStateMaxedOut   CouponState = "maxed_out"
+	CouponStateExpired    CouponState = "expired"
+	CouponStateInactive   CouponState = "inactive"
+)
+
+// couponStateValues are the values of CouponState listed in the API spec
+var couponStateValues = []string{"redeemable", "maxed_out", "expired", "inactive"}
+
+// IsKnown reports whether the CouponState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CouponState) IsKnown() bool {
+	return containsString(couponStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CouponState) Ptr() *CouponState {
+	return &value
+}
+
+// CouponType is a value of the `coupon_type` of Coupon
+type CouponType string
+
+const (
+	CouponTypeSingleCode CouponType = "single_code"
+	CouponTypeBulk       CouponType = "bulk"
+)
+
+// couponTypeValues are the values of CouponType listed in the API spec
+var couponTypeValues = []string{"single_code", "bulk"}
+
+// IsKnown reports whether the CouponType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CouponType) IsKnown() bool {
+	return containsString(couponTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CouponType) Ptr() *CouponType {
+	return &value
+}
+
+// CreditPaymentAction is a value of the `action` of CreditPayment
+type CreditPaymentAction string
+
+const (
+	CreditPaymentActionPayment   CreditPaymentAction = "payment"
+	CreditPaymentActionRefund    CreditPaymentAction = "refund"
+	CreditPaymentActionReduction CreditPaymentAction = "reduction"
+	CreditPaymentActionWriteOff  CreditPaymentAction = "write_off"
+)
+
+// creditPaymentActionValues are the values of CreditPaymentAction listed in the API spec
+var creditPaymentActionValues = []string{"payment", "refund", "reduction", "write_off"}
+
+// IsKnown reports whether the CreditPaymentAction is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CreditPaymentAction) IsKnown() bool {
+	return containsString(creditPaymentActionValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CreditPaymentAction) Ptr() *CreditPaymentAction {
+	return &value
+}
+
+// CreditReasonCode is a value of the `credit_reason_code` of LineItem
+type CreditReasonCode string
+
+const (
+	CreditReasonCodeGeneral     CreditReasonCode = "general"
+	CreditReasonCodeService     CreditReasonCode = "service"
+	CreditReasonCodePromotional CreditReasonCode = "promotional"
+	CreditReasonCodeRefund      CreditReasonCode = "refund"
+	CreditReasonCodeGiftCard    CreditReasonCode = "gift_card"
+	CreditReasonCodeWriteOff    CreditReasonCode = "write_off"
+)
+
+// creditReasonCodeValues are the values of CreditReasonCode listed in the API spec
+var creditReasonCodeValues = []string{"general", "service", "promotional", "refund", "gift_card", "write_off"}
+
+// IsKnown reports whether the CreditReasonCode is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CreditReasonCode) IsKnown() bool {
+	return containsString(creditReasonCodeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CreditReasonCode) Ptr() *CreditReasonCode {
+	return &value
+}
+
+// CvvCheck is a value of the `cvv_check` of Transaction
+type CvvCheck string
+
+const (
+	CvvCheckD CvvCheck = "D"
+	CvvCheckI CvvCheck = "I"
+	CvvCheckM CvvCheck = "M"
+	CvvCheckN CvvCheck = "N"
+	CvvCheckP CvvCheck = "P"
+	CvvCheckS CvvCheck = "S"
+	CvvCheckU CvvCheck = "U"
+	CvvCheckX CvvCheck = "X"
+)
+
+// cvvCheckValues are the values of CvvCheck listed in the API spec
+var cvvCheckValues = []string{"D", "I", "M", "N", "P", "S", "U", "X"}
+
+// IsKnown reports whether the CvvCheck is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value CvvCheck) IsKnown() bool {
+	return containsString(cvvCheckValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value CvvCheck) Ptr() *CvvCheck {
+	return &value
+}
+
+// DiscountType is a value of the `discount_type` of Coupon
+type DiscountType string
+
+const (
+	DiscountTypePercent   DiscountType = "percent"
+	DiscountTypeFixed     DiscountType = "fixed"
+	DiscountTypeFreeTrial DiscountType = "free_trial"
+)
+
+// discountTypeValues are the values of DiscountType listed in the API spec
+var discountTypeValues = []string{"percent", "fixed", "free_trial"}
+
+// IsKnown reports whether the DiscountType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value DiscountType) IsKnown() bool {
+	return containsString(discountTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value DiscountType) Ptr() *DiscountType {
+	return &value
+}
+
+// ExternalRefundPaymentMethod is a value of the `payment_method` of ExternalRefund
+type ExternalRefundPaymentMethod string
+
+const (
+	ExternalRefundPaymentMethodCreditCard      ExternalRefundPaymentMethod = "credit_card"
+	ExternalRefundPaymentMethodPaypal          ExternalRefundPaymentMethod = "paypal"
+	ExternalRefundPaymentMethodAmazon          ExternalRefundPaymentMethod = "amazon"
+	ExternalRefundPaymentMethodRoku            ExternalRefundPaymentMethod = "roku"
+	ExternalRefundPaymentMethodAch             ExternalRefundPaymentMethod = "ach"
+	ExternalRefundPaymentMethodApplePay        ExternalRefundPaymentMethod = "apple_pay"
+	ExternalRefundPaymentMethodSepadirectdebit ExternalRefundPaymentMethod = "sepadirectdebit"
+	ExternalRefundPaymentMethodEft             ExternalRefundPaymentMethod = "eft"
+	ExternalRefundPaymentMethodWireTransfer    ExternalRefundPaymentMethod = "wire_transfer"
+	ExternalRefundPaymentMethodMoneyOrder      ExternalRefundPaymentMethod = "money_order"
+	ExternalRefundPaymentMethodCheck           ExternalRefundPaymentMethod = "check"
+	ExternalRefundPaymentMethodOther           ExternalRefundPaymentMethod = "other"
+)
+
+// externalRefundPaymentMethodValues are the values of ExternalRefundPaymentMethod listed in the API spec
+var externalRefundPaymentMethodValues = []string{"credit_card", "paypal", "amazon", "roku", "ach", "apple_pay", "sepadirectdebit", "eft", "wire_transfer", "money_order", "check", "other"}
+
+// IsKnown reports whether the ExternalRefundPaymentMethod is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value ExternalRefundPaymentMethod) IsKnown() bool {
+	return containsString(externalRefundPaymentMethodValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value ExternalRefundPaymentMethod) Ptr() *ExternalRefundPaymentMethod {
+	return &value
+}
+
+// FraudInfoDecision is a value of the `decision` of FraudInfo
+type FraudInfoDecision string
+
+const (
+	FraudInfoDecisionApprove  FraudInfoDecision = "approve"
+	FraudInfoDecisionReview   FraudInfoDecision = "review"
+	FraudInfoDecisionDecline  FraudInfoDecision = "decline"
+	FraudInfoDecisionEscalate FraudInfoDecision = "escalate"
+)
+
+// fraudInfoDecisionValues are the values of FraudInfoDecision listed in the API spec
+var fraudInfoDecisionValues = []string{"approve", "review", "decline", "escalate"}
+
+// IsKnown reports whether the FraudInfoDecision is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value FraudInfoDecision) IsKnown() bool {
+	return containsString(fraudInfoDecisionValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value FraudInfoDecision) Ptr() *FraudInfoDecision {
+	return &value
+}
+
+// FreeTrialUnit is a value of the `free_trial_unit` of Coupon
+type FreeTrialUnit string
+
+const (
+	FreeTrialUnitDay   FreeTrialUnit = "day"
+	FreeTrialUnitWeek  FreeTrialUnit = "week"
+	FreeTrialUnitMonth FreeTrialUnit = "month"
+)
+
+// freeTrialUnitValues are the values of FreeTrialUnit listed in the API spec
+var freeTrialUnitValues = []string{"day", "week", "month"}
+
+// IsKnown reports whether the FreeTrialUnit is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value FreeTrialUnit) IsKnown() bool {
+	return containsString(freeTrialUnitValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value FreeTrialUnit) Ptr() *FreeTrialUnit {
+	return &value
+}
+
+// IntervalUnit is a value of the `interval_unit` of Plan
+type IntervalUnit string
+
+const (
+	IntervalUnitDays   IntervalUnit = "days"
+	IntervalUnitMonths IntervalUnit = "months"
+)
+
+// intervalUnitValues are the values of IntervalUnit listed in the API spec
+var intervalUnitValues = []string{"days", "months"}
+
+// IsKnown reports whether the IntervalUnit is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value IntervalUnit) IsKnown() bool {
+	return containsString(intervalUnitValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value IntervalUnit) Ptr() *IntervalUnit {
+	return &value
+}
+
+// InvoiceCollectTransactionType is a value of the `transaction_type` of InvoiceCollect
+type InvoiceCollectTransactionType string
+
+const (
+	InvoiceCollectTransactionTypeMoto InvoiceCollectTransactionType = "moto"
+)
+
+// invoiceCollectTransactionTypeValues are the values of InvoiceCollectTransactionType listed in the API spec
+var invoiceCollectTransactionTypeValues = []string{"moto"}
+
+// IsKnown reports whether the InvoiceCollectTransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value InvoiceCollectTransactionType) IsKnown() bool {
+	return containsString(invoiceCollectTransactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value InvoiceCollectTransactionType) Ptr() *InvoiceCollectTransactionType {
+	return &value
+}
+
+// InvoiceOrigin is a value of the `origin` of Invoice
+type InvoiceOrigin string
+
+const (
+	InvoiceOriginPurchase         InvoiceOrigin = "purchase"
+	InvoiceOriginLineItemRefund   InvoiceOrigin = "line_item_refund"
+	InvoiceOriginOpenAmountRefund InvoiceOrigin = "open_amount_refund"
+	InvoiceOriginRenewal          InvoiceOrigin = "renewal"
+	InvoiceOriginImmediateChange  InvoiceOrigin = "immediate_change"
+	InvoiceOriginTermination      InvoiceOrigin = "termination"
+	InvoiceOriginCredit           InvoiceOrigin = "credit"
+	InvoiceOriginGiftCard         InvoiceOrigin = "gift_card"
+	InvoiceOriginWriteOff         InvoiceOrigin = "write_off"
+)
+
+// invoiceOriginValues are the values of InvoiceOrigin listed in the API spec
+var invoiceOriginValues = []string{"purchase", "line_item_refund", "open_amount_refund", "renewal", "immediate_change", "termination", "credit", "gift_card", "write_off"}
+
+// IsKnown reports whether the InvoiceOrigin is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value InvoiceOrigin) IsKnown() bool {
+	return containsString(invoiceOriginValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value InvoiceOrigin) Ptr() *InvoiceOrigin {
+	return &value
+}
+
+// InvoiceRefundType is a value of the `type` of InvoiceRefund
+type InvoiceRefundType string
+
+const (
+	InvoiceRefundTypeAmount    InvoiceRefundType = "amount"
+	InvoiceRefundTypeLineItems InvoiceRefundType = "line_items"
+)
+
+// invoiceRefundTypeValues are the values of InvoiceRefundType listed in the API spec
+var invoiceRefundTypeValues = []string{"amount", "line_items"}
+
+// IsKnown reports whether the InvoiceRefundType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value InvoiceRefundType) IsKnown() bool {
+	return containsString(invoiceRefundTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value InvoiceRefundType) Ptr() *InvoiceRefundType {
+	return &value
+}
+
+// InvoiceState is a value of the `state` of Invoice
+type InvoiceState string
+
+const (
+	InvoiceStateOpen       InvoiceState = "open"
+	InvoiceStatePending    InvoiceState = "pending"
+	InvoiceStateProcessing InvoiceState = "processing"
+	InvoiceStatePastDue    InvoiceState = "past_due"
+	InvoiceStatePaid       InvoiceState = "paid"
+	InvoiceStateClosed     InvoiceState = "closed"
+	InvoiceStateFailed     InvoiceState = "failed"
+	InvoiceStateVoided     InvoiceState = "voided"
+)
+
+// invoiceStateValues are the values of InvoiceState listed in the API spec
+var invoiceStateValues = []string{"open", "pending", "processing", "past_due", "paid", "closed", "failed", "voided"}
+
+// IsKnown reports whether the InvoiceState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value InvoiceState) IsKnown() bool {
+	return containsString(invoiceStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value InvoiceState) Ptr() *InvoiceState {
+	return &value
+}
+
+// InvoiceType is a value of the `type` of Invoice
+type InvoiceType string
+
+const (
+	InvoiceTypeCharge InvoiceType = "charge"
+	InvoiceTypeCredit InvoiceType = "credit"
+	InvoiceTypeLegacy InvoiceType = "legacy"
+)
+
+// invoiceTypeValues are the values of InvoiceType listed in the API spec
+var invoiceTypeValues = []string{"charge", "credit", "legacy"}
+
+// IsKnown reports whether the InvoiceType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value InvoiceType) IsKnown() bool {
+	return containsString(invoiceTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value InvoiceType) Ptr() *InvoiceType {
+	return &value
+}
+
+// ItemState is a value of the `state` of Item
+type ItemState string
+
+const (
+	ItemStateActive   ItemState = "active"
+	ItemStateInactive ItemState = "inactive"
+)
+
+// itemStateValues are the values of ItemState listed in the API spec
+var itemStateValues = []string{"active", "inactive"}
+
+// IsKnown reports whether the ItemState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value ItemState) IsKnown() bool {
+	return containsString(itemStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value ItemState) Ptr() *ItemState {
+	return &value
+}
+
+// LegacyCategory is a value of the `legacy_category` of LineItem
+type LegacyCategory string
+
+const (
+	LegacyCategoryCharge        LegacyCategory = "charge"
+	LegacyCategoryCredit        LegacyCategory = "credit"
+	LegacyCategoryAppliedCredit LegacyCategory = "applied_credit"
+	LegacyCategoryCarryforward  LegacyCategory = "carryforward"
+)
+
+// legacyCategoryValues are the values of LegacyCategory listed in the API spec
+var legacyCategoryValues = []string{"charge", "credit", "applied_credit", "carryforward"}
+
+// IsKnown reports whether the LegacyCategory is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value LegacyCategory) IsKnown() bool {
+	return containsString(legacyCategoryValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value LegacyCategory) Ptr() *LegacyCategory {
+	return &value
+}
+
+// LineItemCreateOrigin is a value of the `origin` of LineItemCreate
+type LineItemCreateOrigin string
+
+const (
+	LineItemCreateOriginExternalGiftCard LineItemCreateOrigin = "external_gift_card"
+)
+
+// lineItemCreateOriginValues are the values of LineItemCreateOrigin listed in the API spec
+var lineItemCreateOriginValues = []string{"external_gift_card"}
+
+// IsKnown reports whether the LineItemCreateOrigin is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value LineItemCreateOrigin) IsKnown() bool {
+	return containsString(lineItemCreateOriginValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value LineItemCreateOrigin) Ptr() *LineItemCreateOrigin {
+	return &value
+}
+
+// LineItemOrigin is a value of the `origin` of LineItem
+type LineItemOrigin string
+
+const (
+	LineItemOriginPlan         LineItemOrigin = "plan"
+	LineItemOriginPlanTrial    LineItemOrigin = "plan_trial"
+	LineItemOriginSetupFee     LineItemOrigin = "setup_fee"
+	LineItemOriginAddOnTrial   LineItemOrigin = "add_on_trial"
+	LineItemOriginAddOn        LineItemOrigin = "add_on"
+	LineItemOriginDebit        LineItemOrigin = "debit"
+	LineItemOriginOneTime      LineItemOrigin = "one_time"
+	LineItemOriginCredit       LineItemOrigin = "credit"
+	LineItemOriginCoupon       LineItemOrigin = "coupon"
+	LineItemOriginCarryforward LineItemOrigin = "carryforward"
+)
+
+// lineItemOriginValues are the values of LineItemOrigin listed in the API spec
+var lineItemOriginValues = []string{"plan", "plan_trial", "setup_fee", "add_on_trial", "add_on", "debit", "one_time", "credit", "coupon", "carryforward"}
+
+// IsKnown reports whether the LineItemOrigin is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value LineItemOrigin) IsKnown() bool {
+	return containsString(lineItemOriginValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value LineItemOrigin) Ptr() *LineItemOrigin {
+	return &value
+}
+
+// LineItemState is a value of the `state` of LineItem
+type LineItemState string
+
+const (
+	LineItemStatePending  LineItemState = "pending"
+	LineItemStateInvoiced LineItemState = "invoiced"
+)
+
+// lineItemStateValues are the values of LineItemState listed in the API spec
+var lineItemStateValues = []string{"pending", "invoiced"}
+
+// IsKnown reports whether the LineItemState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value LineItemState) IsKnown() bool {
+	return containsString(lineItemStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value LineItemState) Ptr() *LineItemState {
+	return &value
+}
+
+// LineItemType is a value of the `type` of LineItem
+type LineItemType string
+
+const (
+	LineItemTypeCharge LineItemType = "charge"
+	LineItemTypeCredit LineItemType = "credit"
+	LineItemTypeLegacy LineItemType = "legacy"
+)
+
+// lineItemTypeValues are the values of LineItemType listed in the API spec
+var lineItemTypeValues = []string{"charge", "credit", "legacy"}
+
+// IsKnown reports whether the LineItemType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value LineItemType) IsKnown() bool {
+	return containsString(lineItemTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value LineItemType) Ptr() *LineItemType {
+	return &value
+}
+
+// PaymentMethodObject is a value of the `object` of PaymentMethod
+type PaymentMethodObject string
+
+const (
+	PaymentMethodObjectCreditCard             PaymentMethodObject = "credit_card"
+	PaymentMethodObjectPaypal                 PaymentMethodObject = "paypal"
+	PaymentMethodObjectAmazon                 PaymentMethodObject = "amazon"
+	PaymentMethodObjectRoku                   PaymentMethodObject = "roku"
+	PaymentMethodObjectBankAccountInfo        PaymentMethodObject = "bank_account_info"
+	PaymentMethodObjectApplePay               PaymentMethodObject = "apple_pay"
+	PaymentMethodObjectSepadirectdebit        PaymentMethodObject = "sepadirectdebit"
+	PaymentMethodObjectEft                    PaymentMethodObject = "eft"
+	PaymentMethodObjectWireTransfer           PaymentMethodObject = "wire_transfer"
+	PaymentMethodObjectMoneyOrder             PaymentMethodObject = "money_order"
+	PaymentMethodObjectCheck                  PaymentMethodObject = "check"
+	PaymentMethodObjectAmazonBillingAgreement PaymentMethodObject = "amazon_billing_agreement"
+	PaymentMethodObjectPaypalBillingAgreement PaymentMethodObject = "paypal_billing_agreement"
+	PaymentMethodObjectGatewayToken           PaymentMethodObject = "gateway_token"
+	PaymentMethodObjectIbanBankAccount        PaymentMethodObject = "iban_bank_account"
+	PaymentMethodObjectOther                  PaymentMethodObject = "other"
+)
+
+// paymentMethodObjectValues are the values of PaymentMethodObject listed in the API spec
+var paymentMethodObjectValues = []string{"credit_card", "paypal", "amazon", "roku", "bank_account_info", "apple_pay", "sepadirectdebit", "eft", "wire_transfer", "money_order", "check", "amazon_billing_agreement", "paypal_billing_agreement", "gateway_token", "iban_bank_account", "other"}
+
+// IsKnown reports whether the PaymentMethodObject is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value PaymentMethodObject) IsKnown() bool {
+	return containsString(paymentMethodObjectValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value PaymentMethodObject) Ptr() *PaymentMethodObject {
+	return &value
+}
+
+// PlanState is a value of the `state` of Plan
+type PlanState string
+
+const (
+	PlanStateActive   PlanState = "active"
+	PlanStateInactive PlanState = "inactive"
+)
+
+// planStateValues are the values of PlanState listed in the API spec
+var planStateValues = []string{"active", "inactive"}
+
+// IsKnown reports whether the PlanState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value PlanState) IsKnown() bool {
+	return containsString(planStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value PlanState) Ptr() *PlanState {
+	return &value
+}
+
+// PreferredLocale is a value of the `preferred_locale` of Account
+type PreferredLocale string
+
+const (
+	PreferredLocaleDaDK PreferredLocale = "da-DK"
+	PreferredLocaleDeCH PreferredLocale = "de-CH"
+	PreferredLocaleDeDE PreferredLocale = "de-DE"
+	PreferredLocaleEnAU PreferredLocale = "en-AU"
+	PreferredLocaleEnCA PreferredLocale = "en-CA"
+	PreferredLocaleEnGB PreferredLocale = "en-GB"
+	PreferredLocaleEnNZ PreferredLocale = "en-NZ"
+	PreferredLocaleEnUS PreferredLocale = "en-US"
+	PreferredLocaleEsES PreferredLocale = "es-ES"
+	PreferredLocaleEsMX PreferredLocale = "es-MX"
+	PreferredLocaleEsUS PreferredLocale = "es-US"
+	PreferredLocaleFrCA PreferredLocale = "fr-CA"
+	PreferredLocaleFrFR PreferredLocale = "fr-FR"
+	PreferredLocaleHiIN PreferredLocale = "hi-IN"
+	PreferredLocaleJaJP PreferredLocale = "ja-JP"
+	PreferredLocaleNlBE PreferredLocale = "nl-BE"
+	PreferredLocaleNlNL PreferredLocale = "nl-NL"
+	PreferredLocalePtBR PreferredLocale = "pt-BR"
+	PreferredLocalePtPT PreferredLocale = "pt-PT"
+	PreferredLocaleRuRU PreferredLocale = "ru-RU"
+	PreferredLocaleTrTR PreferredLocale = "tr-TR"
+	PreferredLocaleZhCN PreferredLocale = "zh-CN"
+)
+
+// preferredLocaleValues are the values of PreferredLocale listed in the API spec
+var preferredLocaleValues = []string{"da-DK", "de-CH", "de-DE", "en-AU", "en-CA", "en-GB", "en-NZ", "en-US", "es-ES", "es-MX", "es-US", "fr-CA", "fr-FR", "hi-IN", "ja-JP", "nl-BE", "nl-NL", "pt-BR", "pt-PT", "ru-RU", "tr-TR", "zh-CN"}
+
+// IsKnown reports whether the PreferredLocale is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value PreferredLocale) IsKnown() bool {
+	return containsString(preferredLocaleValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value PreferredLocale) Ptr() *PreferredLocale {
+	return &value
+}
+
+// PurchaseTransactionType is a value of the `transaction_type` of Purchase
+type PurchaseTransactionType string
+
+const (
+	PurchaseTransactionTypeMoto PurchaseTransactionType = "moto"
+)
+
+// purchaseTransactionTypeValues are the values of PurchaseTransactionType listed in the API spec
+var purchaseTransactionTypeValues = []string{"moto"}
+
+// IsKnown reports whether the PurchaseTransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value PurchaseTransactionType) IsKnown() bool {
+	return containsString(purchaseTransactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value PurchaseTransactionType) Ptr() *PurchaseTransactionType {
+	return &value
+}
+
+// RedemptionResource is a value of the `redemption_resource` of Coupon
+type RedemptionResource string
+
+const (
+	RedemptionResourceAccount      RedemptionResource = "account"
+	RedemptionResourceSubscription RedemptionResource = "subscription"
+)
+
+// redemptionResourceValues are the values of RedemptionResource listed in the API spec
+var redemptionResourceValues = []string{"account", "subscription"}
+
+// IsKnown reports whether the RedemptionResource is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value RedemptionResource) IsKnown() bool {
+	return containsString(redemptionResourceValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value RedemptionResource) Ptr() *RedemptionResource {
+	return &value
+}
+
+// RefundMethod is a value of the `refund_method` of InvoiceRefund
+type RefundMethod string
+
+const (
+	RefundMethodTransactionFirst RefundMethod = "transaction_first"
+	RefundMethodCreditFirst      RefundMethod = "credit_first"
+	RefundMethodAllCredit        RefundMethod = "all_credit"
+	RefundMethodAllTransaction   RefundMethod = "all_transaction"
+)
+
+// refundMethodValues are the values of RefundMethod listed in the API spec
+var refundMethodValues = []string{"transaction_first", "credit_first", "all_credit", "all_transaction"}
+
+// IsKnown reports whether the RefundMethod is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value RefundMethod) IsKnown() bool {
+	return containsString(refundMethodValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value RefundMethod) Ptr() *RefundMethod {
+	return &value
+}
+
+// RelatedType is a value of the `related_type` of CustomFieldDefinition
+type RelatedType string
+
+const (
+	RelatedTypeAccount      RelatedType = "account"
+	RelatedTypeItem         RelatedType = "item"
+	RelatedTypeSubscription RelatedType = "subscription"
+)
+
+// relatedTypeValues are the values of RelatedType listed in the API spec
+var relatedTypeValues = []string{"account", "item", "subscription"}
+
+// IsKnown reports whether the RelatedType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value RelatedType) IsKnown() bool {
+	return containsString(relatedTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value RelatedType) Ptr() *RelatedType {
+	return &value
+}
+
+// RevenueScheduleType is a value of the `revenue_schedule_type` of LineItem, Subscription, SubscriptionChange, Item, Plan, AddOn and SubscriptionAddOn
+type RevenueScheduleType string
+
+const (
+	RevenueScheduleTypeNever        RevenueScheduleType = "never"
+	RevenueScheduleTypeEvenly       RevenueScheduleType = "evenly"
+	RevenueScheduleTypeAtRangeEnd   RevenueScheduleType = "at_range_end"
+	RevenueScheduleTypeAtRangeStart RevenueScheduleType = "at_range_start"
+	RevenueScheduleTypeAtInvoice    RevenueScheduleType = "at_invoice"
+)
+
+// revenueScheduleTypeValues are the values of RevenueScheduleType listed in the API spec
+var revenueScheduleTypeValues = []string{"never", "evenly", "at_range_end", "at_range_start", "at_invoice"}
+
+// IsKnown reports whether the RevenueScheduleType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value RevenueScheduleType) IsKnown() bool {
+	return containsString(revenueScheduleTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value RevenueScheduleType) Ptr() *RevenueScheduleType {
+	return &value
+}
+
+// SetupFeeRevenueScheduleType is a value of the `setup_fee_revenue_schedule_type` of SubscriptionChange and Plan
+type SetupFeeRevenueScheduleType string
+
+const (
+	SetupFeeRevenueScheduleTypeNever        SetupFeeRevenueScheduleType = "never"
+	SetupFeeRevenueScheduleTypeEvenly       SetupFeeRevenueScheduleType = "evenly"
+	SetupFeeRevenueScheduleTypeAtRangeEnd   SetupFeeRevenueScheduleType = "at_range_end"
+	SetupFeeRevenueScheduleTypeAtRangeStart SetupFeeRevenueScheduleType = "at_range_start"
+)
+
+// setupFeeRevenueScheduleTypeValues are the values of SetupFeeRevenueScheduleType listed in the API spec
+var setupFeeRevenueScheduleTypeValues = []string{"never", "evenly", "at_range_end", "at_range_start"}
+
+// IsKnown reports whether the SetupFeeRevenueScheduleType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SetupFeeRevenueScheduleType) IsKnown() bool {
+	return containsString(setupFeeRevenueScheduleTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SetupFeeRevenueScheduleType) Ptr() *SetupFeeRevenueScheduleType {
+	return &value
+}
+
+// SiteFeature is a value of the `features` of Site
+type SiteFeature string
+
+const (
+	SiteFeatureCreditMemos         SiteFeature = "credit_memos"
+	SiteFeatureManualInvoicing     SiteFeature = "manual_invoicing"
+	SiteFeatureOnlyBillWhatChanged SiteFeature = "only_bill_what_changed"
+	SiteFeatureSubscriptionTerms   SiteFeature = "subscription_terms"
+)
+
+// siteFeatureValues are the values of SiteFeature listed in the API spec
+var siteFeatureValues = []string{"credit_memos", "manual_invoicing", "only_bill_what_changed", "subscription_terms"}
+
+// IsKnown reports whether the SiteFeature is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SiteFeature) IsKnown() bool {
+	return containsString(siteFeatureValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SiteFeature) Ptr() *SiteFeature {
+	return &value
+}
+
+// SiteMode is a value of the `mode` of Site
+type SiteMode string
+
+const (
+	SiteModeDevelopment SiteMode = "development"
+	SiteModeProduction  SiteMode = "production"
+	SiteModeSandbox     SiteMode = "sandbox"
+)
+
+// siteModeValues are the values of SiteMode listed in the API spec
+var siteModeValues = []string{"development", "production", "sandbox"}
+
+// IsKnown reports whether the SiteMode is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SiteMode) IsKnown() bool {
+	return containsString(siteModeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SiteMode) Ptr() *SiteMode {
+	return &value
+}
+
+// SubscriptionChangeTimeframe is a value of the `timeframe` of SubscriptionChange
+type SubscriptionChangeTimeframe string
+
+const (
+	SubscriptionChangeTimeframeNow      SubscriptionChangeTimeframe = "now"
+	SubscriptionChangeTimeframeBillDate SubscriptionChangeTimeframe = "bill_date"
+	SubscriptionChangeTimeframeTermEnd  SubscriptionChangeTimeframe = "term_end"
+	SubscriptionChangeTimeframeRenewal  SubscriptionChangeTimeframe = "renewal"
+)
+
+// subscriptionChangeTimeframeValues are the values of SubscriptionChangeTimeframe listed in the API spec
+var subscriptionChangeTimeframeValues = []string{"now", "bill_date", "term_end", "renewal"}
+
+// IsKnown reports whether the SubscriptionChangeTimeframe is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SubscriptionChangeTimeframe) IsKnown() bool {
+	return containsString(subscriptionChangeTimeframeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SubscriptionChangeTimeframe) Ptr() *SubscriptionChangeTimeframe {
+	return &value
+}
+
+// SubscriptionChangeTransactionType is a value of the `transaction_type` of SubscriptionChange
+type SubscriptionChangeTransactionType string
+
+const (
+	SubscriptionChangeTransactionTypeMoto SubscriptionChangeTransactionType = "moto"
+)
+
+// subscriptionChangeTransactionTypeValues are the values of SubscriptionChangeTransactionType listed in the API spec
+var subscriptionChangeTransactionTypeValues = []string{"moto"}
+
+// IsKnown reports whether the SubscriptionChangeTransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SubscriptionChangeTransactionType) IsKnown() bool {
+	return containsString(subscriptionChangeTransactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SubscriptionChangeTransactionType) Ptr() *SubscriptionChangeTransactionType {
+	return &value
+}
+
+// SubscriptionState is a value of the `state` of Subscription
+type SubscriptionState string
+
+const (
+	SubscriptionStateActive   SubscriptionState = "active"
+	SubscriptionStateCanceled SubscriptionState = "canceled"
+	SubscriptionStateExpired  SubscriptionState = "expired"
+	SubscriptionStateFailed   SubscriptionState = "failed"
+	SubscriptionStateFuture   SubscriptionState = "future"
+	SubscriptionStatePaused   SubscriptionState = "paused"
+)
+
+// subscriptionStateValues are the values of SubscriptionState listed in the API spec
+var subscriptionStateValues = []string{"active", "canceled", "expired", "failed", "future", "paused"}
+
+// IsKnown reports whether the SubscriptionState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SubscriptionState) IsKnown() bool {
+	return containsString(subscriptionStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SubscriptionState) Ptr() *SubscriptionState {
+	return &value
+}
+
+// SubscriptionTimeframe is a value of the `timeframe` of Subscription
+type SubscriptionTimeframe string
+
+const (
+	SubscriptionTimeframeNow      SubscriptionTimeframe = "now"
+	SubscriptionTimeframeBillDate SubscriptionTimeframe = "bill_date"
+	SubscriptionTimeframeTermEnd  SubscriptionTimeframe = "term_end"
+	SubscriptionTimeframeRenewal  SubscriptionTimeframe = "renewal"
+)
+
+// subscriptionTimeframeValues are the values of SubscriptionTimeframe listed in the API spec
+var subscriptionTimeframeValues = []string{"now", "bill_date", "term_end", "renewal"}
+
+// IsKnown reports whether the SubscriptionTimeframe is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SubscriptionTimeframe) IsKnown() bool {
+	return containsString(subscriptionTimeframeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SubscriptionTimeframe) Ptr() *SubscriptionTimeframe {
+	return &value
+}
+
+// SubscriptionTransactionType is a value of the `transaction_type` of Subscription
+type SubscriptionTransactionType string
+
+const (
+	SubscriptionTransactionTypeMoto SubscriptionTransactionType = "moto"
+)
+
+// subscriptionTransactionTypeValues are the values of SubscriptionTransactionType listed in the API spec
+var subscriptionTransactionTypeValues = []string{"moto"}
+
+// IsKnown reports whether the SubscriptionTransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value SubscriptionTransactionType) IsKnown() bool {
+	return containsString(subscriptionTransactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value SubscriptionTransactionType) Ptr() *SubscriptionTransactionType {
+	return &value
+}
+
+// TemporalUnit is a value of the `temporal_unit` of Coupon
+type TemporalUnit string
+
+const (
+	TemporalUnitDay   TemporalUnit = "day"
+	TemporalUnitWeek  TemporalUnit = "week"
+	TemporalUnitMonth TemporalUnit = "month"
+	TemporalUnitYear  TemporalUnit = "year"
+)
+
+// temporalUnitValues are the values of TemporalUnit listed in the API spec
+var temporalUnitValues = []string{"day", "week", "month", "year"}
+
+// IsKnown reports whether the TemporalUnit is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value TemporalUnit) IsKnown() bool {
+	return containsString(temporalUnitValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value TemporalUnit) Ptr() *TemporalUnit {
+	return &value
+}
+
+// TransactionOrigin is a value of the `origin` of Transaction
+type TransactionOrigin string
+
+const (
+	TransactionOriginApi                TransactionOrigin = "api"
+	TransactionOriginHpp                TransactionOrigin = "hpp"
+	TransactionOriginMerchant           TransactionOrigin = "merchant"
+	TransactionOriginRecurlyAdmin       TransactionOrigin = "recurly_admin"
+	TransactionOriginRecurlyjs          TransactionOrigin = "recurlyjs"
+	TransactionOriginRecurring          TransactionOrigin = "recurring"
+	TransactionOriginTransparent        TransactionOrigin = "transparent"
+	TransactionOriginForceCollect       TransactionOrigin = "force_collect"
+	TransactionOriginRefundedExternally TransactionOrigin = "refunded_externally"
+	TransactionOriginChargeback         TransactionOrigin = "chargeback"
+)
+
+// transactionOriginValues are the values of TransactionOrigin listed in the API spec
+var transactionOriginValues = []string{"api", "hpp", "merchant", "recurly_admin", "recurlyjs", "recurring", "transparent", "force_collect", "refunded_externally", "chargeback"}
+
+// IsKnown reports whether the TransactionOrigin is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value TransactionOrigin) IsKnown() bool {
+	return containsString(transactionOriginValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value TransactionOrigin) Ptr() *TransactionOrigin {
+	return &value
+}
+
+// TransactionStatus is a value of the `status` of Transaction
+type TransactionStatus string
+
+const (
+	TransactionStatusPending    TransactionStatus = "pending"
+	TransactionStatusScheduled  TransactionStatus = "scheduled"
+	TransactionStatusProcessing TransactionStatus = "processing"
+	TransactionStatusSuccess    TransactionStatus = "success"
+	TransactionStatusVoid       TransactionStatus = "void"
+	TransactionStatusDeclined   TransactionStatus = "declined"
+	TransactionStatusError      TransactionStatus = "error"
+	TransactionStatusChargeback TransactionStatus = "chargeback"
+)
+
+// transactionStatusValues are the values of TransactionStatus listed in the API spec
+var transactionStatusValues = []string{"pending", "scheduled", "processing", "success", "void", "declined", "error", "chargeback"}
+
+// IsKnown reports whether the TransactionStatus is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value TransactionStatus) IsKnown() bool {
+	return containsString(transactionStatusValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value TransactionStatus) Ptr() *TransactionStatus {
+	return &value
+}
+
+// TransactionType is a value of the `type` of Transaction
+type TransactionType string
+
+const (
+	TransactionTypeAuthorization TransactionType = "authorization"
+	TransactionTypeCapture       TransactionType = "capture"
+	TransactionTypePurchase      TransactionType = "purchase"
+	TransactionTypeRefund        TransactionType = "refund"
+	TransactionTypeVerify        TransactionType = "verify"
+)
+
+// transactionTypeValues are the values of TransactionType listed in the API spec
+var transactionTypeValues = []string{"authorization", "capture", "purchase", "refund", "verify"}
+
+// IsKnown reports whether the TransactionType is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value TransactionType) IsKnown() bool {
+	return containsString(transactionTypeValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value TransactionType) Ptr() *TransactionType {
+	return &value
+}
+
+// TrialUnit is a value of the `trial_unit` of Plan
+type TrialUnit string
+
+const (
+	TrialUnitDays   TrialUnit = "days"
+	TrialUnitMonths TrialUnit = "months"
+)
+
+// trialUnitValues are the values of TrialUnit listed in the API spec
+var trialUnitValues = []string{"days", "months"}
+
+// IsKnown reports whether the TrialUnit is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value TrialUnit) IsKnown() bool {
+	return containsString(trialUnitValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value TrialUnit) Ptr() *TrialUnit {
+	return &value
+}
+
+// UniqueCouponCodeState is a value of the `state` of UniqueCouponCode
+type UniqueCouponCodeState string
+
+const (
+	UniqueCouponCodeStateRedeemable UniqueCouponCodeState = "redeemable"
+	UniqueCouponCodeStateMaxedOut   UniqueCouponCodeState = "maxed_out"
+	UniqueCouponCodeStateExpired    UniqueCouponCodeState = "expired"
+	UniqueCouponCodeStateInactive   UniqueCouponCodeState = "inactive"
+)
+
+// uniqueCouponCodeStateValues are the values of UniqueCouponCodeState listed in the API spec
+var uniqueCouponCodeStateValues = []string{"redeemable", "maxed_out", "expired", "inactive"}
+
+// IsKnown reports whether the UniqueCouponCodeState is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value UniqueCouponCodeState) IsKnown() bool {
+	return containsString(uniqueCouponCodeStateValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value UniqueCouponCodeState) Ptr() *UniqueCouponCodeState {
+	return &value
+}
+
+// UserAccess is a value of the `user_access` of CustomFieldDefinition
+type UserAccess string
+
+const (
+	UserAccessApiOnly  UserAccess = "api_only"
+	UserAccessReadOnly UserAccess = "read_only"
+	UserAccessWrite    UserAccess = "write"
+)
+
+// userAccessValues are the values of UserAccess listed in the API spec
+var userAccessValues = []string{"api_only", "read_only", "write"}
+
+// IsKnown reports whether the UserAccess is one of the values listed in the API
+// spec. Values added to the API later are decoded as they are, but aren't known.
+func (value UserAccess) IsKnown() bool {
+	return containsString(userAccessValues, string(value))
+}
+
+// Ptr returns a pointer to the value, for the fields of requests
+func (value UserAccess) Ptr() *UserAccess {
+	return &value
+}
@@ -0,0 +1,61 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumsDecodeKnownAndUnknownValues(test *testing.T) {
+	t := &T{test}
+	subscription := &Subscription{}
+	body := `{"id":"sub","state":"active","collection_method":"invoice_later"}`
+	if err := json.Unmarshal([]byte(body), subscription); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	t.Assert(subscription.State, SubscriptionStateActive, "Subscription.State")
+	t.Assert(subscription.State.IsKnown(), true, "Subscription.State.IsKnown()")
+	t.Assert(subscription.CollectionMethod, CollectionMethod("invoice_later"), "Subscription.CollectionMethod")
+	t.Assert(subscription.CollectionMethod.IsKnown(), false, "Subscription.CollectionMethod.IsKnown()")
+
+	data, _ := json.Marshal(subscription)
+	t.Assert(string(data), body, "json.Marshal")
+}
+
+func TestEnumsKeepPlainScalars(test *testing.T) {
+	t := &T{test}
+	transaction := &Transaction{}
+	if err := json.Unmarshal([]byte(`{"avs_check":"N","cvv_check":"N"}`), transaction); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(transaction.AvsCheck, AvsCheckN, "Transaction.AvsCheck")
+	t.Assert(transaction.AvsCheck.IsKnown(), true, "Transaction.AvsCheck.IsKnown()")
+	t.Assert(transaction.CvvCheck.IsKnown(), true, "Transaction.CvvCheck.IsKnown()")
+	t.Assert(AvsCheckY.IsKnown(), true, "AvsCheckY.IsKnown()")
+	t.Assert(AvsCheck("false").IsKnown(), false, "AvsCheck(false).IsKnown()")
+}
+
+func TestEnumsOnRequests(test *testing.T) {
+	t := &T{test}
+	req := &SubscriptionCreate{
+		CollectionMethod: CollectionMethodManual.Ptr(),
+		Account:          &AccountCreate{PreferredLocale: PreferredLocaleEnUS.Ptr()},
+	}
+	data, _ := json.Marshal(req)
+	t.Assert(string(data), `{"account":{"preferred_locale":"en-US"},"collection_method":"manual"}`, "json.Marshal")
+}
+
+func TestEnumsSharedBetweenSchemas(test *testing.T) {
+	t := &T{test}
+	// the states of coupons are part of the states of unique coupon codes,
+	// but each schema has its own type, named after it
+	var state UniqueCouponCodeState = (&UniqueCouponCode{State: UniqueCouponCodeStateInactive}).State
+	t.Assert(state.IsKnown(), true, "UniqueCouponCodeStateInactive.IsKnown()")
+	var planState PlanState = (&Plan{State: PlanStateActive}).State
+	t.Assert(string(planState), string(AccountStateActive), "PlanStateActive")
+	// a property with a single type across the spec is shared
+	var method CollectionMethod = (&Invoice{CollectionMethod: CollectionMethodManual}).CollectionMethod
+	t.Assert(method, (&Subscription{CollectionMethod: CollectionMethodManual}).CollectionMethod, "CollectionMethod")
+	t.Assert(TransactionTypePurchase.IsKnown(), true, "TransactionTypePurchase.IsKnown()")
+	t.Assert(ErrorMayHaveTransaction{Type: ErrorTypeValidation}.Type, ErrorTypeValidation, "ErrorMayHaveTransaction.Type")
+}
@@ -2,6 +2,7 @@ package recurly
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 )
@@ -31,6 +32,84 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
+// Is reports whether the error matches a sentinel error, such as ErrNotFound,
+// for use with errors.Is
+func (e *Error) Is(target error) bool {
+	sentinel, ok := target.(*sentinelError)
+	if !ok {
+		return false
+	}
+	for _, errorType := range sentinel.types {
+		if e.Type == errorType {
+			return true
+		}
+	}
+	return false
+}
+
+// sentinelError is the type of the sentinel errors, which match the *Error
+// values of some types
+type sentinelError struct {
+	message string
+	types   []ErrorType
+}
+
+func (e *sentinelError) Error() string {
+	return "recurly: " + e.message
+}
+
+// The sentinel errors match the *Error values of their types with errors.Is,
+// even when they are wrapped:
+//
+//	if errors.Is(err, recurly.ErrNotFound) {
+var (
+	// ErrNotFound matches errors of type ErrorTypeNotFound
+	ErrNotFound error = &sentinelError{"not found", []ErrorType{ErrorTypeNotFound}}
+	// ErrRateLimited matches errors of type ErrorTypeRateLimited
+	ErrRateLimited error = &sentinelError{"rate limited", []ErrorType{ErrorTypeRateLimited}}
+	// ErrValidation matches errors of type ErrorTypeValidation, including the
+	// ones of requests rejected before they are sent
+	ErrValidation error = &sentinelError{"validation failed", []ErrorType{ErrorTypeValidation}}
+	// ErrTransaction matches errors of type ErrorTypeTransaction, whose
+	// TransactionError holds the details of the declined transaction
+	ErrTransaction error = &sentinelError{"transaction failed", []ErrorType{ErrorTypeTransaction}}
+	// ErrUnauthorized matches errors of type ErrorTypeUnauthorized and
+	// ErrorTypeInvalidApiKey
+	ErrUnauthorized error = &sentinelError{"unauthorized", []ErrorType{ErrorTypeUnauthorized, ErrorTypeInvalidApiKey}}
+)
+
+// RequestError is returned by Client.Do when a request got no response, such
+// as on a network error or a cancelled context, or when its response couldn't
+// be read or decoded. The underlying error is returned by Unwrap, so it can be
+// inspected with errors.Is and errors.As.
+type RequestError struct {
+	// Request describes the request which failed
+	Request RequestMetadata
+	// Response is the metadata of the response, or nil if there was none
+	Response *ResponseMetadata
+	// Err is the error of the transport, or of reading or decoding the
+	// response
+	Err error
+}
+
+// GetResponse returns the metadata of the response which couldn't be
+// decoded, or nil if there was no response
+func (e *RequestError) GetResponse() *ResponseMetadata {
+	return e.Response
+}
+
+func (e *RequestError) Error() string {
+	if e.Response == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("recurly: cannot read the response to %s %s: %v", e.Request.Method, e.Request.URL, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
 type ErrorType string
 type ErrorClass string
 
@@ -2,6 +2,8 @@ package recurly
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
@@ -273,3 +275,87 @@ func Test504GatewayTimeoutError(test *testing.T) {
 		t.Assert(e.Class, ErrorClassServer, "e.Class")
 	}
 }
+
+// wrappedError wraps an error like fmt.Errorf with %w does
+type wrappedError struct {
+	err error
+}
+
+func (e *wrappedError) Error() string { return "wrapped: " + e.err.Error() }
+func (e *wrappedError) Unwrap() error { return e.err }
+
+// errorTransport fails every request with its error
+type errorTransport struct {
+	err error
+}
+
+func (transport errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, transport.err
+}
+
+func TestErrorIsSentinel(test *testing.T) {
+	t := &T{test}
+	tests := []struct {
+		errorType ErrorType
+		sentinel  error
+	}{
+		{ErrorTypeNotFound, ErrNotFound},
+		{ErrorTypeRateLimited, ErrRateLimited},
+		{ErrorTypeValidation, ErrValidation},
+		{ErrorTypeTransaction, ErrTransaction},
+		{ErrorTypeUnauthorized, ErrUnauthorized},
+		{ErrorTypeInvalidApiKey, ErrUnauthorized},
+	}
+	for _, tt := range tests {
+		err := &wrappedError{&Error{Type: tt.errorType}}
+		t.Assert(errors.Is(err, tt.sentinel), true, "errors.Is "+string(tt.errorType))
+		t.Assert(errors.Is(err, ErrNotFound), tt.sentinel == ErrNotFound, "errors.Is ErrNotFound "+string(tt.errorType))
+	}
+
+	err := parseError(mockResponseWithoutBody(404))
+	t.Assert(errors.Is(err, ErrNotFound), true, "errors.Is(404, ErrNotFound)")
+	var e *Error
+	t.Assert(errors.As(&wrappedError{err}, &e), true, "errors.As *Error")
+	t.Assert(e.Type, ErrorTypeNotFound, "e.Type")
+}
+
+func TestTransportErrorsAreWrapped(test *testing.T) {
+	t := &T{test}
+	failure := errors.New("connection reset")
+	client := newClient("APIKEY", &http.Client{Transport: errorTransport{failure}})
+	client.Log = NewLogger(LevelWarn)
+
+	_, err := client.GetAccount("code-abc")
+	var requestErr *RequestError
+	if !errors.As(err, &requestErr) {
+		t.Fatalf("Expected *RequestError, got %v", err)
+	}
+	t.Assert(requestErr.Request.Method, http.MethodGet, "Request.Method")
+	t.Assert(requestErr.Request.URL.Path, "/accounts/code-abc", "Request.URL.Path")
+	t.Assert(requestErr.GetResponse() == nil, true, "GetResponse() == nil")
+	t.Assert(errors.Is(err, failure), true, "errors.Is(err, failure)")
+}
+
+func TestDecodeErrorsAreWrapped(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T:             t,
+		AssertRequest: func(req *http.Request) {},
+		MakeResponse: func(req *http.Request) *http.Response {
+			res := mockResponse(req, 200, String(`{"id": `))
+			res.Header.Set("X-Request-Id", "req-1")
+			return res
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	_, err := client.GetAccount("code-abc")
+	var requestErr *RequestError
+	if !errors.As(err, &requestErr) {
+		t.Fatalf("Expected *RequestError, got %v", err)
+	}
+	t.Assert(requestErr.Request.ID, "req-1", "Request.ID")
+	t.Assert(requestErr.GetResponse().StatusCode, 200, "GetResponse().StatusCode")
+	var syntaxErr *json.SyntaxError
+	t.Assert(errors.As(err, &syntaxErr), true, "errors.As *json.SyntaxError")
+}
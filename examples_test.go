@@ -0,0 +1,440 @@
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurly
+
+// resourceExamples are JSON documents of every resource for the round-trip
+// tests: with zero values, with every value set, and with null values
+var resourceExamples = []resourceExample{
+	{
+		name: "Site",
+		new:  func() interface{} { return &Site{} },
+		documents: []string{
+			`{"address":{},"created_at":null,"deleted_at":null,"features":[],"id":"","mode":"","object":"","public_api_key":"","settings":{},"subdomain":"","updated_at":null}`,
+			`{"address":{"city":"string","country":"string","first_name":"string","last_name":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"},"created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","features":["credit_memos"],"id":"string","mode":"development","object":"string","public_api_key":"string","settings":{"accepted_currencies":["string"],"billing_address_requirement":"full","default_currency":"string"},"subdomain":"string","updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"address":null,"created_at":null,"deleted_at":null,"features":null,"id":null,"mode":null,"object":null,"public_api_key":null,"settings":null,"subdomain":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "Address",
+		new:  func() interface{} { return &Address{} },
+		documents: []string{
+			`{"city":"","country":"","first_name":"","last_name":"","phone":"","postal_code":"","region":"","street1":"","street2":""}`,
+			`{"city":"string","country":"string","first_name":"string","last_name":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"}`,
+			`{"city":null,"country":null,"first_name":null,"last_name":null,"phone":null,"postal_code":null,"region":null,"street1":null,"street2":null}`,
+		},
+	},
+	{
+		name: "Settings",
+		new:  func() interface{} { return &Settings{} },
+		documents: []string{
+			`{"accepted_currencies":[],"billing_address_requirement":"","default_currency":""}`,
+			`{"accepted_currencies":["string"],"billing_address_requirement":"full","default_currency":"string"}`,
+			`{"accepted_currencies":null,"billing_address_requirement":null,"default_currency":null}`,
+		},
+	},
+	{
+		name: "ShippingAddress",
+		new:  func() interface{} { return &ShippingAddress{} },
+		documents: []string{
+			`{"account_id":"","city":"","company":"","country":"","created_at":null,"email":"","first_name":"","id":"","last_name":"","nickname":"","object":"","phone":"","postal_code":"","region":"","street1":"","street2":"","updated_at":null,"vat_number":""}`,
+			`{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"}`,
+			`{"account_id":null,"city":null,"company":null,"country":null,"created_at":null,"email":null,"first_name":null,"id":null,"last_name":null,"nickname":null,"object":null,"phone":null,"postal_code":null,"region":null,"street1":null,"street2":null,"updated_at":null,"vat_number":null}`,
+		},
+	},
+	{
+		name: "BillingInfo",
+		new:  func() interface{} { return &BillingInfo{} },
+		documents: []string{
+			`{"account_id":"","address":{},"company":"","created_at":null,"first_name":"","fraud":{},"id":"","last_name":"","object":"","payment_method":{},"updated_at":null,"updated_by":{},"valid":false,"vat_number":""}`,
+			`{"account_id":"string","address":{"city":"string","country":"string","first_name":"string","last_name":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"},"company":"string","created_at":"2020-01-01T00:00:00Z","first_name":"string","fraud":{"decision":"approve","risk_rules_triggered":{"key":"value"},"score":1},"id":"string","last_name":"string","object":"string","payment_method":{"account_type":"checking","billing_agreement_id":"string","card_type":"American Express","exp_month":1,"exp_year":1,"first_six":"string","gateway_code":"string","gateway_token":"string","last_four":"string","last_two":"string","object":"credit_card","routing_number":"string","routing_number_bank":"string"},"updated_at":"2020-01-01T00:00:00Z","updated_by":{"country":"string","ip":"string"},"valid":true,"vat_number":"string"}`,
+			`{"account_id":null,"address":null,"company":null,"created_at":null,"first_name":null,"fraud":null,"id":null,"last_name":null,"object":null,"payment_method":null,"updated_at":null,"updated_by":null,"valid":null,"vat_number":null}`,
+		},
+	},
+	{
+		name: "FraudInfo",
+		new:  func() interface{} { return &FraudInfo{} },
+		documents: []string{
+			`{"decision":"","risk_rules_triggered":{},"score":0}`,
+			`{"decision":"approve","risk_rules_triggered":{"key":"value"},"score":1}`,
+			`{"decision":null,"risk_rules_triggered":null,"score":null}`,
+		},
+	},
+	{
+		name: "BillingInfoUpdatedBy",
+		new:  func() interface{} { return &BillingInfoUpdatedBy{} },
+		documents: []string{
+			`{"country":"","ip":""}`,
+			`{"country":"string","ip":"string"}`,
+			`{"country":null,"ip":null}`,
+		},
+	},
+	{
+		name: "CustomField",
+		new:  func() interface{} { return &CustomField{} },
+		documents: []string{
+			`{"name":"","value":""}`,
+			`{"name":"string","value":"string"}`,
+			`{"name":null,"value":null}`,
+		},
+	},
+	{
+		name: "AccountAcquisitionCost",
+		new:  func() interface{} { return &AccountAcquisitionCost{} },
+		documents: []string{
+			`{"amount":0,"currency":""}`,
+			`{"amount":1.5,"currency":"string"}`,
+			`{"amount":null,"currency":null}`,
+		},
+	},
+	{
+		name: "AccountMini",
+		new:  func() interface{} { return &AccountMini{} },
+		documents: []string{
+			`{"bill_to":"","code":"","company":"","email":"","first_name":"","id":"","last_name":"","object":"","parent_account_id":""}`,
+			`{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"}`,
+			`{"bill_to":null,"code":null,"company":null,"email":null,"first_name":null,"id":null,"last_name":null,"object":null,"parent_account_id":null}`,
+		},
+	},
+	{
+		name: "AccountBalance",
+		new:  func() interface{} { return &AccountBalance{} },
+		documents: []string{
+			`{"account":{},"balances":[],"object":"","past_due":false}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"balances":[{"amount":1.5,"currency":"string"}],"object":"string","past_due":true}`,
+			`{"account":null,"balances":null,"object":null,"past_due":null}`,
+		},
+	},
+	{
+		name: "AccountBalanceAmount",
+		new:  func() interface{} { return &AccountBalanceAmount{} },
+		documents: []string{
+			`{"amount":0,"currency":""}`,
+			`{"amount":1.5,"currency":"string"}`,
+			`{"amount":null,"currency":null}`,
+		},
+	},
+	{
+		name: "CouponRedemption",
+		new:  func() interface{} { return &CouponRedemption{} },
+		documents: []string{
+			`{"account":{},"coupon":{},"created_at":null,"currency":"","discounted":0,"id":"","object":"","removed_at":null,"state":"","updated_at":null}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"coupon":{"applies_to_all_plans":true,"applies_to_non_plan_charges":true,"code":"string","coupon_type":"single_code","created_at":"2020-01-01T00:00:00Z","discount":{"currencies":[{}],"percent":1,"trial":{},"type":"percent"},"duration":"forever","expired_at":"2020-01-01T00:00:00Z","free_trial_amount":1,"free_trial_unit":"day","hosted_page_description":"string","id":"string","invoice_description":"string","max_redemptions":1,"max_redemptions_per_account":1,"name":"string","object":"string","plans":[{}],"plans_names":["string"],"redeem_by":"2020-01-01T00:00:00Z","redeemed_at":"2020-01-01T00:00:00Z","redemption_resource":"account","state":"redeemable","temporal_amount":1,"temporal_unit":"day","unique_code_template":"string","unique_coupon_codes_count":1,"updated_at":"2020-01-01T00:00:00Z"},"created_at":"2020-01-01T00:00:00Z","currency":"string","discounted":1.5,"id":"string","object":"string","removed_at":"2020-01-01T00:00:00Z","state":"active","updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"account":null,"coupon":null,"created_at":null,"currency":null,"discounted":null,"id":null,"object":null,"removed_at":null,"state":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "Coupon",
+		new:  func() interface{} { return &Coupon{} },
+		documents: []string{
+			`{"applies_to_all_plans":false,"applies_to_non_plan_charges":false,"code":"","coupon_type":"","created_at":null,"discount":{},"duration":"","expired_at":null,"free_trial_amount":0,"free_trial_unit":"","hosted_page_description":"","id":"","invoice_description":"","max_redemptions":0,"max_redemptions_per_account":0,"name":"","object":"","plans":[],"plans_names":[],"redeem_by":null,"redeemed_at":null,"redemption_resource":"","state":"","temporal_amount":0,"temporal_unit":"","unique_code_template":"","unique_coupon_codes_count":0,"updated_at":null}`,
+			`{"applies_to_all_plans":true,"applies_to_non_plan_charges":true,"code":"string","coupon_type":"single_code","created_at":"2020-01-01T00:00:00Z","discount":{"currencies":[{}],"percent":1,"trial":{"length":1,"unit":"day"},"type":"percent"},"duration":"forever","expired_at":"2020-01-01T00:00:00Z","free_trial_amount":1,"free_trial_unit":"day","hosted_page_description":"string","id":"string","invoice_description":"string","max_redemptions":1,"max_redemptions_per_account":1,"name":"string","object":"string","plans":[{"code":"string","id":"string","name":"string","object":"string"}],"plans_names":["string"],"redeem_by":"2020-01-01T00:00:00Z","redeemed_at":"2020-01-01T00:00:00Z","redemption_resource":"account","state":"redeemable","temporal_amount":1,"temporal_unit":"day","unique_code_template":"string","unique_coupon_codes_count":1,"updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"applies_to_all_plans":null,"applies_to_non_plan_charges":null,"code":null,"coupon_type":null,"created_at":null,"discount":null,"duration":null,"expired_at":null,"free_trial_amount":null,"free_trial_unit":null,"hosted_page_description":null,"id":null,"invoice_description":null,"max_redemptions":null,"max_redemptions_per_account":null,"name":null,"object":null,"plans":null,"plans_names":null,"redeem_by":null,"redeemed_at":null,"redemption_resource":null,"state":null,"temporal_amount":null,"temporal_unit":null,"unique_code_template":null,"unique_coupon_codes_count":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "PlanMini",
+		new:  func() interface{} { return &PlanMini{} },
+		documents: []string{
+			`{"code":"","id":"","name":"","object":""}`,
+			`{"code":"string","id":"string","name":"string","object":"string"}`,
+			`{"code":null,"id":null,"name":null,"object":null}`,
+		},
+	},
+	{
+		name: "CouponDiscount",
+		new:  func() interface{} { return &CouponDiscount{} },
+		documents: []string{
+			`{"currencies":[],"percent":0,"trial":{},"type":""}`,
+			`{"currencies":[{"amount":1.5,"currency":"string"}],"percent":1,"trial":{"length":1,"unit":"day"},"type":"percent"}`,
+			`{"currencies":null,"percent":null,"trial":null,"type":null}`,
+		},
+	},
+	{
+		name: "CouponDiscountPricing",
+		new:  func() interface{} { return &CouponDiscountPricing{} },
+		documents: []string{
+			`{"amount":0,"currency":""}`,
+			`{"amount":1.5,"currency":"string"}`,
+			`{"amount":null,"currency":null}`,
+		},
+	},
+	{
+		name: "CouponDiscountTrial",
+		new:  func() interface{} { return &CouponDiscountTrial{} },
+		documents: []string{
+			`{"length":0,"unit":""}`,
+			`{"length":1,"unit":"day"}`,
+			`{"length":null,"unit":null}`,
+		},
+	},
+	{
+		name: "CreditPayment",
+		new:  func() interface{} { return &CreditPayment{} },
+		documents: []string{
+			`{"account":{},"action":"","amount":0,"applied_to_invoice":{},"created_at":null,"currency":"","id":"","object":"","original_credit_payment_id":"","original_invoice":{},"refund_transaction":{},"updated_at":null,"uuid":"","voided_at":null}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"action":"payment","amount":1.5,"applied_to_invoice":{"id":"string","number":"string","object":"string","state":"open","type":"charge"},"created_at":"2020-01-01T00:00:00Z","currency":"string","id":"string","object":"string","original_credit_payment_id":"string","original_invoice":{"id":"string","number":"string","object":"string","state":"open","type":"charge"},"refund_transaction":{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"amount":1.5,"avs_check":"A","billing_address":{"city":"string","country":"string","first_name":"string","last_name":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"},"collected_at":"2020-01-01T00:00:00Z","collection_method":"automatic","created_at":"2020-01-01T00:00:00Z","currency":"string","customer_message":"string","customer_message_locale":"string","cvv_check":"D","gateway_approval_code":"string","gateway_message":"string","gateway_reference":"string","gateway_response_code":"string","gateway_response_time":1.5,"gateway_response_values":{"key":"value"},"id":"string","invoice":{"id":"string","number":"string","object":"string","state":"open","type":"charge"},"ip_address_country":"string","ip_address_v4":"string","object":"string","origin":"api","original_transaction_id":"string","payment_gateway":{"id":"string","name":"string","object":"string","type":"string"},"payment_method":{"account_type":"checking","billing_agreement_id":"string","card_type":"American Express","exp_month":1,"exp_year":1,"first_six":"string","gateway_code":"string","gateway_token":"string","last_four":"string","last_two":"string","object":"credit_card","routing_number":"string","routing_number_bank":"string"},"refunded":true,"status":"pending","status_code":"string","status_message":"string","subscription_ids":["string"],"success":true,"type":"authorization","uuid":"string","voided_at":"2020-01-01T00:00:00Z","voided_by_invoice":{"id":"string","number":"string","object":"string","state":"open","type":"charge"}},"updated_at":"2020-01-01T00:00:00Z","uuid":"string","voided_at":"2020-01-01T00:00:00Z"}`,
+			`{"account":null,"action":null,"amount":null,"applied_to_invoice":null,"created_at":null,"currency":null,"id":null,"object":null,"original_credit_payment_id":null,"original_invoice":null,"refund_transaction":null,"updated_at":null,"uuid":null,"voided_at":null}`,
+		},
+	},
+	{
+		name: "InvoiceMini",
+		new:  func() interface{} { return &InvoiceMini{} },
+		documents: []string{
+			`{"id":"","number":"","object":"","state":"","type":""}`,
+			`{"id":"string","number":"string","object":"string","state":"open","type":"charge"}`,
+			`{"id":null,"number":null,"object":null,"state":null,"type":null}`,
+		},
+	},
+	{
+		name: "Transaction",
+		new:  func() interface{} { return &Transaction{} },
+		documents: []string{
+			`{"account":{},"amount":0,"avs_check":"","billing_address":{},"collected_at":null,"collection_method":"","created_at":null,"currency":"","customer_message":"","customer_message_locale":"","cvv_check":"","gateway_approval_code":"","gateway_message":"","gateway_reference":"","gateway_response_code":"","gateway_response_time":0,"gateway_response_values":{},"id":"","invoice":{},"ip_address_country":"","ip_address_v4":"","object":"","origin":"","original_transaction_id":"","payment_gateway":{},"payment_method":{},"refunded":false,"status":"","status_code":"","status_message":"","subscription_ids":[],"success":false,"type":"","uuid":"","voided_at":null,"voided_by_invoice":{}}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"amount":1.5,"avs_check":"A","billing_address":{"city":"string","country":"string","first_name":"string","last_name":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"},"collected_at":"2020-01-01T00:00:00Z","collection_method":"automatic","created_at":"2020-01-01T00:00:00Z","currency":"string","customer_message":"string","customer_message_locale":"string","cvv_check":"D","gateway_approval_code":"string","gateway_message":"string","gateway_reference":"string","gateway_response_code":"string","gateway_response_time":1.5,"gateway_response_values":{"key":"value"},"id":"string","invoice":{"id":"string","number":"string","object":"string","state":"open","type":"charge"},"ip_address_country":"string","ip_address_v4":"string","object":"string","origin":"api","original_transaction_id":"string","payment_gateway":{"id":"string","name":"string","object":"string","type":"string"},"payment_method":{"account_type":"checking","billing_agreement_id":"string","card_type":"American Express","exp_month":1,"exp_year":1,"first_six":"string","gateway_code":"string","gateway_token":"string","last_four":"string","last_two":"string","object":"credit_card","routing_number":"string","routing_number_bank":"string"},"refunded":true,"status":"pending","status_code":"string","status_message":"string","subscription_ids":["string"],"success":true,"type":"authorization","uuid":"string","voided_at":"2020-01-01T00:00:00Z","voided_by_invoice":{"id":"string","number":"string","object":"string","state":"open","type":"charge"}}`,
+			`{"account":null,"amount":null,"avs_check":null,"billing_address":null,"collected_at":null,"collection_method":null,"created_at":null,"currency":null,"customer_message":null,"customer_message_locale":null,"cvv_check":null,"gateway_approval_code":null,"gateway_message":null,"gateway_reference":null,"gateway_response_code":null,"gateway_response_time":null,"gateway_response_values":null,"id":null,"invoice":null,"ip_address_country":null,"ip_address_v4":null,"object":null,"origin":null,"original_transaction_id":null,"payment_gateway":null,"payment_method":null,"refunded":null,"status":null,"status_code":null,"status_message":null,"subscription_ids":null,"success":null,"type":null,"uuid":null,"voided_at":null,"voided_by_invoice":null}`,
+		},
+	},
+	{
+		name: "TransactionPaymentGateway",
+		new:  func() interface{} { return &TransactionPaymentGateway{} },
+		documents: []string{
+			`{"id":"","name":"","object":"","type":""}`,
+			`{"id":"string","name":"string","object":"string","type":"string"}`,
+			`{"id":null,"name":null,"object":null,"type":null}`,
+		},
+	},
+	{
+		name: "Invoice",
+		new:  func() interface{} { return &Invoice{} },
+		documents: []string{
+			`{"account":{},"address":{},"balance":0,"closed_at":null,"collection_method":"","created_at":null,"credit_payments":[],"currency":"","customer_notes":"","discount":0,"due_at":null,"id":"","line_items":{"data":[],"has_more":false,"next":null,"object":"list"},"net_terms":0,"number":"","object":"","origin":"","paid":0,"po_number":"","previous_invoice_id":"","refundable_amount":0,"shipping_address":{},"state":"","subscription_ids":[],"subtotal":0,"tax":0,"tax_info":{},"terms_and_conditions":"","total":0,"transactions":[],"type":"","updated_at":null,"vat_number":"","vat_reverse_charge_notes":""}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"address":{"city":"string","company":"string","country":"string","first_name":"string","last_name":"string","name_on_account":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"},"balance":1.5,"closed_at":"2020-01-01T00:00:00Z","collection_method":"automatic","created_at":"2020-01-01T00:00:00Z","credit_payments":[{"account":{},"action":"payment","amount":1.5,"applied_to_invoice":{},"created_at":"2020-01-01T00:00:00Z","currency":"string","id":"string","object":"string","original_credit_payment_id":"string","original_invoice":{},"refund_transaction":{},"updated_at":"2020-01-01T00:00:00Z","uuid":"string","voided_at":"2020-01-01T00:00:00Z"}],"currency":"string","customer_notes":"string","discount":1.5,"due_at":"2020-01-01T00:00:00Z","id":"string","line_items":{"data":[{"account":{},"accounting_code":"string","add_on_code":"string","add_on_id":"string","amount":1.5,"created_at":"2020-01-01T00:00:00Z","credit_applied":1.5,"credit_reason_code":"general","currency":"string","description":"string","discount":1.5,"end_date":"2020-01-01T00:00:00Z","external_sku":"string","id":"string","invoice_id":"string","invoice_number":"string","item_code":"string","item_id":"string","legacy_category":"charge","object":"string","origin":"plan","original_line_item_invoice_id":"string","plan_code":"string","plan_id":"string","previous_line_item_id":"string","product_code":"string","proration_rate":1.5,"quantity":1,"refund":true,"refunded_quantity":1,"revenue_schedule_type":"never","shipping_address":{},"start_date":"2020-01-01T00:00:00Z","state":"pending","subscription_id":"string","subtotal":1.5,"tax":1.5,"tax_code":"string","tax_exempt":true,"tax_info":{},"taxable":true,"type":"charge","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z","uuid":"string"}],"has_more":true,"next":"/next","object":"list"},"net_terms":1,"number":"string","object":"string","origin":"purchase","paid":1.5,"po_number":"string","previous_invoice_id":"string","refundable_amount":1.5,"shipping_address":{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"},"state":"open","subscription_ids":["string"],"subtotal":1.5,"tax":1.5,"tax_info":{"rate":1.5,"region":"string","type":"string"},"terms_and_conditions":"string","total":1.5,"transactions":[{"account":{},"amount":1.5,"avs_check":"A","billing_address":{},"collected_at":"2020-01-01T00:00:00Z","collection_method":"automatic","created_at":"2020-01-01T00:00:00Z","currency":"string","customer_message":"string","customer_message_locale":"string","cvv_check":"D","gateway_approval_code":"string","gateway_message":"string","gateway_reference":"string","gateway_response_code":"string","gateway_response_time":1.5,"gateway_response_values":{"key":"value"},"id":"string","invoice":{},"ip_address_country":"string","ip_address_v4":"string","object":"string","origin":"api","original_transaction_id":"string","payment_gateway":{},"payment_method":{},"refunded":true,"status":"pending","status_code":"string","status_message":"string","subscription_ids":["string"],"success":true,"type":"authorization","uuid":"string","voided_at":"2020-01-01T00:00:00Z","voided_by_invoice":{}}],"type":"charge","updated_at":"2020-01-01T00:00:00Z","vat_number":"string","vat_reverse_charge_notes":"string"}`,
+			`{"account":null,"address":null,"balance":null,"closed_at":null,"collection_method":null,"created_at":null,"credit_payments":null,"currency":null,"customer_notes":null,"discount":null,"due_at":null,"id":null,"line_items":null,"net_terms":null,"number":null,"object":null,"origin":null,"paid":null,"po_number":null,"previous_invoice_id":null,"refundable_amount":null,"shipping_address":null,"state":null,"subscription_ids":null,"subtotal":null,"tax":null,"tax_info":null,"terms_and_conditions":null,"total":null,"transactions":null,"type":null,"updated_at":null,"vat_number":null,"vat_reverse_charge_notes":null}`,
+		},
+	},
+	{
+		name: "InvoiceAddress",
+		new:  func() interface{} { return &InvoiceAddress{} },
+		documents: []string{
+			`{"city":"","company":"","country":"","first_name":"","last_name":"","name_on_account":"","phone":"","postal_code":"","region":"","street1":"","street2":""}`,
+			`{"city":"string","company":"string","country":"string","first_name":"string","last_name":"string","name_on_account":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"}`,
+			`{"city":null,"company":null,"country":null,"first_name":null,"last_name":null,"name_on_account":null,"phone":null,"postal_code":null,"region":null,"street1":null,"street2":null}`,
+		},
+	},
+	{
+		name: "TaxInfo",
+		new:  func() interface{} { return &TaxInfo{} },
+		documents: []string{
+			`{"rate":0,"region":"","type":""}`,
+			`{"rate":1.5,"region":"string","type":"string"}`,
+			`{"rate":null,"region":null,"type":null}`,
+		},
+	},
+	{
+		name: "LineItem",
+		new:  func() interface{} { return &LineItem{} },
+		documents: []string{
+			`{"account":{},"accounting_code":"","add_on_code":"","add_on_id":"","amount":0,"created_at":null,"credit_applied":0,"credit_reason_code":"","currency":"","description":"","discount":0,"end_date":null,"external_sku":"","id":"","invoice_id":"","invoice_number":"","item_code":"","item_id":"","legacy_category":"","object":"","origin":"","original_line_item_invoice_id":"","plan_code":"","plan_id":"","previous_line_item_id":"","product_code":"","proration_rate":0,"quantity":0,"refund":false,"refunded_quantity":0,"revenue_schedule_type":"","shipping_address":{},"start_date":null,"state":"","subscription_id":"","subtotal":0,"tax":0,"tax_code":"","tax_exempt":false,"tax_info":{},"taxable":false,"type":"","unit_amount":0,"updated_at":null,"uuid":""}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"accounting_code":"string","add_on_code":"string","add_on_id":"string","amount":1.5,"created_at":"2020-01-01T00:00:00Z","credit_applied":1.5,"credit_reason_code":"general","currency":"string","description":"string","discount":1.5,"end_date":"2020-01-01T00:00:00Z","external_sku":"string","id":"string","invoice_id":"string","invoice_number":"string","item_code":"string","item_id":"string","legacy_category":"charge","object":"string","origin":"plan","original_line_item_invoice_id":"string","plan_code":"string","plan_id":"string","previous_line_item_id":"string","product_code":"string","proration_rate":1.5,"quantity":1,"refund":true,"refunded_quantity":1,"revenue_schedule_type":"never","shipping_address":{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"},"start_date":"2020-01-01T00:00:00Z","state":"pending","subscription_id":"string","subtotal":1.5,"tax":1.5,"tax_code":"string","tax_exempt":true,"tax_info":{"rate":1.5,"region":"string","type":"string"},"taxable":true,"type":"charge","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z","uuid":"string"}`,
+			`{"account":null,"accounting_code":null,"add_on_code":null,"add_on_id":null,"amount":null,"created_at":null,"credit_applied":null,"credit_reason_code":null,"currency":null,"description":null,"discount":null,"end_date":null,"external_sku":null,"id":null,"invoice_id":null,"invoice_number":null,"item_code":null,"item_id":null,"legacy_category":null,"object":null,"origin":null,"original_line_item_invoice_id":null,"plan_code":null,"plan_id":null,"previous_line_item_id":null,"product_code":null,"proration_rate":null,"quantity":null,"refund":null,"refunded_quantity":null,"revenue_schedule_type":null,"shipping_address":null,"start_date":null,"state":null,"subscription_id":null,"subtotal":null,"tax":null,"tax_code":null,"tax_exempt":null,"tax_info":null,"taxable":null,"type":null,"unit_amount":null,"updated_at":null,"uuid":null}`,
+		},
+	},
+	{
+		name: "InvoiceCollection",
+		new:  func() interface{} { return &InvoiceCollection{} },
+		documents: []string{
+			`{"charge_invoice":{},"credit_invoices":[],"object":""}`,
+			`{"charge_invoice":{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"address":{"city":"string","company":"string","country":"string","first_name":"string","last_name":"string","name_on_account":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string"},"balance":1.5,"closed_at":"2020-01-01T00:00:00Z","collection_method":"automatic","created_at":"2020-01-01T00:00:00Z","credit_payments":[{}],"currency":"string","customer_notes":"string","discount":1.5,"due_at":"2020-01-01T00:00:00Z","id":"string","line_items":{"data":[{}],"has_more":true,"next":"/next","object":"list"},"net_terms":1,"number":"string","object":"string","origin":"purchase","paid":1.5,"po_number":"string","previous_invoice_id":"string","refundable_amount":1.5,"shipping_address":{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"},"state":"open","subscription_ids":["string"],"subtotal":1.5,"tax":1.5,"tax_info":{"rate":1.5,"region":"string","type":"string"},"terms_and_conditions":"string","total":1.5,"transactions":[{}],"type":"charge","updated_at":"2020-01-01T00:00:00Z","vat_number":"string","vat_reverse_charge_notes":"string"},"credit_invoices":[{"account":{},"address":{},"balance":1.5,"closed_at":"2020-01-01T00:00:00Z","collection_method":"automatic","created_at":"2020-01-01T00:00:00Z","credit_payments":[{}],"currency":"string","customer_notes":"string","discount":1.5,"due_at":"2020-01-01T00:00:00Z","id":"string","line_items":{"data":[],"has_more":false,"next":null,"object":"list"},"net_terms":1,"number":"string","object":"string","origin":"purchase","paid":1.5,"po_number":"string","previous_invoice_id":"string","refundable_amount":1.5,"shipping_address":{},"state":"open","subscription_ids":["string"],"subtotal":1.5,"tax":1.5,"tax_info":{},"terms_and_conditions":"string","total":1.5,"transactions":[{}],"type":"charge","updated_at":"2020-01-01T00:00:00Z","vat_number":"string","vat_reverse_charge_notes":"string"}],"object":"string"}`,
+			`{"charge_invoice":null,"credit_invoices":null,"object":null}`,
+		},
+	},
+	{
+		name: "AccountNote",
+		new:  func() interface{} { return &AccountNote{} },
+		documents: []string{
+			`{"account_id":"","created_at":null,"id":"","message":"","object":"","user":{}}`,
+			`{"account_id":"string","created_at":"2020-01-01T00:00:00Z","id":"string","message":"string","object":"string","user":{"created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","time_zone":"string"}}`,
+			`{"account_id":null,"created_at":null,"id":null,"message":null,"object":null,"user":null}`,
+		},
+	},
+	{
+		name: "User",
+		new:  func() interface{} { return &User{} },
+		documents: []string{
+			`{"created_at":null,"deleted_at":null,"email":"","first_name":"","id":"","last_name":"","object":"","time_zone":""}`,
+			`{"created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","time_zone":"string"}`,
+			`{"created_at":null,"deleted_at":null,"email":null,"first_name":null,"id":null,"last_name":null,"object":null,"time_zone":null}`,
+		},
+	},
+	{
+		name: "Subscription",
+		new:  func() interface{} { return &Subscription{} },
+		documents: []string{
+			`{"account":{},"activated_at":null,"add_ons":[],"add_ons_total":0,"auto_renew":false,"bank_account_authorized_at":null,"canceled_at":null,"collection_method":"","coupon_redemptions":[],"created_at":null,"currency":"","current_period_ends_at":null,"current_period_started_at":null,"current_term_ends_at":null,"current_term_started_at":null,"custom_fields":[],"customer_notes":"","expiration_reason":"","expires_at":null,"id":"","net_terms":0,"object":"","paused_at":null,"pending_change":{},"plan":{},"po_number":"","quantity":0,"remaining_billing_cycles":0,"remaining_pause_cycles":0,"renewal_billing_cycles":0,"revenue_schedule_type":"","shipping":{},"state":"","subtotal":0,"terms_and_conditions":"","total_billing_cycles":0,"trial_ends_at":null,"trial_started_at":null,"unit_amount":0,"updated_at":null,"uuid":""}`,
+			`{"account":{"bill_to":"string","code":"string","company":"string","email":"string","first_name":"string","id":"string","last_name":"string","object":"string","parent_account_id":"string"},"activated_at":"2020-01-01T00:00:00Z","add_ons":[{"add_on":{},"created_at":"2020-01-01T00:00:00Z","expired_at":"2020-01-01T00:00:00Z","id":"string","object":"string","quantity":1,"subscription_id":"string","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z"}],"add_ons_total":1.5,"auto_renew":true,"bank_account_authorized_at":"2020-01-01T00:00:00Z","canceled_at":"2020-01-01T00:00:00Z","collection_method":"automatic","coupon_redemptions":[{"coupon":{},"created_at":"2020-01-01T00:00:00Z","discounted":1.5,"id":"string","object":"string","state":"active"}],"created_at":"2020-01-01T00:00:00Z","currency":"string","current_period_ends_at":"2020-01-01T00:00:00Z","current_period_started_at":"2020-01-01T00:00:00Z","current_term_ends_at":"2020-01-01T00:00:00Z","current_term_started_at":"2020-01-01T00:00:00Z","custom_fields":[{"name":"string","value":"string"}],"customer_notes":"string","expiration_reason":"string","expires_at":"2020-01-01T00:00:00Z","id":"string","net_terms":1,"object":"string","paused_at":"2020-01-01T00:00:00Z","pending_change":{"activate_at":"2020-01-01T00:00:00Z","activated":true,"add_ons":[{}],"created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","id":"string","object":"string","plan":{"code":"string","id":"string","name":"string","object":"string"},"quantity":1,"revenue_schedule_type":"never","setup_fee_revenue_schedule_type":"never","shipping":{"address":{},"amount":1.5,"method":{},"object":"string"},"subscription_id":"string","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z"},"plan":{"code":"string","id":"string","name":"string","object":"string"},"po_number":"string","quantity":1,"remaining_billing_cycles":1,"remaining_pause_cycles":1,"renewal_billing_cycles":1,"revenue_schedule_type":"never","shipping":{"address":{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"},"amount":1.5,"method":{"code":"string","id":"string","name":"string","object":"string"},"object":"string"},"state":"active","subtotal":1.5,"terms_and_conditions":"string","total_billing_cycles":1,"trial_ends_at":"2020-01-01T00:00:00Z","trial_started_at":"2020-01-01T00:00:00Z","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z","uuid":"string"}`,
+			`{"account":null,"activated_at":null,"add_ons":null,"add_ons_total":null,"auto_renew":null,"bank_account_authorized_at":null,"canceled_at":null,"collection_method":null,"coupon_redemptions":null,"created_at":null,"currency":null,"current_period_ends_at":null,"current_period_started_at":null,"current_term_ends_at":null,"current_term_started_at":null,"custom_fields":null,"customer_notes":null,"expiration_reason":null,"expires_at":null,"id":null,"net_terms":null,"object":null,"paused_at":null,"pending_change":null,"plan":null,"po_number":null,"quantity":null,"remaining_billing_cycles":null,"remaining_pause_cycles":null,"renewal_billing_cycles":null,"revenue_schedule_type":null,"shipping":null,"state":null,"subtotal":null,"terms_and_conditions":null,"total_billing_cycles":null,"trial_ends_at":null,"trial_started_at":null,"unit_amount":null,"updated_at":null,"uuid":null}`,
+		},
+	},
+	{
+		name: "SubscriptionShipping",
+		new:  func() interface{} { return &SubscriptionShipping{} },
+		documents: []string{
+			`{"address":{},"amount":0,"method":{},"object":""}`,
+			`{"address":{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"},"amount":1.5,"method":{"code":"string","id":"string","name":"string","object":"string"},"object":"string"}`,
+			`{"address":null,"amount":null,"method":null,"object":null}`,
+		},
+	},
+	{
+		name: "ShippingMethodMini",
+		new:  func() interface{} { return &ShippingMethodMini{} },
+		documents: []string{
+			`{"code":"","id":"","name":"","object":""}`,
+			`{"code":"string","id":"string","name":"string","object":"string"}`,
+			`{"code":null,"id":null,"name":null,"object":null}`,
+		},
+	},
+	{
+		name: "CouponRedemptionMini",
+		new:  func() interface{} { return &CouponRedemptionMini{} },
+		documents: []string{
+			`{"coupon":{},"created_at":null,"discounted":0,"id":"","object":"","state":""}`,
+			`{"coupon":{"code":"string","coupon_type":"single_code","discount":{"currencies":[{}],"percent":1,"trial":{},"type":"percent"},"expired_at":"2020-01-01T00:00:00Z","id":"string","name":"string","object":"string","state":"redeemable"},"created_at":"2020-01-01T00:00:00Z","discounted":1.5,"id":"string","object":"string","state":"active"}`,
+			`{"coupon":null,"created_at":null,"discounted":null,"id":null,"object":null,"state":null}`,
+		},
+	},
+	{
+		name: "CouponMini",
+		new:  func() interface{} { return &CouponMini{} },
+		documents: []string{
+			`{"code":"","coupon_type":"","discount":{},"expired_at":null,"id":"","name":"","object":"","state":""}`,
+			`{"code":"string","coupon_type":"single_code","discount":{"currencies":[{}],"percent":1,"trial":{"length":1,"unit":"day"},"type":"percent"},"expired_at":"2020-01-01T00:00:00Z","id":"string","name":"string","object":"string","state":"redeemable"}`,
+			`{"code":null,"coupon_type":null,"discount":null,"expired_at":null,"id":null,"name":null,"object":null,"state":null}`,
+		},
+	},
+	{
+		name: "SubscriptionChange",
+		new:  func() interface{} { return &SubscriptionChange{} },
+		documents: []string{
+			`{"activate_at":null,"activated":false,"add_ons":[],"created_at":null,"deleted_at":null,"id":"","object":"","plan":{},"quantity":0,"revenue_schedule_type":"","setup_fee_revenue_schedule_type":"","shipping":{},"subscription_id":"","unit_amount":0,"updated_at":null}`,
+			`{"activate_at":"2020-01-01T00:00:00Z","activated":true,"add_ons":[{"add_on":{},"created_at":"2020-01-01T00:00:00Z","expired_at":"2020-01-01T00:00:00Z","id":"string","object":"string","quantity":1,"subscription_id":"string","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z"}],"created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","id":"string","object":"string","plan":{"code":"string","id":"string","name":"string","object":"string"},"quantity":1,"revenue_schedule_type":"never","setup_fee_revenue_schedule_type":"never","shipping":{"address":{"account_id":"string","city":"string","company":"string","country":"string","created_at":"2020-01-01T00:00:00Z","email":"string","first_name":"string","id":"string","last_name":"string","nickname":"string","object":"string","phone":"string","postal_code":"string","region":"string","street1":"string","street2":"string","updated_at":"2020-01-01T00:00:00Z","vat_number":"string"},"amount":1.5,"method":{"code":"string","id":"string","name":"string","object":"string"},"object":"string"},"subscription_id":"string","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"activate_at":null,"activated":null,"add_ons":null,"created_at":null,"deleted_at":null,"id":null,"object":null,"plan":null,"quantity":null,"revenue_schedule_type":null,"setup_fee_revenue_schedule_type":null,"shipping":null,"subscription_id":null,"unit_amount":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "SubscriptionAddOn",
+		new:  func() interface{} { return &SubscriptionAddOn{} },
+		documents: []string{
+			`{"add_on":{},"created_at":null,"expired_at":null,"id":"","object":"","quantity":0,"subscription_id":"","unit_amount":0,"updated_at":null}`,
+			`{"add_on":{"accounting_code":"string","code":"string","external_sku":"string","id":"string","item_id":"string","name":"string","object":"string"},"created_at":"2020-01-01T00:00:00Z","expired_at":"2020-01-01T00:00:00Z","id":"string","object":"string","quantity":1,"subscription_id":"string","unit_amount":1.5,"updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"add_on":null,"created_at":null,"expired_at":null,"id":null,"object":null,"quantity":null,"subscription_id":null,"unit_amount":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "AddOnMini",
+		new:  func() interface{} { return &AddOnMini{} },
+		documents: []string{
+			`{"accounting_code":"","code":"","external_sku":"","id":"","item_id":"","name":"","object":""}`,
+			`{"accounting_code":"string","code":"string","external_sku":"string","id":"string","item_id":"string","name":"string","object":"string"}`,
+			`{"accounting_code":null,"code":null,"external_sku":null,"id":null,"item_id":null,"name":null,"object":null}`,
+		},
+	},
+	{
+		name: "UniqueCouponCode",
+		new:  func() interface{} { return &UniqueCouponCode{} },
+		documents: []string{
+			`{"code":"","created_at":null,"expired_at":null,"id":"","object":"","redeemed_at":null,"state":"","updated_at":null}`,
+			`{"code":"string","created_at":"2020-01-01T00:00:00Z","expired_at":"2020-01-01T00:00:00Z","id":"string","object":"string","redeemed_at":"2020-01-01T00:00:00Z","state":"redeemable","updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"code":null,"created_at":null,"expired_at":null,"id":null,"object":null,"redeemed_at":null,"state":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "CustomFieldDefinition",
+		new:  func() interface{} { return &CustomFieldDefinition{} },
+		documents: []string{
+			`{"created_at":null,"deleted_at":null,"display_name":"","id":"","name":"","object":"","related_type":"","tooltip":"","updated_at":null,"user_access":""}`,
+			`{"created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","display_name":"string","id":"string","name":"string","object":"string","related_type":"account","tooltip":"string","updated_at":"2020-01-01T00:00:00Z","user_access":"api_only"}`,
+			`{"created_at":null,"deleted_at":null,"display_name":null,"id":null,"name":null,"object":null,"related_type":null,"tooltip":null,"updated_at":null,"user_access":null}`,
+		},
+	},
+	{
+		name: "Item",
+		new:  func() interface{} { return &Item{} },
+		documents: []string{
+			`{"accounting_code":"","code":"","created_at":null,"currencies":[],"custom_fields":[],"deleted_at":null,"description":"","external_sku":"","id":"","name":"","object":"","revenue_schedule_type":"","state":"","tax_code":"","tax_exempt":false,"updated_at":null}`,
+			`{"accounting_code":"string","code":"string","created_at":"2020-01-01T00:00:00Z","currencies":[{"currency":"string","unit_amount":1.5}],"custom_fields":[{"name":"string","value":"string"}],"deleted_at":"2020-01-01T00:00:00Z","description":"string","external_sku":"string","id":"string","name":"string","object":"string","revenue_schedule_type":"never","state":"active","tax_code":"string","tax_exempt":true,"updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"accounting_code":null,"code":null,"created_at":null,"currencies":null,"custom_fields":null,"deleted_at":null,"description":null,"external_sku":null,"id":null,"name":null,"object":null,"revenue_schedule_type":null,"state":null,"tax_code":null,"tax_exempt":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "Pricing",
+		new:  func() interface{} { return &Pricing{} },
+		documents: []string{
+			`{"currency":"","unit_amount":0}`,
+			`{"currency":"string","unit_amount":1.5}`,
+			`{"currency":null,"unit_amount":null}`,
+		},
+	},
+	{
+		name: "Plan",
+		new:  func() interface{} { return &Plan{} },
+		documents: []string{
+			`{"accounting_code":"","auto_renew":false,"code":"","created_at":null,"currencies":[],"deleted_at":null,"description":"","hosted_pages":{},"id":"","interval_length":0,"interval_unit":"","name":"","object":"","revenue_schedule_type":"","setup_fee_accounting_code":"","setup_fee_revenue_schedule_type":"","state":"","tax_code":"","tax_exempt":false,"total_billing_cycles":0,"trial_length":0,"trial_unit":"","updated_at":null}`,
+			`{"accounting_code":"string","auto_renew":true,"code":"string","created_at":"2020-01-01T00:00:00Z","currencies":[{"currency":"string","setup_fee":1.5,"unit_amount":1.5}],"deleted_at":"2020-01-01T00:00:00Z","description":"string","hosted_pages":{"bypass_confirmation":true,"cancel_url":"string","display_quantity":true,"success_url":"string"},"id":"string","interval_length":1,"interval_unit":"days","name":"string","object":"string","revenue_schedule_type":"never","setup_fee_accounting_code":"string","setup_fee_revenue_schedule_type":"never","state":"active","tax_code":"string","tax_exempt":true,"total_billing_cycles":1,"trial_length":1,"trial_unit":"days","updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"accounting_code":null,"auto_renew":null,"code":null,"created_at":null,"currencies":null,"deleted_at":null,"description":null,"hosted_pages":null,"id":null,"interval_length":null,"interval_unit":null,"name":null,"object":null,"revenue_schedule_type":null,"setup_fee_accounting_code":null,"setup_fee_revenue_schedule_type":null,"state":null,"tax_code":null,"tax_exempt":null,"total_billing_cycles":null,"trial_length":null,"trial_unit":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "PlanPricing",
+		new:  func() interface{} { return &PlanPricing{} },
+		documents: []string{
+			`{"currency":"","setup_fee":0,"unit_amount":0}`,
+			`{"currency":"string","setup_fee":1.5,"unit_amount":1.5}`,
+			`{"currency":null,"setup_fee":null,"unit_amount":null}`,
+		},
+	},
+	{
+		name: "PlanHostedPages",
+		new:  func() interface{} { return &PlanHostedPages{} },
+		documents: []string{
+			`{"bypass_confirmation":false,"cancel_url":"","display_quantity":false,"success_url":""}`,
+			`{"bypass_confirmation":true,"cancel_url":"string","display_quantity":true,"success_url":"string"}`,
+			`{"bypass_confirmation":null,"cancel_url":null,"display_quantity":null,"success_url":null}`,
+		},
+	},
+	{
+		name: "AddOn",
+		new:  func() interface{} { return &AddOn{} },
+		documents: []string{
+			`{"accounting_code":"","code":"","created_at":null,"currencies":[],"default_quantity":0,"deleted_at":null,"display_quantity":false,"external_sku":"","id":"","item":{},"name":"","object":"","optional":false,"plan_id":"","revenue_schedule_type":"","state":"","tax_code":"","updated_at":null}`,
+			`{"accounting_code":"string","code":"string","created_at":"2020-01-01T00:00:00Z","currencies":[{"currency":"string","unit_amount":1.5}],"default_quantity":1,"deleted_at":"2020-01-01T00:00:00Z","display_quantity":true,"external_sku":"string","id":"string","item":{"code":"string","description":"string","id":"string","name":"string","object":"string","state":"active"},"name":"string","object":"string","optional":true,"plan_id":"string","revenue_schedule_type":"never","state":"active","tax_code":"string","updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"accounting_code":null,"code":null,"created_at":null,"currencies":null,"default_quantity":null,"deleted_at":null,"display_quantity":null,"external_sku":null,"id":null,"item":null,"name":null,"object":null,"optional":null,"plan_id":null,"revenue_schedule_type":null,"state":null,"tax_code":null,"updated_at":null}`,
+		},
+	},
+	{
+		name: "AddOnPricing",
+		new:  func() interface{} { return &AddOnPricing{} },
+		documents: []string{
+			`{"currency":"","unit_amount":0}`,
+			`{"currency":"string","unit_amount":1.5}`,
+			`{"currency":null,"unit_amount":null}`,
+		},
+	},
+	{
+		name: "ItemMini",
+		new:  func() interface{} { return &ItemMini{} },
+		documents: []string{
+			`{"code":"","description":"","id":"","name":"","object":"","state":""}`,
+			`{"code":"string","description":"string","id":"string","name":"string","object":"string","state":"active"}`,
+			`{"code":null,"description":null,"id":null,"name":null,"object":null,"state":null}`,
+		},
+	},
+	{
+		name: "ShippingMethod",
+		new:  func() interface{} { return &ShippingMethod{} },
+		documents: []string{
+			`{"code":"","created_at":null,"deleted_at":null,"id":"","name":"","object":"","tax_code":"","updated_at":null}`,
+			`{"code":"string","created_at":"2020-01-01T00:00:00Z","deleted_at":"2020-01-01T00:00:00Z","id":"string","name":"string","object":"string","tax_code":"string","updated_at":"2020-01-01T00:00:00Z"}`,
+			`{"code":null,"created_at":null,"deleted_at":null,"id":null,"name":null,"object":null,"tax_code":null,"updated_at":null}`,
+		},
+	},
+}
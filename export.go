@@ -0,0 +1,443 @@
+package recurly
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultExportConcurrency      = 4
+	defaultExportMaxWindowRecords = 10000
+)
+
+// ExportOptions configures a sharded export
+type ExportOptions struct {
+	// Concurrency is the number of windows paged through at the same time.
+	// It defaults to 4.
+	Concurrency int
+
+	// Windows is the number of equal windows the time range is split into at
+	// first. It defaults to the Concurrency.
+	Windows int
+
+	// MaxWindowRecords is the number of records a window may match before it
+	// is split in two, based on its Count. It defaults to 10,000.
+	MaxWindowRecords int64
+}
+
+// exportItem is a record of an export
+type exportItem struct {
+	value interface{}
+	id    string
+	// edge is set when the record was created in the second a window starts
+	// or ends at, so it may also be part of the neighbouring window
+	edge bool
+}
+
+// exportSource pages through the records of a single window
+type exportSource struct {
+	count func() (*int64, error)
+	next  func() bool
+	item  func() (value interface{}, id string, createdAt time.Time)
+	err   func() error
+	close func()
+}
+
+// exportWindow is a time range, inclusive of both ends like the
+// `begin_time` and `end_time` filters
+type exportWindow struct {
+	begin time.Time
+	end   time.Time
+}
+
+// export pages through the windows of a time range concurrently, and merges
+// their records into a single stream. The typed exports embed it.
+type export struct {
+	items   chan exportItem
+	done    chan struct{}
+	once    sync.Once
+	seen    map[string]bool
+	current interface{}
+
+	mu  sync.Mutex
+	err error
+}
+
+// start splits the time range into windows and starts paging through them.
+// open returns the source of the records of a window.
+func (e *export) start(begin *time.Time, end *time.Time, options *ExportOptions, open func(begin, end time.Time) exportSource) {
+	e.items = make(chan exportItem)
+	e.done = make(chan struct{})
+	e.seen = map[string]bool{}
+
+	if begin == nil {
+		e.err = &Error{
+			Message: "exports require a BeginTime",
+			Class:   ErrorClassClient,
+			Type:    ErrorTypeValidation,
+			Params:  []ErrorParam{{Property: "begin_time", Message: "is required for exports"}},
+		}
+		close(e.items)
+		return
+	}
+	until := time.Now()
+	if end != nil {
+		until = *end
+	}
+
+	opts := ExportOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultExportConcurrency
+	}
+	if opts.Windows <= 0 {
+		opts.Windows = opts.Concurrency
+	}
+	if opts.MaxWindowRecords <= 0 {
+		opts.MaxWindowRecords = defaultExportMaxWindowRecords
+	}
+
+	// windows are split at whole seconds, like the filters
+	first, last := begin.Truncate(time.Second), until.Truncate(time.Second)
+	slots := make(chan struct{}, opts.Concurrency)
+	var windows sync.WaitGroup
+	var process func(window exportWindow)
+	process = func(window exportWindow) {
+		defer windows.Done()
+		select {
+		case slots <- struct{}{}:
+		case <-e.done:
+			return
+		}
+		defer func() { <-slots }()
+
+		// the outer windows keep the exact bounds of the range
+		from, to := window.begin, window.end
+		if from.Equal(first) {
+			from = *begin
+		}
+		if to.Equal(last) {
+			to = until
+		}
+		source := open(from, to)
+		defer source.close()
+
+		if halves := window.split(); halves != nil {
+			count, err := source.count()
+			if err != nil {
+				e.fail(err)
+				return
+			}
+			if count != nil && *count > opts.MaxWindowRecords {
+				windows.Add(len(halves))
+				for _, half := range halves {
+					go process(half)
+				}
+				return
+			}
+		}
+
+		for source.next() {
+			value, id, createdAt := source.item()
+			if createdAt.Before(*begin) || createdAt.After(until) {
+				// the filters only have whole seconds, so the outer
+				// windows may match records just outside the range
+				continue
+			}
+			createdAt = createdAt.Truncate(time.Second)
+			item := exportItem{
+				value: value,
+				id:    id,
+				edge:  createdAt.Equal(window.begin) || createdAt.Equal(window.end),
+			}
+			select {
+			case e.items <- item:
+			case <-e.done:
+				return
+			}
+		}
+		if err := source.err(); err != nil {
+			e.fail(err)
+		}
+	}
+
+	initial := splitWindows(exportWindow{first, last}, opts.Windows)
+	windows.Add(len(initial))
+	for _, window := range initial {
+		go process(window)
+	}
+	go func() {
+		windows.Wait()
+		close(e.items)
+	}()
+}
+
+// splitWindows splits a window into n windows of the same duration, at
+// whole seconds. Neighbouring windows share the second they meet at.
+func splitWindows(window exportWindow, n int) []exportWindow {
+	duration := window.end.Sub(window.begin)
+	if max := int(duration / time.Second); n > max {
+		n = max
+	}
+	if n <= 1 {
+		return []exportWindow{window}
+	}
+	windows := make([]exportWindow, n)
+	begin := window.begin
+	for i := range windows {
+		end := window.end
+		if i < n-1 {
+			end = window.begin.Add(duration * time.Duration(i+1) / time.Duration(n)).Truncate(time.Second)
+		}
+		windows[i] = exportWindow{begin, end}
+		begin = end
+	}
+	return windows
+}
+
+// split returns the two halves of the window, or nil if it is too short to split
+func (window exportWindow) split() []exportWindow {
+	halves := splitWindows(window, 2)
+	if len(halves) < 2 {
+		return nil
+	}
+	return halves
+}
+
+// fail records the first error and stops the export
+func (e *export) fail(err error) {
+	e.mu.Lock()
+	if e.err == nil {
+		e.err = err
+	}
+	e.mu.Unlock()
+	e.Close()
+}
+
+// next advances to the next record, skipping records already returned for a
+// neighbouring window
+func (e *export) next() bool {
+	for {
+		select {
+		case item, ok := <-e.items:
+			if !ok {
+				return false
+			}
+			if item.edge {
+				if e.seen[item.id] {
+					continue
+				}
+				e.seen[item.id] = true
+			}
+			e.current = item.value
+			return true
+		case <-e.done:
+			return false
+		}
+	}
+}
+
+// Err returns the error which stopped the export, if any
+func (e *export) Err() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
+// Close stops the export. Exports stop by themselves after the last record
+// or an error, Close releases an export which is abandoned before that.
+func (e *export) Close() {
+	e.once.Do(func() {
+		close(e.done)
+	})
+}
+
+// InvoiceExport is the merged stream of invoices of a sharded export
+type InvoiceExport struct {
+	export
+}
+
+// Next advances to the next Invoice. It returns false after the last record or
+// when an error occurs, see Err.
+func (e *InvoiceExport) Next() bool {
+	return e.next()
+}
+
+// Item returns the current Invoice
+func (e *InvoiceExport) Item() *Invoice {
+	item, _ := e.current.(*Invoice)
+	return item
+}
+
+// ExportInvoices pages through the invoices created between the BeginTime and
+// EndTime of the params concurrently, in windows of time. Windows matching
+// more than MaxWindowRecords invoices are split further. Every invoice is
+// returned once, in no particular order.
+func (c *Client) ExportInvoices(params *ListInvoicesParams, options *ExportOptions) *InvoiceExport {
+	base := ListInvoicesParams{}
+	if params != nil {
+		base = *params
+	}
+	e := &InvoiceExport{}
+	e.start(base.BeginTime, base.EndTime, options, func(begin, end time.Time) exportSource {
+		window := base
+		window.BeginTime, window.EndTime = &begin, &end
+		window.Sort, window.Order = String("created_at"), String("asc")
+		window.SortValue, window.OrderValue = "", ""
+		list := c.ListInvoices(&window)
+		return exportSource{
+			count: list.Count,
+			next:  list.Next,
+			item: func() (interface{}, string, time.Time) {
+				item := list.Item()
+				return item, item.Id, item.CreatedAt.Time
+			},
+			err:   list.Err,
+			close: list.Close,
+		}
+	})
+	return e
+}
+
+// TransactionExport is the merged stream of transactions of a sharded export
+type TransactionExport struct {
+	export
+}
+
+// Next advances to the next Transaction. It returns false after the last
+// record or when an error occurs, see Err.
+func (e *TransactionExport) Next() bool {
+	return e.next()
+}
+
+// Item returns the current Transaction
+func (e *TransactionExport) Item() *Transaction {
+	item, _ := e.current.(*Transaction)
+	return item
+}
+
+// ExportTransactions pages through the transactions created between the
+// BeginTime and EndTime of the params concurrently, in windows of time.
+// Windows matching more than MaxWindowRecords transactions are split further.
+// Every transaction is returned once, in no particular order.
+func (c *Client) ExportTransactions(params *ListTransactionsParams, options *ExportOptions) *TransactionExport {
+	base := ListTransactionsParams{}
+	if params != nil {
+		base = *params
+	}
+	e := &TransactionExport{}
+	e.start(base.BeginTime, base.EndTime, options, func(begin, end time.Time) exportSource {
+		window := base
+		window.BeginTime, window.EndTime = &begin, &end
+		window.Sort, window.Order = String("created_at"), String("asc")
+		window.SortValue, window.OrderValue = "", ""
+		list := c.ListTransactions(&window)
+		return exportSource{
+			count: list.Count,
+			next:  list.Next,
+			item: func() (interface{}, string, time.Time) {
+				item := list.Item()
+				return item, item.Id, item.CreatedAt.Time
+			},
+			err:   list.Err,
+			close: list.Close,
+		}
+	})
+	return e
+}
+
+// LineItemExport is the merged stream of line items of a sharded export
+type LineItemExport struct {
+	export
+}
+
+// Next advances to the next LineItem. It returns false after the last record
+// or when an error occurs, see Err.
+func (e *LineItemExport) Next() bool {
+	return e.next()
+}
+
+// Item returns the current LineItem
+func (e *LineItemExport) Item() *LineItem {
+	item, _ := e.current.(*LineItem)
+	return item
+}
+
+// ExportLineItems pages through the line items created between the BeginTime
+// and EndTime of the params concurrently, in windows of time. Windows matching
+// more than MaxWindowRecords line items are split further. Every line item is
+// returned once, in no particular order.
+func (c *Client) ExportLineItems(params *ListLineItemsParams, options *ExportOptions) *LineItemExport {
+	base := ListLineItemsParams{}
+	if params != nil {
+		base = *params
+	}
+	e := &LineItemExport{}
+	e.start(base.BeginTime, base.EndTime, options, func(begin, end time.Time) exportSource {
+		window := base
+		window.BeginTime, window.EndTime = &begin, &end
+		window.Sort, window.Order = String("created_at"), String("asc")
+		window.SortValue, window.OrderValue = "", ""
+		list := c.ListLineItems(&window)
+		return exportSource{
+			count: list.Count,
+			next:  list.Next,
+			item: func() (interface{}, string, time.Time) {
+				item := list.Item()
+				return item, item.Id, item.CreatedAt.Time
+			},
+			err:   list.Err,
+			close: list.Close,
+		}
+	})
+	return e
+}
+
+// AccountExport is the merged stream of accounts of a sharded export
+type AccountExport struct {
+	export
+}
+
+// Next advances to the next Account. It returns false after the last record or
+// when an error occurs, see Err.
+func (e *AccountExport) Next() bool {
+	return e.next()
+}
+
+// Item returns the current Account
+func (e *AccountExport) Item() *Account {
+	item, _ := e.current.(*Account)
+	return item
+}
+
+// ExportAccounts pages through the accounts created between the BeginTime and
+// EndTime of the params concurrently, in windows of time. Windows matching
+// more than MaxWindowRecords accounts are split further. Every account is
+// returned once, in no particular order.
+func (c *Client) ExportAccounts(params *ListAccountsParams, options *ExportOptions) *AccountExport {
+	base := ListAccountsParams{}
+	if params != nil {
+		base = *params
+	}
+	e := &AccountExport{}
+	e.start(base.BeginTime, base.EndTime, options, func(begin, end time.Time) exportSource {
+		window := base
+		window.BeginTime, window.EndTime = &begin, &end
+		window.Sort, window.Order = String("created_at"), String("asc")
+		window.SortValue, window.OrderValue = "", ""
+		list := c.ListAccounts(&window)
+		return exportSource{
+			count: list.Count,
+			next:  list.Next,
+			item: func() (interface{}, string, time.Time) {
+				item := list.Item()
+				return item, item.Id, item.CreatedAt.Time
+			},
+			err:   list.Err,
+			close: list.Close,
+		}
+	})
+	return e
+}
@@ -0,0 +1,210 @@
+package recurly
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var exportBegin = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// exportServer answers time filtered invoice lists from a fixed set of
+// invoices, three per page
+type exportServer struct {
+	sync.Mutex
+	created []time.Time
+	windows []string
+	fail    bool
+}
+
+func (s *exportServer) roundTrip(req *http.Request) *http.Response {
+	query := req.URL.Query()
+	begin, _ := time.Parse("2006-01-02T15:04:05Z", query.Get("begin_time"))
+	end, _ := time.Parse("2006-01-02T15:04:05Z", query.Get("end_time"))
+	if query.Get("sort") != "created_at" || query.Get("order") != "asc" {
+		panic("exports must sort by created_at")
+	}
+	if s.fail {
+		return mockResponse(req, 500, String(`{"error":{"type":"internal_server_error","message":"Oops"}}`))
+	}
+
+	// the filters are inclusive, at whole seconds
+	var matching []string
+	for i, created := range s.created {
+		if !created.Before(begin) && created.Before(end.Add(time.Second)) {
+			matching = append(matching, fmt.Sprintf(`{"id":"inv%d","created_at":%q}`, i, created.Format(time.RFC3339Nano)))
+		}
+	}
+
+	if req.Method == http.MethodHead {
+		res := mockResponse(req, 200, String(""))
+		res.Header.Set("Recurly-Total-Records", strconv.Itoa(len(matching)))
+		return res
+	}
+
+	offset, _ := strconv.Atoi(query.Get("cursor"))
+	if offset == 0 {
+		s.Lock()
+		s.windows = append(s.windows, query.Get("begin_time")+"/"+query.Get("end_time"))
+		s.Unlock()
+	}
+	page := matching[offset:]
+	if len(page) > 3 {
+		page = page[:3]
+	}
+	hasMore := offset+len(page) < len(matching)
+	query.Set("cursor", strconv.Itoa(offset+len(page)))
+	body := fmt.Sprintf(`{"object":"list","has_more":%t,"next":%q,"data":[%s]}`,
+		hasMore, "/invoices?"+query.Encode(), strings.Join(page, ","))
+	return mockResponse(req, 200, String(body))
+}
+
+func (s *exportServer) client() *Client {
+	client := newClient("APIKEY", &http.Client{Transport: roundTripFunc(s.roundTrip)})
+	client.Log = NewLogger(LevelWarn)
+	return client
+}
+
+func exportIds(t *T, invoices *InvoiceExport) []string {
+	var ids []string
+	for invoices.Next() {
+		ids = append(ids, invoices.Item().Id)
+	}
+	if err := invoices.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestExportReturnsEveryRecordOnce(test *testing.T) {
+	t := &T{test}
+	server := &exportServer{}
+	var expected []string
+	for i := 0; i < 40; i++ {
+		server.created = append(server.created, exportBegin.Add(time.Duration(i)*time.Minute))
+		expected = append(expected, fmt.Sprintf("inv%d", i))
+	}
+	// a record on the boundary between the first two windows
+	server.created = append(server.created, exportBegin.Add(10*time.Minute))
+	expected = append(expected, "inv40")
+	sort.Strings(expected)
+
+	end := exportBegin.Add(40 * time.Minute)
+	invoices := server.client().ExportInvoices(&ListInvoicesParams{
+		BeginTime: &exportBegin,
+		EndTime:   &end,
+	}, &ExportOptions{Concurrency: 4, MaxWindowRecords: 100})
+
+	t.Assert(strings.Join(exportIds(t, invoices), ","), strings.Join(expected, ","), "Invoice ids")
+	t.Assert(len(server.windows), 4, "Number of windows")
+}
+
+func TestExportSplitsLargeWindows(test *testing.T) {
+	t := &T{test}
+	server := &exportServer{}
+	// most invoices are created in the first minute
+	for i := 0; i < 30; i++ {
+		server.created = append(server.created, exportBegin.Add(time.Duration(i)*time.Second))
+	}
+	server.created = append(server.created, exportBegin.Add(50*time.Minute))
+
+	end := exportBegin.Add(time.Hour)
+	invoices := server.client().ExportInvoices(&ListInvoicesParams{
+		BeginTime: &exportBegin,
+		EndTime:   &end,
+	}, &ExportOptions{Windows: 2, MaxWindowRecords: 5})
+
+	ids := exportIds(t, invoices)
+	t.Assert(len(ids), 31, "Number of invoices")
+	if len(server.windows) <= 2 {
+		t.Errorf("Expected large windows to be split, got %v", server.windows)
+	}
+	for i := 1; i < len(ids); i++ {
+		if ids[i] == ids[i-1] {
+			t.Errorf("Duplicate invoice %s", ids[i])
+		}
+	}
+}
+
+func TestExportKeepsExactBounds(test *testing.T) {
+	t := &T{test}
+	server := &exportServer{}
+	for _, offset := range []time.Duration{200, 700, 60300} {
+		server.created = append(server.created, exportBegin.Add(offset*time.Millisecond))
+	}
+
+	begin := exportBegin.Add(500 * time.Millisecond)
+	end := exportBegin.Add(time.Minute + 100*time.Millisecond)
+	invoices := server.client().ExportInvoices(&ListInvoicesParams{
+		BeginTime: &begin,
+		EndTime:   &end,
+	}, &ExportOptions{Windows: 2})
+
+	t.Assert(strings.Join(exportIds(t, invoices), ","), "inv1", "Invoice ids")
+}
+
+func TestExportOverridesTypedSort(test *testing.T) {
+	t := &T{test}
+	server := &exportServer{}
+	server.created = []time.Time{exportBegin, exportBegin.Add(time.Minute)}
+
+	end := exportBegin.Add(time.Hour)
+	invoices := server.client().ExportInvoices(&ListInvoicesParams{
+		BeginTime:  &exportBegin,
+		EndTime:    &end,
+		SortValue:  SortUpdatedAt,
+		OrderValue: ListDescending,
+	}, nil)
+
+	t.Assert(strings.Join(exportIds(t, invoices), ","), "inv0,inv1", "Invoice ids")
+}
+
+func TestExportRequiresBeginTime(test *testing.T) {
+	t := &T{test}
+	server := &exportServer{}
+	invoices := server.client().ExportInvoices(nil, nil)
+
+	t.Assert(invoices.Next(), false, "Next")
+	e, ok := invoices.Err().(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %v", invoices.Err())
+	}
+	t.Assert(e.Type, ErrorTypeValidation, "Error.Type")
+	t.Assert(len(server.windows), 0, "Number of requests")
+}
+
+func TestExportStopsOnError(test *testing.T) {
+	t := &T{test}
+	server := &exportServer{fail: true}
+	invoices := server.client().ExportInvoices(&ListInvoicesParams{BeginTime: &exportBegin}, nil)
+
+	for invoices.Next() {
+	}
+	if _, ok := invoices.Err().(*Error); !ok {
+		t.Fatalf("Expected *Error, got %v", invoices.Err())
+	}
+}
+
+func TestSplitWindows(test *testing.T) {
+	t := &T{test}
+	window := exportWindow{exportBegin, exportBegin.Add(10 * time.Second)}
+
+	windows := splitWindows(window, 3)
+	t.Assert(len(windows), 3, "Number of windows")
+	t.Assert(windows[0].begin, window.begin, "First window begin")
+	t.Assert(windows[2].end, window.end, "Last window end")
+	for i := 1; i < len(windows); i++ {
+		t.Assert(windows[i].begin, windows[i-1].end, "Window boundary")
+		t.Assert(windows[i].begin.Nanosecond(), 0, "Boundary at a whole second")
+	}
+
+	short := exportWindow{exportBegin, exportBegin.Add(time.Second)}
+	t.Assert(len(splitWindows(short, 4)), 1, "Number of windows of a second")
+	t.Assert(short.split() == nil, true, "split of a second")
+}
@@ -1,3 +1,5 @@
 module github.com/recurly/recurly-client-go/v3
 
-go 1.12
+go 1.13
+
+require gopkg.in/yaml.v2 v2.4.0
@@ -0,0 +1,184 @@
+package recurly
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// maxIds is the number of IDs the API accepts in a single `ids` filter
+	maxIds = 200
+
+	// idsConcurrency is the number of chunks of IDs fetched at the same time
+	idsConcurrency = 4
+)
+
+// listPaths returns the paths of the first page of a list. Lists filtered by
+// more IDs than the API accepts at once are split into one path per chunk.
+func listPaths(path string, genericParams GenericParams) ([]string, error) {
+	var params *Params
+	if genericParams != nil && !reflect.ValueOf(genericParams).IsNil() { // test if the interface is nil
+		params = genericParams.toParams()
+	}
+	if params == nil {
+		return []string{path}, nil
+	}
+
+	var ids []string
+	var others []string
+	for _, kv := range params.URLParams() {
+		if kv.Key == "ids" {
+			ids = strings.Split(kv.Value, ",")
+		} else {
+			others = append(others, kv.Key)
+		}
+	}
+	if ids == nil {
+		return []string{BuildUrl(path, genericParams)}, nil
+	}
+	if len(others) > 0 {
+		message := "ids cannot be used with any other ordering or filtering parameters: " + strings.Join(others, ", ")
+		return nil, &Error{
+			Message: message,
+			Class:   ErrorClassClient,
+			Type:    ErrorTypeValidation,
+			Params:  []ErrorParam{{Property: "ids", Message: message}},
+		}
+	}
+
+	var paths []string
+	for start := 0; start < len(ids); start += maxIds {
+		end := start + maxIds
+		if end > len(ids) {
+			end = len(ids)
+		}
+		paths = append(paths, path+"?ids="+url.QueryEscape(strings.Join(ids[start:end], ",")))
+	}
+	return paths, nil
+}
+
+// chunkFetcher fetches the first page of every chunk of IDs concurrently
+type chunkFetcher struct {
+	sync.Mutex
+	// results holds the page of each chunk, by path. It is only used by the
+	// consumer of the list.
+	results map[string]chan prefetchedPage
+	limit   RateLimit
+	done    chan struct{}
+	once    sync.Once
+}
+
+// startChunks starts fetching the first page of the current and remaining chunks
+func (p *pager) startChunks(newPage func() listPage) *chunkFetcher {
+	paths := append([]string{p.nextPagePath}, p.chunks...)
+	cf := &chunkFetcher{
+		results: make(map[string]chan prefetchedPage, len(paths)),
+		done:    make(chan struct{}),
+	}
+	results := make([]chan prefetchedPage, len(paths))
+	for i, path := range paths {
+		results[i] = make(chan prefetchedPage, 1)
+		cf.results[path] = results[i]
+	}
+	go cf.run(p.client, paths, results, p.params, newPage)
+	return cf
+}
+
+func (cf *chunkFetcher) run(client *Client, paths []string, results []chan prefetchedPage, params *Params, newPage func() listPage) {
+	var ctxDone <-chan struct{}
+	if params != nil && params.Context != nil {
+		ctxDone = params.Context.Done()
+	}
+
+	slots := make(chan struct{}, idsConcurrency)
+	var inFlight sync.WaitGroup
+	for i, path := range paths {
+		// The first response tells how many requests remain in the rate
+		// limit. Once few remain, the chunks are fetched one at a time, and
+		// not at all until the limit resets once none are left.
+		if i == 1 {
+			inFlight.Wait()
+		}
+		if limit := cf.rateLimit(); limit.Limit > 0 && limit.Remaining <= idsConcurrency {
+			inFlight.Wait()
+			limit = cf.rateLimit()
+			if reset := limit.ResetDate(); limit.Remaining == 0 && reset != nil {
+				timer := time.NewTimer(time.Until(*reset))
+				select {
+				case <-timer.C:
+				case <-cf.done:
+					timer.Stop()
+					return
+				case <-ctxDone:
+					timer.Stop()
+					return
+				}
+			}
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-cf.done:
+			return
+		case <-ctxDone:
+			return
+		}
+
+		inFlight.Add(1)
+		go func(path string, result chan<- prefetchedPage) {
+			defer inFlight.Done()
+			page := newPage()
+			err := client.Call(http.MethodGet, path, params, page)
+			if err == nil {
+				cf.setRateLimit(page.GetResponse().RateLimit)
+			}
+			result <- prefetchedPage{page: page, err: err}
+			<-slots
+		}(path, results[i])
+	}
+}
+
+func (cf *chunkFetcher) rateLimit() RateLimit {
+	cf.Lock()
+	defer cf.Unlock()
+	return cf.limit
+}
+
+func (cf *chunkFetcher) setRateLimit(limit RateLimit) {
+	cf.Lock()
+	defer cf.Unlock()
+	cf.limit = limit
+}
+
+// next returns the first page of the chunk at path. ok is false if the path
+// is not the start of a chunk, or its page was already returned.
+func (cf *chunkFetcher) next(path string, params *Params) (result prefetchedPage, ok bool) {
+	results, ok := cf.results[path]
+	if !ok {
+		return result, false
+	}
+	delete(cf.results, path)
+
+	var ctxDone <-chan struct{}
+	if params != nil && params.Context != nil {
+		ctxDone = params.Context.Done()
+	}
+	select {
+	case result = <-results:
+		return result, true
+	case <-cf.done:
+		// stopped by Close, the chunk is fetched on demand
+		return result, false
+	case <-ctxDone:
+		return prefetchedPage{err: params.Context.Err()}, true
+	}
+}
+
+func (cf *chunkFetcher) stop() {
+	cf.once.Do(func() {
+		close(cf.done)
+	})
+}
@@ -0,0 +1,204 @@
+package recurly
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// idsServer answers list requests filtered by IDs with one account per ID
+type idsServer struct {
+	sync.Mutex
+	remaining   int
+	delay       time.Duration
+	requested   []string
+	inFlight    int
+	maxInFlight int
+}
+
+func (s *idsServer) roundTrip(req *http.Request) *http.Response {
+	s.Lock()
+	s.requested = append(s.requested, req.URL.RequestURI())
+	s.inFlight++
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+	s.Unlock()
+
+	time.Sleep(s.delay)
+
+	ids := strings.Split(req.URL.Query().Get("ids"), ",")
+	var data []string
+	for _, id := range ids {
+		data = append(data, fmt.Sprintf(`{"id":%q}`, id))
+	}
+	body := fmt.Sprintf(`{"object":"list","has_more":false,"next":null,"data":[%s]}`, strings.Join(data, ","))
+	res := mockResponse(req, 200, String(body))
+	res.Header.Set("Recurly-Total-Records", fmt.Sprint(len(ids)))
+	if s.remaining > 0 {
+		res.Header.Set("X-RateLimit-Limit", "2000")
+		res.Header.Set("X-RateLimit-Remaining", fmt.Sprint(s.remaining))
+		res.Header.Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+	}
+
+	s.Lock()
+	s.inFlight--
+	s.Unlock()
+	return res
+}
+
+func (s *idsServer) client() *Client {
+	client := newClient("APIKEY", &http.Client{Transport: roundTripFunc(s.roundTrip)})
+	client.Log = NewLogger(LevelWarn)
+	return client
+}
+
+func makeIds(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("id%d", i)
+	}
+	return ids
+}
+
+func TestIdsAreChunked(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{}
+	ids := makeIds(450)
+	accounts := server.client().ListAccounts(&ListAccountsParams{Ids: ids})
+
+	var got []string
+	for accounts.Next() {
+		got = append(got, accounts.Item().Id)
+	}
+	if err := accounts.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(strings.Join(got, ","), strings.Join(ids, ","), "Account ids")
+	t.Assert(len(server.requested), 3, "Number of requests")
+	t.Assert(accounts.Page(), 3, "Page")
+	for _, uri := range server.requested {
+		req, _ := http.NewRequest(http.MethodGet, uri, nil)
+		if n := len(strings.Split(req.URL.Query().Get("ids"), ",")); n > 200 {
+			t.Errorf("Expected at most 200 ids per request, got %d", n)
+		}
+	}
+}
+
+func TestIdsBelowLimitAreNotChunked(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{}
+	accounts := server.client().ListAccounts(&ListAccountsParams{Ids: []string{"a", "b"}})
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(len(accounts.Data), 2, "Number of accounts")
+	t.Assert(accounts.HasMore, false, "HasMore")
+	t.Assert(server.requested[0], "/accounts?ids=a%2Cb", "Request URI")
+}
+
+func TestIdsChunksAreFetchedConcurrently(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{delay: 20 * time.Millisecond}
+	accounts := server.client().ListAccounts(&ListAccountsParams{Ids: makeIds(2000)})
+
+	count := 0
+	for accounts.Next() {
+		count++
+	}
+	if err := accounts.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(count, 2000, "Number of accounts")
+	t.Assert(len(server.requested), 10, "Number of requests")
+	if server.maxInFlight < 2 || server.maxInFlight > idsConcurrency {
+		t.Errorf("Expected between 2 and %d concurrent requests, got %d", idsConcurrency, server.maxInFlight)
+	}
+}
+
+func TestIdsChunksObeyRateLimit(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{delay: 5 * time.Millisecond, remaining: 3}
+	accounts := server.client().ListAccounts(&ListAccountsParams{Ids: makeIds(1000)})
+
+	count := 0
+	for accounts.Next() {
+		count++
+	}
+	if err := accounts.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(count, 1000, "Number of accounts")
+	t.Assert(server.maxInFlight, 1, "Concurrent requests with a low rate limit")
+}
+
+func TestIdsCount(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{}
+	accounts := server.client().ListAccounts(&ListAccountsParams{Ids: makeIds(450)})
+
+	count, err := accounts.Count()
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(*count, int64(450), "Count")
+	t.Assert(len(server.requested), 3, "Number of requests")
+}
+
+func TestIdsCursor(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{}
+	client := server.client()
+	ids := makeIds(450)
+	accounts := client.ListAccounts(&ListAccountsParams{Ids: ids})
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	accounts.Close()
+
+	resumed, err := client.ResumeAccountList(accounts.Cursor(), nil)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	var got []string
+	for resumed.Next() {
+		got = append(got, resumed.Item().Id)
+	}
+	if err := resumed.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(strings.Join(got, ","), strings.Join(ids[200:], ","), "Resumed account ids")
+}
+
+func TestIdsRejectIncompatibleParams(test *testing.T) {
+	t := &T{test}
+	server := &idsServer{}
+	client := server.client()
+
+	accounts := client.ListAccounts(&ListAccountsParams{
+		Ids:   []string{"a"},
+		Limit: Int(10),
+		Sort:  String("created_at"),
+	})
+	err := accounts.Fetch()
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %v", err)
+	}
+	t.Assert(e.Type, ErrorTypeValidation, "Error.Type")
+	t.Assert(e.Class, ErrorClassClient, "Error.Class")
+	t.Assert(e.Params[0].Property, "ids", "Error.Params[0].Property")
+	t.Assert(e.Message, "ids cannot be used with any other ordering or filtering parameters: limit, sort", "Error.Message")
+
+	t.Assert(accounts.Next(), false, "Next")
+	t.Assert(accounts.Err(), err, "Err")
+	if _, err := accounts.Count(); err == nil {
+		t.Errorf("Expected Count to be rejected")
+	}
+	t.Assert(len(server.requested), 0, "Number of requests")
+}
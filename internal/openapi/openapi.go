@@ -0,0 +1,427 @@
+// Package openapi loads the subset of the OpenAPI 3 specification in
+// openapi/api.yaml that describes the Recurly client. Maps are decoded in the
+// order they appear in the document so anything derived from it is deterministic.
+package openapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Document is an OpenAPI document
+type Document struct {
+	Info       Info       `yaml:"info"`
+	Paths      Paths      `yaml:"paths"`
+	Components Components `yaml:"components"`
+}
+
+// Info contains the metadata of the API
+type Info struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// Components contains the reusable parameters and schemas of the document
+type Components struct {
+	Parameters map[string]*Parameter `yaml:"parameters"`
+	Schemas    Schemas               `yaml:"schemas"`
+}
+
+// Paths is the ordered list of paths in the document
+type Paths []*PathItem
+
+// PathItem describes the operations available on a single path
+type PathItem struct {
+	Path       string
+	Parameters []*Parameter
+	Operations []*Operation
+}
+
+// Operation describes a single API operation on a path
+type Operation struct {
+	// Method is the upper case HTTP method of the operation
+	Method string `yaml:"-"`
+	// Path is the path template of the operation as it appears in the document
+	Path string `yaml:"-"`
+
+	ID          string       `yaml:"operationId"`
+	Summary     string       `yaml:"summary"`
+	Description string       `yaml:"description"`
+	Tags        []string     `yaml:"tags"`
+	Parameters  []*Parameter `yaml:"parameters"`
+	RequestBody *RequestBody `yaml:"requestBody"`
+	Responses   Responses    `yaml:"responses"`
+}
+
+// Parameter describes a path or query parameter
+type Parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *Schema `yaml:"schema"`
+
+	// Component is the name of the parameter in the components of the
+	// document, for parameters defined there
+	Component string `yaml:"-"`
+}
+
+// RequestBody describes the body of a request
+type RequestBody struct {
+	Required bool                  `yaml:"required"`
+	Content  map[string]*MediaType `yaml:"content"`
+}
+
+// MediaType associates a content type with its schema
+type MediaType struct {
+	Schema *Schema `yaml:"schema"`
+}
+
+// Responses is the ordered list of responses of an operation
+type Responses []*Response
+
+// Response describes a single response of an operation
+type Response struct {
+	// Code is the HTTP status code of the response or "default"
+	Code        string                `yaml:"-"`
+	Description string                `yaml:"description"`
+	Content     map[string]*MediaType `yaml:"content"`
+}
+
+// Schemas is the ordered list of named schemas in the document
+type Schemas []*NamedSchema
+
+// NamedSchema is a schema defined in the components of the document
+type NamedSchema struct {
+	Name   string
+	Schema *Schema
+}
+
+// Properties is the ordered list of properties of an object schema
+type Properties []*Property
+
+// Property is a single property of an object schema
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+// Schema describes a data type
+type Schema struct {
+	Ref         string      `yaml:"$ref"`
+	Type        string      `yaml:"type"`
+	Format      string      `yaml:"format"`
+	Title       string      `yaml:"title"`
+	Description string      `yaml:"description"`
+	ClassName   string      `yaml:"x-class-name"`
+	ReadOnly    bool        `yaml:"readOnly"`
+	Nullable    bool        `yaml:"nullable"`
+	Required    []string    `yaml:"required"`
+	Properties  Properties  `yaml:"properties"`
+	Items       *Schema     `yaml:"items"`
+	AllOf       []*Schema   `yaml:"allOf"`
+	Enum        EnumValues  `yaml:"enum"`
+	Default     interface{} `yaml:"default"`
+	Pattern     string      `yaml:"pattern"`
+	MinLength   *int        `yaml:"minLength"`
+	MaxLength   *int        `yaml:"maxLength"`
+	Minimum     *float64    `yaml:"minimum"`
+	Maximum     *float64    `yaml:"maximum"`
+	Example     interface{} `yaml:"example"`
+}
+
+// EnumValues are the allowed values of a schema. Values are decoded as the text
+// they are written with, even when YAML would read them as booleans or numbers.
+type EnumValues []string
+
+// Load reads and parses the OpenAPI document at path
+func Load(path string) (*Document, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Parse parses an OpenAPI document and resolves its parameter references
+func Parse(data []byte) (*Document, error) {
+	doc := &Document{}
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	for name, param := range doc.Components.Parameters {
+		param.Component = name
+	}
+	for _, item := range doc.Paths {
+		for _, op := range item.Operations {
+			params := append(append([]*Parameter{}, item.Parameters...), op.Parameters...)
+			resolved := make([]*Parameter, 0, len(params))
+			for _, param := range params {
+				param, err := doc.resolveParameter(param)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", op.ID, err)
+				}
+				resolved = append(resolved, param)
+			}
+			op.Parameters = resolved
+		}
+	}
+	return doc, nil
+}
+
+func (doc *Document) resolveParameter(param *Parameter) (*Parameter, error) {
+	if param.Ref == "" {
+		return param, nil
+	}
+	const prefix = "#/components/parameters/"
+	if !strings.HasPrefix(param.Ref, prefix) {
+		return nil, fmt.Errorf("unsupported parameter reference %q", param.Ref)
+	}
+	resolved, ok := doc.Components.Parameters[strings.TrimPrefix(param.Ref, prefix)]
+	if !ok {
+		return nil, fmt.Errorf("unknown parameter reference %q", param.Ref)
+	}
+	return resolved, nil
+}
+
+// Operations returns every operation in the order they appear in the document
+func (doc *Document) Operations() []*Operation {
+	var ops []*Operation
+	for _, item := range doc.Paths {
+		ops = append(ops, item.Operations...)
+	}
+	return ops
+}
+
+// Schema returns the named schema, or nil if it is not defined
+func (doc *Document) Schema(name string) *Schema {
+	for _, named := range doc.Components.Schemas {
+		if named.Name == name {
+			return named.Schema
+		}
+	}
+	return nil
+}
+
+// Resolve follows a schema reference to the schema it refers to
+func (doc *Document) Resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = doc.Schema(RefName(schema.Ref))
+	}
+	return schema
+}
+
+// RefName returns the name of the schema a reference refers to, e.g.
+// `#/components/schemas/Account` refers to `Account`
+func RefName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// PathParameters returns the parameters interpolated into the path
+func (op *Operation) PathParameters() []*Parameter {
+	return op.parametersIn("path")
+}
+
+// QueryParameters returns the parameters sent in the query string
+func (op *Operation) QueryParameters() []*Parameter {
+	return op.parametersIn("query")
+}
+
+func (op *Operation) parametersIn(in string) []*Parameter {
+	var params []*Parameter
+	for _, param := range op.Parameters {
+		if param.In == in {
+			params = append(params, param)
+		}
+	}
+	return params
+}
+
+// RequestSchema returns the schema of the JSON request body, or nil if the
+// operation does not take a body
+func (op *Operation) RequestSchema() *Schema {
+	if op.RequestBody == nil {
+		return nil
+	}
+	if media, ok := op.RequestBody.Content["application/json"]; ok {
+		return media.Schema
+	}
+	return nil
+}
+
+// SuccessResponse returns the first 2xx response of the operation
+func (op *Operation) SuccessResponse() *Response {
+	for _, res := range op.Responses {
+		if strings.HasPrefix(res.Code, "2") {
+			return res
+		}
+	}
+	return nil
+}
+
+// Schema returns the schema of the response along with its content type. When
+// the response has several content types, JSON is preferred.
+func (res *Response) Schema() (*Schema, string) {
+	if media, ok := res.Content["application/json"]; ok {
+		return media.Schema, "application/json"
+	}
+	contentTypes := make([]string, 0, len(res.Content))
+	for contentType := range res.Content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+	for _, contentType := range contentTypes {
+		return res.Content[contentType].Schema, contentType
+	}
+	return nil, ""
+}
+
+// UnmarshalYAML decodes the paths in document order
+func (paths *Paths) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	keys, values, err := decodeMapping(unmarshal)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		pathItem := &PathItem{Path: key}
+		if err := values[key].decode(pathItem); err != nil {
+			return fmt.Errorf("%s: %v", pathItem.Path, err)
+		}
+		*paths = append(*paths, pathItem)
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes the operations of a path in document order
+func (item *PathItem) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	keys, values, err := decodeMapping(unmarshal)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		switch key {
+		case "parameters":
+			if err := values[key].decode(&item.Parameters); err != nil {
+				return err
+			}
+		case "get", "put", "post", "delete", "options", "head", "patch", "trace":
+			op := &Operation{Method: strings.ToUpper(key), Path: item.Path}
+			if err := values[key].decode(op); err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+			item.Operations = append(item.Operations, op)
+		}
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes the responses in document order
+func (responses *Responses) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	keys, values, err := decodeMapping(unmarshal)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		res := &Response{Code: key}
+		if err := values[key].decode(res); err != nil {
+			return err
+		}
+		*responses = append(*responses, res)
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes the schemas in document order
+func (schemas *Schemas) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	keys, values, err := decodeMapping(unmarshal)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		named := &NamedSchema{Name: key, Schema: &Schema{}}
+		if err := values[key].decode(named.Schema); err != nil {
+			return fmt.Errorf("%s: %v", named.Name, err)
+		}
+		*schemas = append(*schemas, named)
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes the properties in document order
+func (properties *Properties) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	keys, values, err := decodeMapping(unmarshal)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		property := &Property{Name: key, Schema: &Schema{}}
+		if err := values[key].decode(property.Schema); err != nil {
+			return fmt.Errorf("%s: %v", property.Name, err)
+		}
+		*properties = append(*properties, property)
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes enum values as the text they are written with, so
+// that plain scalars such as N, Y or 1.50 are kept as they are instead of
+// becoming booleans or numbers. null values are left out.
+func (values *EnumValues) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var items []*string
+	if err := unmarshal(&items); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item != nil {
+			*values = append(*values, *item)
+		}
+	}
+	return nil
+}
+
+// rawNode is a YAML value whose decoding is deferred until its type is known.
+// Decoding it straight into that type keeps the text of its scalars, which
+// decoding it as an interface{} would turn into booleans or numbers.
+type rawNode struct {
+	unmarshal func(interface{}) error
+}
+
+// UnmarshalYAML keeps the function decoding the value
+func (node *rawNode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	node.unmarshal = unmarshal
+	return nil
+}
+
+// decode decodes the value into v. A null value leaves v unchanged.
+func (node *rawNode) decode(v interface{}) error {
+	if node == nil {
+		return nil
+	}
+	return node.unmarshal(v)
+}
+
+// decodeMapping decodes a YAML mapping into its keys, in document order, and
+// its values by key
+func decodeMapping(unmarshal func(interface{}) error) ([]string, map[string]*rawNode, error) {
+	var items yaml.MapSlice
+	if err := unmarshal(&items); err != nil {
+		return nil, nil, err
+	}
+	values := map[string]*rawNode{}
+	if err := unmarshal(&values); err != nil {
+		return nil, nil, err
+	}
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = fmt.Sprint(item.Key)
+		if _, ok := values[keys[i]]; !ok {
+			return nil, nil, fmt.Errorf("key %q must be written as a string", keys[i])
+		}
+	}
+	return keys, values, nil
+}
@@ -0,0 +1,125 @@
+package openapi
+
+import (
+	"strings"
+	"testing"
+)
+
+const document = `
+openapi: 3.0.0
+info:
+  title: Test API
+  version: v2019-10-10
+paths:
+  "/sites/{site_id}/widgets/{widget_id}":
+    parameters:
+    - "$ref": "#/components/parameters/site_id"
+    put:
+      operationId: update_widget
+      parameters:
+      - "$ref": "#/components/parameters/widget_id"
+      - name: force
+        in: query
+        schema:
+          type: string
+          enum:
+          - true
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              "$ref": "#/components/schemas/WidgetUpdate"
+      responses:
+        '200':
+          description: A widget.
+          content:
+            application/json:
+              schema:
+                "$ref": "#/components/schemas/Widget"
+        default:
+          description: Unexpected error.
+    get:
+      operationId: get_widget
+      responses:
+        '200':
+          description: A widget.
+components:
+  parameters:
+    site_id:
+      name: site_id
+      in: path
+      required: true
+    widget_id:
+      name: widget_id
+      in: path
+      required: true
+  schemas:
+    Widget:
+      type: object
+      properties:
+        zebra:
+          type: string
+        alpha:
+          type: integer
+        check:
+          type: string
+          enum:
+          - N
+          - Y
+          - 1.50
+          -
+    WidgetUpdate:
+      type: object
+      properties:
+        zebra:
+          type: string
+`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse([]byte(document))
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	ops := doc.Operations()
+	if len(ops) != 2 || ops[0].ID != "update_widget" || ops[1].ID != "get_widget" {
+		t.Fatalf("Operations are not in document order: %+v", ops)
+	}
+
+	op := ops[0]
+	if op.Method != "PUT" || op.Path != "/sites/{site_id}/widgets/{widget_id}" {
+		t.Errorf("Unexpected method or path %s %s", op.Method, op.Path)
+	}
+	var pathParams []string
+	for _, param := range op.PathParameters() {
+		pathParams = append(pathParams, param.Name)
+	}
+	if strings.Join(pathParams, ",") != "site_id,widget_id" {
+		t.Errorf("Path parameters are not resolved: %v", pathParams)
+	}
+	if op.PathParameters()[1].Component != "widget_id" || op.QueryParameters()[0].Component != "" {
+		t.Errorf("Unexpected parameter components %+v", op.Parameters)
+	}
+	query := op.QueryParameters()
+	if len(query) != 1 || query[0].Schema.Enum[0] != "true" {
+		t.Errorf("Unexpected query parameters %+v", query)
+	}
+	if RefName(op.RequestSchema().Ref) != "WidgetUpdate" {
+		t.Errorf("Unexpected request schema %+v", op.RequestSchema())
+	}
+
+	res := op.SuccessResponse()
+	schema, contentType := res.Schema()
+	if res.Code != "200" || contentType != "application/json" || RefName(schema.Ref) != "Widget" {
+		t.Errorf("Unexpected success response %+v", res)
+	}
+
+	widget := doc.Resolve(schema)
+	if widget == nil || widget.Properties[0].Name != "zebra" || widget.Properties[1].Name != "alpha" {
+		t.Errorf("Properties are not in document order: %+v", widget)
+	}
+	if enum := strings.Join(widget.Properties[2].Schema.Enum, ","); enum != "N,Y,1.50" {
+		t.Errorf("Expected the enum values as written, got %s", enum)
+	}
+}
@@ -0,0 +1,263 @@
+package recurly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// absentValue is implemented by the field types which know whether they were
+// absent from the JSON they were decoded from
+type absentValue interface {
+	absent() bool
+}
+
+// marshalResource encodes the exported fields of a resource like
+// encoding/json does, except that the timestamps absent from the JSON the
+// resource was decoded from are left out, and so are nested
+// resources with omitempty when they are empty. The fields of raw, the JSON
+// the resource was decoded from, are encoded as they were received unless
+// they changed since, so that null, false and empty values sent by the API
+// are kept. Its unknown fields are encoded after the known ones.
+func marshalResource(v interface{}, raw json.RawMessage) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	structType := value.Type()
+	received := rawFields(raw)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, omitEmpty := parseJSONTag(field)
+		if name == "-" {
+			continue
+		}
+		fieldValue := value.Field(i)
+		data, ok, err := marshalReceived(fieldValue, received[name])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			if omitEmpty && isEmptyValue(fieldValue) {
+				continue
+			}
+			if v, ok := fieldValue.Interface().(absentValue); ok && v.absent() {
+				continue
+			}
+			data, err = json.Marshal(fieldValue.Interface())
+			if err != nil {
+				return nil, err
+			}
+			if omitEmpty && string(data) == "{}" {
+				continue
+			}
+		}
+		key, _ := json.Marshal(name)
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+
+	unknown := unknownFields(v, raw)
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		key, _ := json.Marshal(name)
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(unknown[name])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshalReceived returns the JSON of a field of a resource which was in the
+// JSON the resource was decoded from. The received value is kept if the field
+// still holds what it decodes to, otherwise the field is encoded. ok is false
+// if the field wasn't received.
+func marshalReceived(field reflect.Value, received json.RawMessage) (data []byte, ok bool, err error) {
+	if received == nil {
+		return nil, false, nil
+	}
+	decoded := reflect.New(field.Type())
+	if json.Unmarshal(received, decoded.Interface()) == nil && reflect.DeepEqual(decoded.Elem().Interface(), field.Interface()) {
+		var buf bytes.Buffer
+		if json.Compact(&buf, received) == nil {
+			return buf.Bytes(), true, nil
+		}
+	}
+	data, err = json.Marshal(field.Interface())
+	return data, true, err
+}
+
+// rawFields returns the fields of raw, the JSON a resource was decoded from,
+// or nil if raw isn't a JSON object
+func rawFields(raw json.RawMessage) map[string]json.RawMessage {
+	if len(raw) == 0 {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+// rawField returns the JSON of the field of raw named name. Unlike rawFields,
+// it doesn't decode the other fields into a map.
+func rawField(raw json.RawMessage, name string) (json.RawMessage, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, false
+	}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, false
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, false
+		}
+		if key == name {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// unknownFields returns the fields of raw, the JSON a resource was decoded
+// from, which the resource has no field for. It returns nil if there are
+// none, or if raw isn't a JSON object.
+func unknownFields(v interface{}, raw json.RawMessage) map[string]json.RawMessage {
+	fields := rawFields(raw)
+	if fields == nil {
+		return nil
+	}
+	structType := reflect.Indirect(reflect.ValueOf(v)).Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath == "" {
+			name, _ := parseJSONTag(field)
+			delete(fields, name)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// exactRequest is implemented by the requests with amounts of money, which
+// hold the exact amounts set with their Set...Money methods
+type exactRequest interface {
+	exactMoney(name string, value float64) (Money, bool)
+}
+
+// marshalRequest encodes the exported fields of a request like encoding/json
+// does, except that the fields for which IsNull is true are encoded as null,
+// and the amounts set with a Set...Money method with every digit of the Money.
+// It fails if the value of one of the pointers returned by the Null functions
+// was changed.
+func marshalRequest(v interface{}) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	structType := value.Type()
+	exact, _ := v.(exactRequest)
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, omitEmpty := parseJSONTag(field)
+		if name == "-" {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if nullChanged(fieldValue) {
+			return nil, fmt.Errorf("recurly: the value of the Null pointer of %s was changed", name)
+		}
+		data := []byte("null")
+		if !isNullValue(fieldValue) {
+			if omitEmpty && isEmptyValue(fieldValue) {
+				continue
+			}
+			var err error
+			if data, err = marshalField(exact, name, fieldValue); err != nil {
+				return nil, err
+			}
+		}
+		key, _ := json.Marshal(name)
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshalField encodes a field of a request which isn't null. The amounts set
+// with a Set...Money method are encoded with every digit of their Money.
+func marshalField(exact exactRequest, name string, value reflect.Value) ([]byte, error) {
+	if amount, ok := value.Interface().(*float64); ok && amount != nil && exact != nil {
+		if m, ok := exact.exactMoney(name, *amount); ok {
+			return []byte(m.String()), nil
+		}
+	}
+	return json.Marshal(value.Interface())
+}
+
+// parseJSONTag returns the JSON name of a field and whether it has the
+// omitempty option
+func parseJSONTag(field reflect.StructField) (string, bool) {
+	parts := strings.Split(field.Tag.Get("json"), ",")
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			return name, true
+		}
+	}
+	return name, false
+}
+
+// isEmptyValue reports whether the value is omitted by omitempty
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package recurly
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUnknownFieldsAreKept(test *testing.T) {
+	t := &T{test}
+	body := `{"id":"abcd1234","code":"acct","loyalty_tier":"gold","billing_info":{"id":"b1","wallet":{"kind":"apple_pay"}}}`
+	account := &Account{}
+	if err := json.Unmarshal([]byte(body), account); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	t.Assert(string(account.RawJSON()), body, "Account.RawJSON()")
+	t.Assert(len(account.UnknownFields()), 1, "len(Account.UnknownFields())")
+	t.Assert(string(account.UnknownFields()["loyalty_tier"]), `"gold"`, "Account.UnknownFields()")
+	t.Assert(account.BillingInfo.Id, "b1", "Account.BillingInfo.Id")
+	t.Assert(len(account.BillingInfo.UnknownFields()), 1, "len(BillingInfo.UnknownFields())")
+	t.Assert(string(account.BillingInfo.UnknownFields()["wallet"]), `{"kind":"apple_pay"}`, "BillingInfo.UnknownFields()")
+
+	data, err := json.Marshal(account)
+	t.Assert(err, nil, "json.Marshal")
+	t.Assert(string(data), `{"id":"abcd1234","code":"acct","billing_info":{"id":"b1","wallet":{"kind":"apple_pay"}},"loyalty_tier":"gold"}`, "json.Marshal")
+}
+
+func TestResourcesWithoutUnknownFields(test *testing.T) {
+	t := &T{test}
+	account := &Account{Code: "acct"}
+	t.Assert(account.RawJSON() == nil, true, "Account.RawJSON() == nil")
+	t.Assert(account.UnknownFields() == nil, true, "Account.UnknownFields() == nil")
+
+	if err := json.Unmarshal([]byte(`{"code":"acct"}`), account); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(account.UnknownFields() == nil, true, "Account.UnknownFields() == nil")
+}
+
+func TestUnknownFieldsOfListItems(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T:             t,
+		AssertRequest: func(req *http.Request) {},
+		MakeResponse: func(req *http.Request) *http.Response {
+			body := `{"object":"list","has_more":false,"data":[{"id":"a1","loyalty_tier":"gold"}]}`
+			return mockResponse(req, 200, String(body))
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(nil)
+	if !accounts.Next() {
+		t.Fatalf("Expected an account, got %v", accounts.Err())
+	}
+	t.Assert(string(accounts.Item().UnknownFields()["loyalty_tier"]), `"gold"`, "Account.UnknownFields()")
+}
+
+// resourceExample holds JSON documents of a resource, generated in
+// examples_test.go
+type resourceExample struct {
+	name      string
+	new       func() interface{}
+	documents []string
+}
+
+// decodeJSON decodes a JSON document into plain values, keeping numbers as
+// they are written
+func decodeJSON(t *T, data []byte) interface{} {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatalf("Invalid JSON %s: %v", data, err)
+	}
+	return value
+}
+
+func TestResourceExamplesRoundTrip(test *testing.T) {
+	t := &T{test}
+	for _, example := range resourceExamples {
+		for _, document := range example.documents {
+			resource := example.new()
+			if err := json.Unmarshal([]byte(document), resource); err != nil {
+				t.Fatalf("%s: Error decoding %s: %v", example.name, document, err)
+			}
+			data, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("%s: Error encoding: %v", example.name, err)
+			}
+			if !reflect.DeepEqual(decodeJSON(t, data), decodeJSON(t, []byte(document))) {
+				t.Errorf("%s: Expected %s, got %s", example.name, document, data)
+			}
+		}
+	}
+}
+
+func TestChangedFieldsAreEncoded(test *testing.T) {
+	t := &T{test}
+	plan := &Plan{}
+	body := `{"code":"gold","name":null,"trial_length":0,"auto_renew":false,"created_at":null,"updated_at":"2020-01-01T00:00:00Z"}`
+	if err := json.Unmarshal([]byte(body), plan); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	plan.Name = "Gold"
+	plan.AutoRenew = true
+	plan.TotalBillingCycles = 12
+
+	data, err := json.Marshal(plan)
+	t.Assert(err, nil, "json.Marshal")
+	t.Assert(string(data), `{"code":"gold","name":"Gold","trial_length":0,"total_billing_cycles":12,"auto_renew":true,"created_at":null,"updated_at":"2020-01-01T00:00:00Z"}`, "json.Marshal")
+}
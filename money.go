@@ -0,0 +1,353 @@
+package recurly
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidMoney is returned when parsing a string which isn't a decimal number
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+// maxMoneyExponent bounds the exponent of the numbers ParseMoney accepts, so
+// an amount such as "1e999999999" can't allocate a huge number of digits
+const maxMoneyExponent = 100
+
+// Money is an exact decimal amount of money. It keeps every digit of the
+// number it was parsed from, including trailing zeros, so it marshals back
+// to the same JSON. The zero value is 0.
+//
+// Money is a value type: operations return a new Money and never modify
+// their operands.
+type Money struct {
+	// units is the value without its decimal point, nil for 0
+	units *big.Int
+	// scale is the number of digits after the decimal point
+	scale int
+}
+
+// currencyMinorUnits lists the ISO 4217 currencies whose minor unit is not a
+// hundredth of the major unit
+var currencyMinorUnits = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0,
+	"XOF": 0, "XPF": 0,
+}
+
+// CurrencyMinorUnits returns the number of digits after the decimal point of
+// amounts in the currency, e.g. 2 for "USD" and 0 for "JPY". Unknown
+// currencies have 2.
+func CurrencyMinorUnits(currency string) int {
+	if units, ok := currencyMinorUnits[strings.ToUpper(currency)]; ok {
+		return units
+	}
+	return 2
+}
+
+// ParseMoney parses a decimal number such as "10", "-0.50" or "1.5e3". The
+// exponent must be between -100 and 100.
+func ParseMoney(value string) (Money, error) {
+	s := value
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e < -maxMoneyExponent || e > maxMoneyExponent {
+			return Money{}, ErrInvalidMoney
+		}
+		exponent = e
+		s = s[:i]
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, ErrInvalidMoney
+	}
+
+	units, ok := new(big.Int).SetString(sign+whole+fraction, 10)
+	if !ok {
+		return Money{}, ErrInvalidMoney
+	}
+	m := Money{units: units, scale: len(fraction) - exponent}
+	if m.scale < 0 {
+		m.units.Mul(m.units, pow10(-m.scale))
+		m.scale = 0
+	}
+	return m, nil
+}
+
+// MustParseMoney is like ParseMoney but panics if the value is not a decimal
+// number. It simplifies writing amounts in code.
+func MustParseMoney(value string) Money {
+	m, err := ParseMoney(value)
+	if err != nil {
+		panic("recurly: invalid money amount " + strconv.Quote(value))
+	}
+	return m
+}
+
+// MoneyFromMinorUnits returns the amount of minor units of the currency, e.g.
+// 1050 USD cents is 10.50
+func MoneyFromMinorUnits(units int64, currency string) Money {
+	return Money{units: big.NewInt(units), scale: CurrencyMinorUnits(currency)}
+}
+
+// MoneyFromFloat returns the shortest decimal which converts back to the
+// float. Amounts decoded from JSON into a float64 are recovered exactly as
+// long as they have fewer than 16 significant digits.
+func MoneyFromFloat(value float64) Money {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Money{}
+	}
+	m, _ := ParseMoney(strconv.FormatFloat(value, 'f', -1, 64))
+	return m
+}
+
+// NewAmount returns a pointer to the amount of the decimal string, for the
+// money fields of requests. It panics if value is not a decimal number.
+// Amounts with fewer than 16 significant digits are sent as the exact same
+// number. The Set...Money methods of requests send every digit of any amount.
+func NewAmount(value string) *float64 {
+	amount := MustParseMoney(value).Float64()
+	return &amount
+}
+
+// receivedMoney returns the amount of money named name in raw, the JSON a
+// resource was decoded from, as long as the field of the resource still holds
+// it, and the value of the field otherwise
+func receivedMoney(raw json.RawMessage, name string, value float64) Money {
+	var received Money
+	if data, ok := rawField(raw, name); ok && json.Unmarshal(data, &received) == nil &&
+		!received.absent() && received.Float64() == value {
+		return received
+	}
+	return MoneyFromFloat(value)
+}
+
+// exactAmounts holds the exact amounts of money set on a request with its
+// Set...Money methods, by JSON name
+type exactAmounts struct {
+	amounts map[string]Money
+}
+
+// setMoney records the exact amount of the field, and returns the float64 the
+// field is set to
+func (e *exactAmounts) setMoney(name string, m Money) *float64 {
+	if e.amounts == nil {
+		e.amounts = map[string]Money{}
+	}
+	e.amounts[name] = m
+	value := m.Float64()
+	return &value
+}
+
+// exactMoney returns the exact amount of the field, as long as value, the
+// value of the field, is still the one it was set to
+func (e exactAmounts) exactMoney(name string, value float64) (Money, bool) {
+	m, ok := e.amounts[name]
+	return m, ok && m.Float64() == value
+}
+
+// clone returns a copy of the amounts. Money values are immutable, so they
+// are shared.
+func (e exactAmounts) clone() exactAmounts {
+	if e.amounts == nil {
+		return e
+	}
+	amounts := make(map[string]Money, len(e.amounts))
+	for name, m := range e.amounts {
+		amounts[name] = m
+	}
+	return exactAmounts{amounts: amounts}
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// absent reports whether m is the zero value. A 0 decoded from JSON is not
+// absent.
+func (m Money) absent() bool {
+	return m.units == nil
+}
+
+// value returns the units, which are never nil
+func (m Money) value() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+	return m.units
+}
+
+// rescale returns the units of m with scale digits after the decimal point.
+// scale must not be lower than the scale of m.
+func (m Money) rescale(scale int) *big.Int {
+	units := new(big.Int).Set(m.value())
+	if scale > m.scale {
+		units.Mul(units, pow10(scale-m.scale))
+	}
+	return units
+}
+
+// align returns the units of both amounts at the same scale
+func align(a Money, b Money) (*big.Int, *big.Int, int) {
+	scale := a.scale
+	if b.scale > scale {
+		scale = b.scale
+	}
+	return a.rescale(scale), b.rescale(scale), scale
+}
+
+// Add returns m + other
+func (m Money) Add(other Money) Money {
+	a, b, scale := align(m, other)
+	return Money{units: a.Add(a, b), scale: scale}
+}
+
+// Sub returns m - other
+func (m Money) Sub(other Money) Money {
+	a, b, scale := align(m, other)
+	return Money{units: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns m * other, with as many digits after the decimal point as both
+// amounts together
+func (m Money) Mul(other Money) Money {
+	return Money{units: new(big.Int).Mul(m.value(), other.value()), scale: m.scale + other.scale}
+}
+
+// MulInt returns m * n, e.g. a unit amount times a quantity
+func (m Money) MulInt(n int64) Money {
+	return Money{units: new(big.Int).Mul(m.value(), big.NewInt(n)), scale: m.scale}
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{units: new(big.Int).Neg(m.value()), scale: m.scale}
+}
+
+// Cmp compares m and other numerically and returns -1, 0 or +1
+func (m Money) Cmp(other Money) int {
+	a, b, _ := align(m, other)
+	return a.Cmp(b)
+}
+
+// Equal reports whether m and other are the same amount, regardless of
+// trailing zeros: 1.5 equals 1.50
+func (m Money) Equal(other Money) bool {
+	return m.Cmp(other) == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of m
+func (m Money) Sign() int {
+	return m.value().Sign()
+}
+
+// IsZero reports whether m is 0
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Scale returns the number of digits after the decimal point
+func (m Money) Scale() int {
+	return m.scale
+}
+
+// Round returns m rounded to the number of digits after the decimal point,
+// with halves rounded away from zero
+func (m Money) Round(places int) Money {
+	if places < 0 {
+		places = 0
+	}
+	if places >= m.scale {
+		return Money{units: m.rescale(places), scale: places}
+	}
+	divisor := pow10(m.scale - places)
+	quotient, remainder := new(big.Int).QuoRem(m.value(), divisor, new(big.Int))
+	remainder.Abs(remainder).Mul(remainder, big.NewInt(2))
+	if remainder.Cmp(divisor) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(m.Sign())))
+	}
+	return Money{units: quotient, scale: places}
+}
+
+// RoundToCurrency returns m rounded to the minor units of the currency
+func (m Money) RoundToCurrency(currency string) Money {
+	return m.Round(CurrencyMinorUnits(currency))
+}
+
+// MinorUnits returns m as a whole number of minor units of the currency, e.g.
+// 10.50 USD is 1050 cents. It returns false if m has more digits than the
+// currency allows or does not fit in an int64.
+func (m Money) MinorUnits(currency string) (int64, bool) {
+	places := CurrencyMinorUnits(currency)
+	rounded := m.Round(places)
+	if !rounded.Equal(m) || !rounded.value().IsInt64() {
+		return 0, false
+	}
+	return rounded.value().Int64(), true
+}
+
+// Float64 returns the nearest float64 to m
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.String(), 64)
+	return f
+}
+
+// String returns m as a decimal number, with every digit it was parsed from
+func (m Money) String() string {
+	units := m.value()
+	digits := new(big.Int).Abs(units).String()
+	if m.scale > 0 {
+		if len(digits) <= m.scale {
+			digits = strings.Repeat("0", m.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-m.scale] + "." + digits[len(digits)-m.scale:]
+	}
+	if units.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON encodes m as a JSON number
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number, or a string containing one. null
+// leaves m unchanged.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
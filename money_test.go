@@ -0,0 +1,163 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(test *testing.T) {
+	t := &T{test}
+	cases := map[string]string{
+		"10":       "10",
+		"10.50":    "10.50",
+		"-0.05":    "-0.05",
+		".5":       "0.5",
+		"+3.":      "3",
+		"1.5e3":    "1500",
+		"125e-2":   "1.25",
+		"0.000001": "0.000001",
+	}
+	for input, expected := range cases {
+		m, err := ParseMoney(input)
+		if err != nil {
+			t.Errorf("ParseMoney(%q) failed: %v", input, err)
+			continue
+		}
+		t.Assert(m.String(), expected, "ParseMoney("+input+")")
+	}
+	for _, input := range []string{"", "-", ".", "1.2.3", "1,5", "abc", "1e", "NaN"} {
+		if _, err := ParseMoney(input); err != ErrInvalidMoney {
+			t.Errorf("Expected ParseMoney(%q) to fail, got %v", input, err)
+		}
+	}
+	t.Assert(Money{}.String(), "0", "zero Money")
+}
+
+func TestMoneyArithmetic(test *testing.T) {
+	t := &T{test}
+	a := MustParseMoney("0.1")
+	b := MustParseMoney("0.20")
+
+	t.Assert(a.Add(b).String(), "0.30", "Add")
+	t.Assert(a.Sub(b).String(), "-0.10", "Sub")
+	t.Assert(a.Mul(b).String(), "0.020", "Mul")
+	t.Assert(b.MulInt(3).String(), "0.60", "MulInt")
+	t.Assert(b.Neg().String(), "-0.20", "Neg")
+	t.Assert(a.Cmp(b), -1, "Cmp")
+	t.Assert(b.Cmp(a), 1, "Cmp")
+	t.Assert(MustParseMoney("1.5").Equal(MustParseMoney("1.50")), true, "Equal")
+	t.Assert(Money{}.IsZero(), true, "IsZero")
+	t.Assert(b.Neg().Sign(), -1, "Sign")
+
+	// the operands are not modified
+	t.Assert(a.String(), "0.1", "a")
+	t.Assert(b.String(), "0.20", "b")
+
+	// float64 drifts where Money does not
+	total := Money{}
+	for i := 0; i < 10; i++ {
+		total = total.Add(a)
+	}
+	t.Assert(total.Equal(MustParseMoney("1")), true, "Sum of ten 0.1")
+}
+
+func TestMoneyRound(test *testing.T) {
+	t := &T{test}
+	t.Assert(MustParseMoney("2.345").Round(2).String(), "2.35", "Round half up")
+	t.Assert(MustParseMoney("-2.345").Round(2).String(), "-2.35", "Round half away from zero")
+	t.Assert(MustParseMoney("2.344").Round(2).String(), "2.34", "Round down")
+	t.Assert(MustParseMoney("2").Round(2).String(), "2.00", "Round pads")
+	t.Assert(MustParseMoney("0.5").Round(0).String(), "1", "Round to units")
+}
+
+func TestMoneyCurrencies(test *testing.T) {
+	t := &T{test}
+	t.Assert(CurrencyMinorUnits("USD"), 2, "USD")
+	t.Assert(CurrencyMinorUnits("jpy"), 0, "JPY")
+	t.Assert(CurrencyMinorUnits("KWD"), 3, "KWD")
+
+	t.Assert(MoneyFromMinorUnits(1050, "USD").String(), "10.50", "USD cents")
+	t.Assert(MoneyFromMinorUnits(1050, "JPY").String(), "1050", "JPY")
+	t.Assert(MustParseMoney("1.2345").RoundToCurrency("KWD").String(), "1.235", "RoundToCurrency")
+
+	units, ok := MustParseMoney("10.5").MinorUnits("USD")
+	t.Assert(units, int64(1050), "MinorUnits")
+	t.Assert(ok, true, "MinorUnits ok")
+	_, ok = MustParseMoney("10.5").MinorUnits("JPY")
+	t.Assert(ok, false, "MinorUnits of a fraction of a yen")
+}
+
+func TestMoneyJSON(test *testing.T) {
+	t := &T{test}
+	var v struct {
+		Amount  Money  `json:"amount"`
+		Quoted  Money  `json:"quoted"`
+		Missing *Money `json:"missing"`
+	}
+	err := json.Unmarshal([]byte(`{"amount":12345678901234567.89,"quoted":"0.10","missing":null}`), &v)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(v.Amount.String(), "12345678901234567.89", "Amount")
+	t.Assert(v.Missing == nil, true, "Missing")
+
+	data, _ := json.Marshal(v)
+	t.Assert(string(data), `{"amount":12345678901234567.89,"quoted":0.10,"missing":null}`, "json.Marshal")
+
+	if err := json.Unmarshal([]byte(`{"amount":true}`), &v); err == nil {
+		t.Errorf("Expected a boolean to be rejected")
+	}
+}
+
+func TestMoneyFromFloat(test *testing.T) {
+	t := &T{test}
+	t.Assert(MoneyFromFloat(10.1).String(), "10.1", "MoneyFromFloat")
+	t.Assert(MoneyFromFloat(*NewAmount("19.99")).String(), "19.99", "MoneyFromFloat(NewAmount())")
+}
+
+func TestParseMoneyBoundsExponent(test *testing.T) {
+	t := &T{test}
+	t.Assert(MustParseMoney("1e100").Scale(), 0, "Scale of 1e100")
+	for _, value := range []string{"1e999999999", "1e-999999999", "1e101"} {
+		if _, err := ParseMoney(value); err != ErrInvalidMoney {
+			t.Errorf("Expected %q to be rejected, got %v", value, err)
+		}
+	}
+}
+
+func TestResourceMoney(test *testing.T) {
+	t := &T{test}
+	invoice := &Invoice{}
+	err := json.Unmarshal([]byte(`{"total":12345678901234567.89,"subtotal":0.10,"tax":null}`), invoice)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(invoice.TotalMoney().String(), "12345678901234567.89", "TotalMoney()")
+	t.Assert(invoice.SubtotalMoney().String(), "0.10", "SubtotalMoney()")
+	t.Assert(invoice.TaxMoney().String(), "0", "TaxMoney()")
+	t.Assert(invoice.BalanceMoney().String(), "0", "BalanceMoney()")
+
+	// a changed field no longer has the digits received
+	invoice.Total = 5.25
+	t.Assert(invoice.TotalMoney().String(), "5.25", "TotalMoney() after a change")
+	t.Assert((&Invoice{Total: 1.5}).TotalMoney().String(), "1.5", "TotalMoney() of a new resource")
+}
+
+func TestRequestMoney(test *testing.T) {
+	t := &T{test}
+	lineItem := LineItemCreate{Currency: String("USD")}
+	lineItem.SetUnitAmountMoney(MustParseMoney("12345678901234567.89"))
+	purchase := &PurchaseCreate{LineItems: []LineItemCreate{lineItem}}
+	data, err := json.Marshal(purchase)
+	t.Assert(err, nil, "json.Marshal")
+	t.Assert(string(data), `{"line_items":[{"currency":"USD","unit_amount":12345678901234567.89}]}`, "json.Marshal")
+
+	clone := purchase.Clone()
+	data, _ = json.Marshal(clone)
+	t.Assert(string(data), `{"line_items":[{"currency":"USD","unit_amount":12345678901234567.89}]}`, "json.Marshal of the clone")
+
+	// a changed field no longer sends the exact amount
+	clone.LineItems[0].UnitAmount = NewAmount("5.25")
+	data, _ = json.Marshal(clone.LineItems[0])
+	t.Assert(string(data), `{"currency":"USD","unit_amount":5.25}`, "json.Marshal after a change")
+}
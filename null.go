@@ -0,0 +1,88 @@
+package recurly
+
+import (
+	"reflect"
+	"time"
+)
+
+// The pointers sent as null. Request fields are pointers: nil leaves the field
+// out of the request, and these pointers send it as null, which clears it.
+// They are shared, so a request whose Null pointer was changed through is
+// rejected when it is encoded, and a copy of the value they point to is a
+// value, not null.
+var (
+	nullString = new(string)
+	nullInt    = new(int)
+	nullFloat  = new(float64)
+	nullBool   = new(bool)
+	nullTime   = new(time.Time)
+)
+
+// nullPointers are the addresses of the pointers sent as null
+var nullPointers = map[uintptr]bool{
+	reflect.ValueOf(nullString).Pointer(): true,
+	reflect.ValueOf(nullInt).Pointer():    true,
+	reflect.ValueOf(nullFloat).Pointer():  true,
+	reflect.ValueOf(nullBool).Pointer():   true,
+	reflect.ValueOf(nullTime).Pointer():   true,
+}
+
+// NullString returns a pointer which sends a string field of a request as
+// null. The value it points to must not be changed.
+func NullString() *string {
+	return nullString
+}
+
+// NullInt returns a pointer which sends an integer field of a request as
+// null. The value it points to must not be changed.
+func NullInt() *int {
+	return nullInt
+}
+
+// NullFloat returns a pointer which sends a number field of a request as
+// null. The value it points to must not be changed.
+func NullFloat() *float64 {
+	return nullFloat
+}
+
+// NullBool returns a pointer which sends a boolean field of a request as
+// null. The value it points to must not be changed.
+func NullBool() *bool {
+	return nullBool
+}
+
+// NullTimestamp returns a pointer which sends a timestamp field of a request
+// as null. The value it points to must not be changed.
+func NullTimestamp() *time.Time {
+	return nullTime
+}
+
+// IsNull reports whether a field of a request is sent as null: whether it is
+// one of the pointers returned by the Null functions, or a pointer to the empty
+// value of an enum, e.g. new(PreferredLocale), which is never a valid value.
+// Nested request fields can't be null, so it is always false for them.
+func IsNull(pointer interface{}) bool {
+	return isNullValue(reflect.ValueOf(pointer))
+}
+
+func isNullValue(v reflect.Value) bool {
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return false
+	}
+	return nullPointers[v.Pointer()] || isEnumType(v.Elem().Type()) && v.Elem().String() == ""
+}
+
+// isEnumType reports whether a type is an enum: the named string types of
+// the fields of requests are all enums
+func isEnumType(t reflect.Type) bool {
+	return t.Kind() == reflect.String && t != reflect.TypeOf("")
+}
+
+// nullChanged reports whether v is one of the pointers returned by the Null
+// functions whose value was changed
+func nullChanged(v reflect.Value) bool {
+	if v.Kind() != reflect.Ptr || v.IsNil() || !nullPointers[v.Pointer()] {
+		return false
+	}
+	return v.Elem().Interface() != reflect.Zero(v.Elem().Type()).Interface()
+}
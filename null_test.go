@@ -0,0 +1,75 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRequestFieldStates(test *testing.T) {
+	t := &T{test}
+	update := &AccountUpdate{
+		FirstName: String("Ann"),
+		VatNumber: NullString(),
+		TaxExempt: NullBool(),
+		CustomFields: CustomFieldsCreate{
+			{Name: String("tier"), Value: NullString()},
+		},
+	}
+	data, err := json.Marshal(update)
+	t.Assert(err, nil, "json.Marshal")
+	t.Assert(string(data), `{"first_name":"Ann","vat_number":null,"tax_exempt":null,"custom_fields":[{"name":"tier","value":null}]}`, "json.Marshal")
+}
+
+func TestNullPointersOfEveryType(test *testing.T) {
+	t := &T{test}
+	sub := &SubscriptionUpdate{
+		PoNumber:     NullString(),
+		NetTerms:     NullInt(),
+		NextBillDate: NullTimestamp(),
+	}
+	data, _ := json.Marshal(sub)
+	t.Assert(string(data), `{"next_bill_date":null,"po_number":null,"net_terms":null}`, "json.Marshal")
+
+	addOn := &SubscriptionAddOnUpdate{UnitAmount: NullFloat()}
+	data, _ = json.Marshal(addOn)
+	t.Assert(string(data), `{"unit_amount":null}`, "json.Marshal")
+
+	t.Assert(IsNull(NullFloat()), true, "IsNull(NullFloat())")
+	t.Assert(IsNull(String("")), false, "IsNull(String(\"\"))")
+	t.Assert(IsNull((*string)(nil)), false, "IsNull(nil)")
+}
+
+func TestNullFieldsAreValidated(test *testing.T) {
+	t := &T{test}
+	// null is not a value for the pattern of the code, but it is blank
+	plan := &PlanUpdate{Code: NullString()}
+	t.Assert(plan.Validate(), nil, "PlanUpdate.Validate()")
+
+	create := &PlanCreate{Code: NullString(), Name: String("Gold"), Currencies: []PlanPricingCreate{{Currency: String("USD")}}}
+	assertInvalid(t, create.Validate(), ErrorParam{Property: "code", Message: "can't be blank"})
+}
+
+func TestNullEnums(test *testing.T) {
+	t := &T{test}
+	billTo := BillToParent
+	update := &AccountUpdate{PreferredLocale: new(PreferredLocale), BillTo: &billTo}
+	t.Assert(IsNull(update.PreferredLocale), true, "IsNull(new(PreferredLocale))")
+	t.Assert(IsNull(update.BillTo), false, "IsNull(BillTo)")
+	t.Assert(update.Validate(), nil, "AccountUpdate.Validate()")
+	data, err := json.Marshal(update)
+	t.Assert(err, nil, "json.Marshal")
+	t.Assert(string(data), `{"preferred_locale":null,"bill_to":"parent"}`, "json.Marshal")
+
+	clone := update.Clone()
+	t.Assert(IsNull(clone.PreferredLocale), true, "IsNull(Clone().PreferredLocale)")
+}
+
+func TestChangedNullPointersAreRejected(test *testing.T) {
+	t := &T{test}
+	update := &AccountUpdate{VatNumber: NullString()}
+	*update.VatNumber = "x"
+	defer func() { *update.VatNumber = "" }()
+
+	_, err := json.Marshal(update)
+	t.Assert(err != nil, true, "json.Marshal of a changed Null pointer")
+}
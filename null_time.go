@@ -0,0 +1,56 @@
+package recurly
+
+import "time"
+
+// NullTime is a timestamp of a resource. It tells apart timestamps which are
+// set, null, or absent from the JSON the resource was decoded from. The
+// embedded time.Time is the zero time unless Valid is set.
+type NullTime struct {
+	time.Time
+	// Valid is set when the field has a timestamp
+	Valid bool
+	// Null is set when the field was explicitly null
+	Null bool
+}
+
+// NewNullTime returns a valid NullTime
+func NewNullTime(t time.Time) NullTime {
+	return NullTime{Time: t, Valid: true}
+}
+
+// Ptr returns a pointer to the timestamp, or nil if it is not valid
+func (t NullTime) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
+
+// absent reports whether the field was missing from the JSON
+func (t NullTime) absent() bool {
+	return !t.Valid && !t.Null
+}
+
+// MarshalJSON encodes a valid timestamp in RFC 3339 format, and null
+// otherwise. Resources leave out the timestamps which were absent.
+func (t NullTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// UnmarshalJSON decodes an RFC 3339 timestamp or null
+func (t *NullTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = NullTime{Null: true}
+		return nil
+	}
+	var v time.Time
+	if err := v.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*t = NullTime{Time: v, Valid: true}
+	return nil
+}
@@ -0,0 +1,51 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullTimeStates(test *testing.T) {
+	t := &T{test}
+	body := `{"id":"abcd1234","created_at":"2020-01-01T12:00:00Z","deleted_at":null,"code":"code"}`
+	account := &Account{}
+	if err := json.Unmarshal([]byte(body), account); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	t.Assert(account.CreatedAt.Valid, true, "CreatedAt.Valid")
+	t.Assert(account.CreatedAt.Equal(time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)), true, "CreatedAt")
+	t.Assert(account.DeletedAt.Valid, false, "DeletedAt.Valid")
+	t.Assert(account.DeletedAt.Null, true, "DeletedAt.Null")
+	t.Assert(account.DeletedAt.Ptr() == nil, true, "DeletedAt.Ptr()")
+	t.Assert(account.UpdatedAt.Valid, false, "UpdatedAt.Valid")
+	t.Assert(account.UpdatedAt.Null, false, "UpdatedAt.Null")
+
+	data, err := json.Marshal(account)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(string(data), body, "json.Marshal")
+}
+
+func TestNullTimeNested(test *testing.T) {
+	t := &T{test}
+	body := `{"id":"sub","account":{"id":"acc"},"plan":{"id":"plan"},"created_at":"2020-01-01T12:00:00Z","canceled_at":null}`
+	subscription := &Subscription{}
+	if err := json.Unmarshal([]byte(body), subscription); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	data, err := json.Marshal(subscription)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(string(data), body, "json.Marshal")
+}
+
+func TestNullTimeRejectsInvalidTimestamps(test *testing.T) {
+	var v NullTime
+	if err := json.Unmarshal([]byte(`"yesterday"`), &v); err == nil {
+		test.Errorf("Expected an invalid timestamp to be rejected")
+	}
+}
@@ -0,0 +1,144 @@
+package recurly
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// pager holds the state shared by every paginated list. The generated *List
+// types embed it and add the methods that depend on their item type.
+type pager struct {
+	client       *Client
+	nextPagePath string
+
+	// params holds the headers and context every page is requested with
+	params *Params
+
+	// chunks holds the first page of the remaining chunks of a list filtered
+	// by more IDs than the API accepts at once. chunkFetch fetches them.
+	chunks     []string
+	chunkFetch *chunkFetcher
+
+	// invalid is set when the params of the list are rejected before any
+	// request is sent
+	invalid error
+
+	// prefetch fetches pages in the background when the client has a
+	// PrefetchDepth. prefetchDone is set once it stopped.
+	prefetch     *prefetcher
+	prefetchDone bool
+
+	// embedded holds the JSON of a list embedded in a resource, whose first
+	// page was decoded with the resource. skip is the number of items left
+	// to skip when such a list is fetched again from its start.
+	embedded json.RawMessage
+	skip     int
+
+	// page is the number of pages fetched so far
+	page int
+	// position is the number of items of the current page read by Next
+	position int
+	err      error
+}
+
+// newPager returns a pager for the list at path, filtered by the params. The
+// headers and context of the params are used to fetch every page.
+func newPager(client *Client, path string, genericParams GenericParams) pager {
+	p := pager{client: client}
+	if genericParams != nil && !reflect.ValueOf(genericParams).IsNil() { // test if the interface is nil
+		params := genericParams.toParams()
+		p.params = &Params{
+			Header:  params.Header,
+			Context: params.Context,
+		}
+	}
+	if err := validateParams(genericParams); err != nil {
+		p.invalid = err
+		return p
+	}
+	paths, err := listPaths(path, genericParams)
+	if err != nil {
+		p.invalid = err
+		return p
+	}
+	p.nextPagePath = paths[0]
+	if len(paths) > 1 {
+		p.chunks = paths[1:]
+	}
+	return p
+}
+
+// fetched records that a new page was loaded
+func (p *pager) fetched() {
+	p.page++
+	p.position = 0
+}
+
+// next advances to the next item, calling fetch until a page with an unread
+// item is loaded. hasMore and size report the state of the list after each fetch.
+func (p *pager) next(fetch func() error, hasMore func() bool, size func() int) bool {
+	if p.err != nil {
+		return false
+	}
+	for p.position >= size() {
+		if !hasMore() {
+			return false
+		}
+		if err := p.contextErr(); err != nil {
+			p.err = err
+			return false
+		}
+		if err := fetch(); err != nil {
+			p.err = err
+			return false
+		}
+		if p.skip > 0 {
+			// the items embedded in the resource were already read
+			skipped := p.skip
+			if skipped > size() {
+				skipped = size()
+			}
+			p.position = skipped
+			p.skip -= skipped
+		}
+	}
+	p.position++
+	return true
+}
+
+func (p *pager) contextErr() error {
+	if p.params == nil || p.params.Context == nil {
+		return nil
+	}
+	return p.params.Context.Err()
+}
+
+// current returns the index of the current item in a page of the given size,
+// or -1 if Next has not returned an item on this page
+func (p *pager) current(size int) int {
+	if p.position == 0 || p.position > size {
+		return -1
+	}
+	return p.position - 1
+}
+
+// Err returns the error which stopped the iteration, if any. It is nil when
+// Next returned false because every item was read.
+func (p *pager) Err() error {
+	return p.err
+}
+
+// Page returns the number of the page the current item is on, starting at 1.
+// It is 0 until the first page is fetched.
+func (p *pager) Page() int {
+	return p.page
+}
+
+// Index returns the index of the current item within its page, or -1 if Next
+// has not returned an item yet
+func (p *pager) Index() int {
+	if p.position == 0 {
+		return -1
+	}
+	return p.position - 1
+}
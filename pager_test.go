@@ -0,0 +1,157 @@
+package recurly
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// pagedScenario serves the given pages in order. Each page links to the next one.
+func pagedScenario(t *T, pages ...string) (*Scenario, *[]string) {
+	var requested []string
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			requested = append(requested, req.URL.RequestURI())
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			page := len(requested) - 1
+			if page >= len(pages) {
+				t.Fatalf("Unexpected request for page %d", page+1)
+			}
+			return mockResponse(req, 200, String(pages[page]))
+		},
+	}
+	return scenario, &requested
+}
+
+func TestListIterator(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"object":"list","has_more":true,"next":"/accounts?cursor=2","data":[{"id":"a"},{"id":"b"}]}`,
+		`{"object":"list","has_more":true,"next":"/accounts?cursor=3","data":[]}`,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"c"}]}`,
+	)
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(nil)
+	t.Assert(accounts.Item() == nil, true, "Item before Next")
+	t.Assert(accounts.Page(), 0, "Page before Next")
+	t.Assert(accounts.Index(), -1, "Index before Next")
+
+	type position struct {
+		id    string
+		page  int
+		index int
+	}
+	var positions []position
+	for accounts.Next() {
+		positions = append(positions, position{accounts.Item().Id, accounts.Page(), accounts.Index()})
+	}
+	if err := accounts.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	expected := []position{{"a", 1, 0}, {"b", 1, 1}, {"c", 3, 0}}
+	t.Assert(len(positions), len(expected), "Number of items")
+	for i := range expected {
+		t.Assert(positions[i], expected[i], "Item position")
+	}
+	t.Assert(len(*requested), 3, "Number of requests")
+	t.Assert((*requested)[1], "/accounts?cursor=2", "Second page")
+	t.Assert(accounts.Next(), false, "Next after the last page")
+	t.Assert(len(*requested), 3, "Number of requests after the last page")
+}
+
+func TestListIteratorEmpty(test *testing.T) {
+	t := &T{test}
+	scenario, _ := pagedScenario(t, `{"object":"list","has_more":false,"next":null,"data":[]}`)
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(nil)
+	t.Assert(accounts.Next(), false, "Next on an empty list")
+	t.Assert(accounts.Err(), nil, "Err on an empty list")
+	t.Assert(accounts.Item() == nil, true, "Item on an empty list")
+}
+
+func TestListIteratorError(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"object":"list","has_more":true,"next":"/accounts?cursor=2","data":[{"id":"a"}]}`,
+	)
+	scenario.MakeResponse = func(req *http.Request) *http.Response {
+		if len(*requested) > 1 {
+			return mockResponse(req, 500, String(`{"error":{"type":"internal_server_error","message":"Oops"}}`))
+		}
+		return mockResponse(req, 200, String(`{"object":"list","has_more":true,"next":"/accounts?cursor=2","data":[{"id":"a"}]}`))
+	}
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(nil)
+	t.Assert(accounts.Next(), true, "Next on the first page")
+	t.Assert(accounts.Next(), false, "Next on a failed page")
+	if _, ok := accounts.Err().(*Error); !ok {
+		t.Fatalf("Expected *Error, got %v", accounts.Err())
+	}
+	t.Assert(accounts.Next(), false, "Next after an error")
+	t.Assert(len(*requested), 2, "Number of requests")
+}
+
+func TestListIteratorContext(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"object":"list","has_more":true,"next":"/accounts?cursor=2","data":[{"id":"a"}]}`,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"b"}]}`,
+	)
+	client := scenario.MockHTTPClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	params := &ListAccountsParams{}
+	params.Context = ctx
+	accounts := client.ListAccounts(params)
+
+	t.Assert(accounts.Next(), true, "Next on the first page")
+	cancel()
+	t.Assert(accounts.Next(), false, "Next after cancelling")
+	t.Assert(accounts.Err(), context.Canceled, "Err after cancelling")
+	t.Assert(len(*requested), 1, "Number of requests")
+}
+
+func TestListFetchSendsParamsHeaders(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			t.Assert(req.Header.Get("X-Test"), "yes", "Request Header \"X-Test\"")
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			return mockResponse(req, 200, String(`{"object":"list","has_more":false,"next":null,"data":[]}`))
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	params := &ListAccountsParams{}
+	params.Header = http.Header{"X-Test": []string{"yes"}}
+	accounts := client.ListAccounts(params)
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+}
+
+func TestListFetchAfterLastPage(test *testing.T) {
+	t := &T{test}
+	scenario, requested := pagedScenario(t,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"a"}]}`,
+		`{"object":"list","has_more":false,"next":null,"data":[{"id":"a"}]}`,
+	)
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(nil)
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected after the last page: %v", err)
+	}
+	t.Assert(len(*requested), 2, "Number of requests")
+}
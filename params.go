@@ -3,6 +3,8 @@ package recurly
 import (
 	"context"
 	"net/http"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -30,6 +32,10 @@ type Params struct {
 	Data interface{} `json:"-"`
 }
 
+func (params *Params) toParams() *Params {
+	return params
+}
+
 // URLParams contains additional URL parameters for querying generic lists
 func (params *Params) URLParams() []KeyValue {
 	if params.RequestParams != nil {
@@ -64,6 +70,85 @@ const (
 	SortUpdatedAt SortField = "updated_at"
 )
 
+// paramsValidator is implemented by the params of operations with typed
+// query parameters
+type paramsValidator interface {
+	validate() error
+}
+
+// validateParams checks the typed query parameters of the params, if any,
+// before a request is sent
+func validateParams(genericParams GenericParams) error {
+	if genericParams == nil || reflect.ValueOf(genericParams).IsNil() { // test if the interface is nil
+		return nil
+	}
+	if v, ok := genericParams.(paramsValidator); ok {
+		return v.validate()
+	}
+	return nil
+}
+
+// checkEnumParam checks a query parameter with a fixed set of values. The value
+// sent, typed or string, must be one of the values the API accepts, and both
+// must agree if both are set.
+func checkEnumParam(invalid []ErrorParam, key string, value *string, typed string, values []string) []ErrorParam {
+	if typed == "" {
+		if value != nil && !containsString(values, *value) {
+			return append(invalid, ErrorParam{
+				Property: key,
+				Message:  "must be one of " + strings.Join(values, ", ") + ", got \"" + *value + "\"",
+			})
+		}
+		return invalid
+	}
+	if !containsString(values, typed) {
+		return append(invalid, ErrorParam{
+			Property: key,
+			Message:  "must be one of " + strings.Join(values, ", ") + ", got \"" + typed + "\"",
+		})
+	}
+	if value != nil && *value != typed {
+		return append(invalid, ErrorParam{
+			Property: key,
+			Message:  "is set to both \"" + *value + "\" and \"" + typed + "\"",
+		})
+	}
+	return invalid
+}
+
+// paramsError returns a validation error for the invalid query parameters, or
+// nil if there are none
+func paramsError(invalid []ErrorParam) error {
+	return validationError("invalid parameters", invalid)
+}
+
+// validationError returns an error of type ErrorTypeValidation listing the
+// invalid properties, or nil if there are none
+func validationError(message string, invalid []ErrorParam) error {
+	if len(invalid) == 0 {
+		return nil
+	}
+	messages := make([]string, len(invalid))
+	for i, param := range invalid {
+		messages[i] = param.Property + " " + param.Message
+	}
+	return &Error{
+		Message: message + ": " + strings.Join(messages, "; "),
+		Class:   ErrorClassClient,
+		Type:    ErrorTypeValidation,
+		Params:  invalid,
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // formatBool converts a boolean to a string
 func formatBool(v bool) string {
 	if v {
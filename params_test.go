@@ -1,6 +1,7 @@
 package recurly
 
 import (
+	"net/http"
 	"testing"
 	"time"
 )
@@ -20,3 +21,79 @@ func TestFormatTime(test *testing.T) {
 	formattedTime := formatTime(time)
 	t.Assert(formattedTime, formatTime(time), "formatTime()")
 }
+
+func TestTypedParamsAreSent(test *testing.T) {
+	t := &T{test}
+	params := &ListSubscriptionsParams{
+		SortValue:  SortUpdatedAt,
+		OrderValue: ListAscending,
+		StateValue: SubscriptionStateFilterInTrial,
+	}
+	t.Assert(BuildUrl("/subscriptions", params), "/subscriptions?order=asc&sort=updated_at&state=in_trial", "BuildUrl()")
+
+	// the string fields are still sent on their own
+	params = &ListSubscriptionsParams{Sort: String("created_at")}
+	t.Assert(BuildUrl("/subscriptions", params), "/subscriptions?sort=created_at", "BuildUrl()")
+	t.Assert(validateParams(params), nil, "validateParams()")
+}
+
+func TestTypedParamsAreValidated(test *testing.T) {
+	t := &T{test}
+	params := &ListAccountsParams{
+		SortValue:    SortField("updatedat"),
+		Order:        String("desc"),
+		OrderValue:   ListAscending,
+		PastDueValue: AccountPastDueFilterTrue,
+	}
+	err := validateParams(params)
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %v", err)
+	}
+	t.Assert(e.Type, ErrorTypeValidation, "Error.Type")
+	t.Assert(e.Class, ErrorClassClient, "Error.Class")
+	t.Assert(len(e.Params), 2, "len(Error.Params)")
+	t.Assert(e.Params[0].Property, "order", "Error.Params[0].Property")
+	t.Assert(e.Params[0].Message, `is set to both "desc" and "asc"`, "Error.Params[0].Message")
+	t.Assert(e.Params[1].Property, "sort", "Error.Params[1].Property")
+	t.Assert(e.Params[1].Message, `must be one of created_at, updated_at, got "updatedat"`, "Error.Params[1].Message")
+
+	// the string fields are checked against the same values
+	err = validateParams(&ListAccountsParams{Sort: String("updatedat"), Order: String("asc")})
+	e, ok = err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %v", err)
+	}
+	t.Assert(len(e.Params), 1, "len(Error.Params)")
+	t.Assert(e.Params[0].Property, "sort", "Error.Params[0].Property")
+	t.Assert(e.Params[0].Message, `must be one of created_at, updated_at, got "updatedat"`, "Error.Params[0].Message")
+
+	var nilParams *ListAccountsParams
+	t.Assert(validateParams(nilParams), nil, "validateParams(nil)")
+	t.Assert(SortCreatedAt.IsValid(), true, "SortCreatedAt.IsValid()")
+	t.Assert(InvoiceTypeFilter("legacy").IsValid(), true, "InvoiceTypeFilter.IsValid()")
+	t.Assert(StateFilter("deleted").IsValid(), false, "StateFilter.IsValid()")
+}
+
+func TestInvalidTypedParamsAreNotSent(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			t.Errorf("Request not expected: %v", req.URL)
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			return mockResponse(req, 200, String(`{}`))
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	accounts := client.ListAccounts(&ListAccountsParams{SortValue: SortField("name")})
+	if _, ok := accounts.Fetch().(*Error); !ok {
+		t.Errorf("Expected the list to be rejected")
+	}
+	_, err := client.TerminateSubscription("abcd1234", &TerminateSubscriptionParams{RefundValue: RefundType("all")})
+	if _, ok := err.(*Error); !ok {
+		t.Errorf("Expected the operation to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package recurly
+
+// PaymentMethodVariant is a typed view of a PaymentMethod, holding only the
+// fields of its payment type. It is one of *CardPaymentMethod,
+// *PayPalPaymentMethod, *AmazonPaymentMethod, *BankAccountPaymentMethod,
+// *IBANPaymentMethod, *GatewayTokenPaymentMethod or *OtherPaymentMethod.
+type PaymentMethodVariant interface {
+	isPaymentMethod()
+}
+
+// CardPaymentMethod is a credit card, or a card paid with Apple Pay
+type CardPaymentMethod struct {
+	// Object is PaymentMethodObjectCreditCard or PaymentMethodObjectApplePay
+	Object   PaymentMethodObject
+	CardType CardType
+	FirstSix string
+	LastFour string
+	ExpMonth int
+	ExpYear  int
+	// GatewayToken and GatewayCode are set when the card is stored by a
+	// gateway
+	GatewayToken string
+	GatewayCode  string
+}
+
+// PayPalPaymentMethod is a PayPal account
+type PayPalPaymentMethod struct {
+	// Object is PaymentMethodObjectPaypal or
+	// PaymentMethodObjectPaypalBillingAgreement
+	Object             PaymentMethodObject
+	BillingAgreementId string
+}
+
+// AmazonPaymentMethod is an Amazon Pay account
+type AmazonPaymentMethod struct {
+	// Object is PaymentMethodObjectAmazon or
+	// PaymentMethodObjectAmazonBillingAgreement
+	Object             PaymentMethodObject
+	BillingAgreementId string
+}
+
+// BankAccountPaymentMethod is a bank account debited through ACH or EFT
+type BankAccountPaymentMethod struct {
+	// Object is PaymentMethodObjectBankAccountInfo or PaymentMethodObjectEft
+	Object            PaymentMethodObject
+	AccountType       AccountType
+	RoutingNumber     string
+	RoutingNumberBank string
+	LastFour          string
+}
+
+// IBANPaymentMethod is a bank account identified by its IBAN, such as a SEPA
+// direct debit
+type IBANPaymentMethod struct {
+	// Object is PaymentMethodObjectSepadirectdebit or
+	// PaymentMethodObjectIbanBankAccount
+	Object  PaymentMethodObject
+	LastTwo string
+}
+
+// GatewayTokenPaymentMethod is a payment method stored by a gateway, and only
+// known by its token
+type GatewayTokenPaymentMethod struct {
+	GatewayToken string
+	GatewayCode  string
+}
+
+// OtherPaymentMethod is a payment method without details, such as a check or
+// a wire transfer, or one added to the API after the client was generated
+type OtherPaymentMethod struct {
+	Object PaymentMethodObject
+}
+
+func (*CardPaymentMethod) isPaymentMethod()         {}
+func (*PayPalPaymentMethod) isPaymentMethod()       {}
+func (*AmazonPaymentMethod) isPaymentMethod()       {}
+func (*BankAccountPaymentMethod) isPaymentMethod()  {}
+func (*IBANPaymentMethod) isPaymentMethod()         {}
+func (*GatewayTokenPaymentMethod) isPaymentMethod() {}
+func (*OtherPaymentMethod) isPaymentMethod()        {}
+
+// Variant returns the typed view of the payment method matching its object,
+// for use in a type switch:
+//
+//	switch method := billingInfo.PaymentMethod.Variant().(type) {
+//	case *recurly.CardPaymentMethod:
+//		fmt.Println(method.CardType, method.LastFour)
+//	case *recurly.IBANPaymentMethod:
+//		fmt.Println(method.LastTwo)
+//	}
+func (method *PaymentMethod) Variant() PaymentMethodVariant {
+	switch method.Object {
+	case PaymentMethodObjectCreditCard, PaymentMethodObjectApplePay:
+		return &CardPaymentMethod{
+			Object:       method.Object,
+			CardType:     method.CardType,
+			FirstSix:     method.FirstSix,
+			LastFour:     method.LastFour,
+			ExpMonth:     method.ExpMonth,
+			ExpYear:      method.ExpYear,
+			GatewayToken: method.GatewayToken,
+			GatewayCode:  method.GatewayCode,
+		}
+	case PaymentMethodObjectPaypal, PaymentMethodObjectPaypalBillingAgreement:
+		return &PayPalPaymentMethod{Object: method.Object, BillingAgreementId: method.BillingAgreementId}
+	case PaymentMethodObjectAmazon, PaymentMethodObjectAmazonBillingAgreement:
+		return &AmazonPaymentMethod{Object: method.Object, BillingAgreementId: method.BillingAgreementId}
+	case PaymentMethodObjectBankAccountInfo, PaymentMethodObjectEft:
+		return &BankAccountPaymentMethod{
+			Object:            method.Object,
+			AccountType:       method.AccountType,
+			RoutingNumber:     method.RoutingNumber,
+			RoutingNumberBank: method.RoutingNumberBank,
+			LastFour:          method.LastFour,
+		}
+	case PaymentMethodObjectSepadirectdebit, PaymentMethodObjectIbanBankAccount:
+		return &IBANPaymentMethod{Object: method.Object, LastTwo: method.LastTwo}
+	case PaymentMethodObjectGatewayToken:
+		return &GatewayTokenPaymentMethod{GatewayToken: method.GatewayToken, GatewayCode: method.GatewayCode}
+	}
+	return &OtherPaymentMethod{Object: method.Object}
+}
+
+// billingPayment is a payment type of billing info requests: the payment
+// fields it needs, and the other ones it accepts
+type billingPayment struct {
+	name     string
+	required []string
+	optional []string
+}
+
+var (
+	tokenPayment        = billingPayment{"token", []string{"token_id"}, []string{"three_d_secure_action_result_token_id"}}
+	cardPayment         = billingPayment{"card", []string{"number", "month", "year"}, []string{"cvv", "three_d_secure_action_result_token_id"}}
+	achPayment          = billingPayment{"ACH", []string{"account_number", "routing_number", "account_type", "name_on_account"}, nil}
+	sepaPayment         = billingPayment{"SEPA", []string{"iban", "name_on_account"}, nil}
+	gatewayTokenPayment = billingPayment{"gateway token", []string{"gateway_token", "gateway_code"}, nil}
+	payPalPayment       = billingPayment{"PayPal", []string{"paypal_billing_agreement_id"}, nil}
+	amazonPayment       = billingPayment{"Amazon", []string{"amazon_billing_agreement_id"}, nil}
+)
+
+// paymentField is a field of a billing info request which belongs to some
+// payment types only, and whether it is set
+type paymentField struct {
+	name string
+	set  bool
+}
+
+// paymentFields returns the fields of the request which belong to some
+// payment types only. The fields shared by every type, such as the name and
+// address, aren't listed.
+func paymentFields(info *BillingInfoCreate) []paymentField {
+	set := func(value *string) bool { return value != nil && !IsNull(value) }
+	return []paymentField{
+		{"token_id", set(info.TokenId)},
+		{"number", set(info.Number)},
+		{"month", set(info.Month)},
+		{"year", set(info.Year)},
+		{"cvv", set(info.Cvv)},
+		{"three_d_secure_action_result_token_id", set(info.ThreeDSecureActionResultTokenId)},
+		{"gateway_token", set(info.GatewayToken)},
+		{"gateway_code", set(info.GatewayCode)},
+		{"amazon_billing_agreement_id", set(info.AmazonBillingAgreementId)},
+		{"paypal_billing_agreement_id", set(info.PaypalBillingAgreementId)},
+		{"iban", set(info.Iban)},
+		{"name_on_account", set(info.NameOnAccount)},
+		{"account_number", set(info.AccountNumber)},
+		{"routing_number", set(info.RoutingNumber)},
+		{"account_type", info.AccountType != nil && *info.AccountType != ""},
+	}
+}
+
+// check returns a deep copy of the request, or an *Error of type
+// ErrorTypeValidation if it is invalid or mixes in the fields of another
+// payment type
+func (payment billingPayment) check(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	invalid := info.validate(nil, "")
+	for _, field := range paymentFields(&info) {
+		switch {
+		case containsString(payment.required, field.name):
+			invalid = checkRequired(invalid, field.name, field.set)
+		case field.set && !containsString(payment.optional, field.name):
+			invalid = append(invalid, ErrorParam{
+				Property: field.name,
+				Message:  "can't be set for " + payment.name + " payments",
+			})
+		}
+	}
+	if err := requestError(invalid); err != nil {
+		return nil, err
+	}
+	return info.Clone(), nil
+}
+
+// NewTokenBillingInfo returns billing info paying with a Recurly.js token set
+// in TokenId. Like every constructor below, it returns a deep copy of info,
+// which keeps the fields shared by every payment type, such as the name and
+// address, and returns an *Error of type ErrorTypeValidation if the fields of
+// the payment type are missing, or if fields of another payment type are set.
+func NewTokenBillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return tokenPayment.check(info)
+}
+
+// NewCardBillingInfo returns billing info paying by card, with its Number,
+// Month, Year and optionally Cvv
+func NewCardBillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return cardPayment.check(info)
+}
+
+// NewACHBillingInfo returns billing info paying from a US bank account, with
+// its AccountNumber, RoutingNumber, AccountType and NameOnAccount
+func NewACHBillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return achPayment.check(info)
+}
+
+// NewSEPABillingInfo returns billing info paying by SEPA direct debit, with
+// the Iban and NameOnAccount of the bank account
+func NewSEPABillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return sepaPayment.check(info)
+}
+
+// NewGatewayTokenBillingInfo returns billing info paying with a payment
+// method stored by a gateway, with its GatewayToken and GatewayCode
+func NewGatewayTokenBillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return gatewayTokenPayment.check(info)
+}
+
+// NewPayPalBillingInfo returns billing info paying with the
+// PaypalBillingAgreementId of a PayPal account
+func NewPayPalBillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return payPalPayment.check(info)
+}
+
+// NewAmazonBillingInfo returns billing info paying with the
+// AmazonBillingAgreementId of an Amazon Pay account
+func NewAmazonBillingInfo(info BillingInfoCreate) (*BillingInfoCreate, error) {
+	return amazonPayment.check(info)
+}
@@ -0,0 +1,100 @@
+package recurly
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func decodePaymentMethod(t *T, data string) PaymentMethodVariant {
+	method := &PaymentMethod{}
+	if err := json.Unmarshal([]byte(data), method); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	return method.Variant()
+}
+
+func TestPaymentMethodVariant(test *testing.T) {
+	t := &T{test}
+
+	switch method := decodePaymentMethod(t, `{"object":"credit_card","card_type":"Visa","last_four":"1111","exp_year":2030}`).(type) {
+	case *CardPaymentMethod:
+		t.Assert(method.CardType, CardType("Visa"), "CardPaymentMethod.CardType")
+		t.Assert(method.LastFour, "1111", "CardPaymentMethod.LastFour")
+		t.Assert(method.ExpYear, 2030, "CardPaymentMethod.ExpYear")
+	default:
+		t.Fatalf("Expected *CardPaymentMethod, got %T", method)
+	}
+
+	switch method := decodePaymentMethod(t, `{"object":"bank_account_info","account_type":"checking","routing_number":"123456780"}`).(type) {
+	case *BankAccountPaymentMethod:
+		t.Assert(method.AccountType, AccountTypeChecking, "BankAccountPaymentMethod.AccountType")
+		t.Assert(method.RoutingNumber, "123456780", "BankAccountPaymentMethod.RoutingNumber")
+	default:
+		t.Fatalf("Expected *BankAccountPaymentMethod, got %T", method)
+	}
+
+	method := decodePaymentMethod(t, `{"object":"paypal_billing_agreement","billing_agreement_id":"B-1"}`)
+	t.Assert(*method.(*PayPalPaymentMethod), PayPalPaymentMethod{PaymentMethodObjectPaypalBillingAgreement, "B-1"}, "PayPalPaymentMethod")
+	method = decodePaymentMethod(t, `{"object":"sepadirectdebit","last_two":"00"}`)
+	t.Assert(method.(*IBANPaymentMethod).LastTwo, "00", "IBANPaymentMethod.LastTwo")
+	method = decodePaymentMethod(t, `{"object":"gateway_token","gateway_token":"tok","gateway_code":"gw"}`)
+	t.Assert(*method.(*GatewayTokenPaymentMethod), GatewayTokenPaymentMethod{"tok", "gw"}, "GatewayTokenPaymentMethod")
+	method = decodePaymentMethod(t, `{"object":"crypto"}`)
+	t.Assert(method.(*OtherPaymentMethod).Object, PaymentMethodObject("crypto"), "OtherPaymentMethod.Object")
+}
+
+func TestNewCardBillingInfo(test *testing.T) {
+	t := &T{test}
+
+	info, err := NewCardBillingInfo(BillingInfoCreate{
+		FirstName: String("Ada"),
+		Number:    String("4111111111111111"),
+		Month:     String("12"),
+		Year:      String("2030"),
+		Cvv:       String("123"),
+	})
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(*info.Number, "4111111111111111", "BillingInfoCreate.Number")
+	t.Assert(*info.FirstName, "Ada", "BillingInfoCreate.FirstName")
+
+	// the returned request shares nothing with the one passed in
+	address := &AddressCreate{City: String("Paris")}
+	input := BillingInfoCreate{TokenId: String("tok"), Address: address}
+	info, _ = NewTokenBillingInfo(input)
+	*info.Address.City = "Lyon"
+	*info.TokenId = "other"
+	t.Assert(*address.City, "Paris", "AddressCreate.City")
+	t.Assert(*input.TokenId, "tok", "BillingInfoCreate.TokenId")
+
+	_, err = NewCardBillingInfo(BillingInfoCreate{
+		Number: String("4111111111111111"),
+		Year:   String("2030"),
+		Iban:   String("DE89370400440532013000"),
+	})
+	assertInvalid(t, err,
+		ErrorParam{Property: "month", Message: "can't be blank"},
+		ErrorParam{Property: "iban", Message: "can't be set for card payments"},
+	)
+}
+
+func TestNewBillingInfoConstructors(test *testing.T) {
+	t := &T{test}
+
+	_, err := NewSEPABillingInfo(BillingInfoCreate{Iban: String("DE89370400440532013000"), NameOnAccount: String("Ada")})
+	t.Assert(err, nil, "NewSEPABillingInfo")
+	_, err = NewACHBillingInfo(BillingInfoCreate{
+		AccountNumber: String("1234"),
+		RoutingNumber: String("123456780"),
+		AccountType:   AccountTypeSavings.Ptr(),
+		NameOnAccount: String("Ada"),
+	})
+	t.Assert(err, nil, "NewACHBillingInfo")
+
+	_, err = NewTokenBillingInfo(BillingInfoCreate{TokenId: String("tok"), Number: String("4111111111111111")})
+	assertInvalid(t, err, ErrorParam{Property: "number", Message: "can't be set for token payments"})
+	_, err = NewPayPalBillingInfo(BillingInfoCreate{AmazonBillingAgreementId: String("A-1")})
+	assertInvalid(t, err,
+		ErrorParam{Property: "amazon_billing_agreement_id", Message: "can't be set for PayPal payments"},
+		ErrorParam{Property: "paypal_billing_agreement_id", Message: "can't be blank"},
+	)
+}
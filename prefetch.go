@@ -0,0 +1,255 @@
+package recurly
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// listPage is a single page of a list, as returned by the API
+type listPage interface {
+	Resource
+	listMetadata() *ListMetadata
+}
+
+// prefetchedPage is a page fetched ahead of the consumer, or the error which
+// stopped the prefetching
+type prefetchedPage struct {
+	page listPage
+	err  error
+}
+
+// prefetchIdleTimeout is how long the background fetching of a list waits for
+// the consumer to read a page before it stops. A list abandoned without Close
+// then doesn't keep its goroutine, and a consumer which comes back reads the
+// rest of the list page by page.
+var prefetchIdleTimeout = time.Minute
+
+// prefetcher fetches the pages of a list in the background, up to depth pages
+// ahead of the consumer
+type prefetcher struct {
+	pages  chan prefetchedPage
+	demand chan struct{}
+	done   chan struct{}
+	once   sync.Once
+	// idleTimeout is the prefetchIdleTimeout when the prefetching started
+	idleTimeout time.Duration
+}
+
+// startPrefetch starts fetching the pages of the list from its next page
+func (p *pager) startPrefetch(depth int, newPage func() listPage) *prefetcher {
+	pf := &prefetcher{
+		// one more page is held by the goroutine while it waits to send it
+		pages:  make(chan prefetchedPage, depth-1),
+		demand: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+
+		idleTimeout: prefetchIdleTimeout,
+	}
+	go pf.run(p.client, p.nextPagePath, p.params, depth, newPage)
+	return pf
+}
+
+func (pf *prefetcher) run(client *Client, path string, params *Params, depth int, newPage func() listPage) {
+	defer close(pf.pages)
+
+	var ctxDone <-chan struct{}
+	if params != nil && params.Context != nil {
+		ctxDone = params.Context.Done()
+	}
+
+	for {
+		page := newPage()
+		err := client.Call(http.MethodGet, path, params, page)
+		idle := time.NewTimer(pf.idleTimeout)
+		select {
+		case pf.pages <- prefetchedPage{page: page, err: err}:
+			idle.Stop()
+		case <-idle.C:
+			return
+		case <-pf.done:
+			idle.Stop()
+			return
+		case <-ctxDone:
+			idle.Stop()
+			return
+		}
+		// the page answers any demand made while it was fetched
+		select {
+		case <-pf.demand:
+		default:
+		}
+		meta := page.listMetadata()
+		if err != nil || !meta.HasMore {
+			return
+		}
+		path = meta.Next
+
+		// Running ahead must not use up the requests the rest of the
+		// application needs. Once the remaining requests drop to the
+		// depth, pages are only fetched when the consumer asks for them,
+		// and not at all until the limit resets once none are left.
+		limit := page.GetResponse().RateLimit
+		if limit.Limit == 0 || limit.Remaining > depth {
+			continue
+		}
+		if reset := limit.ResetDate(); limit.Remaining == 0 && reset != nil {
+			timer := time.NewTimer(time.Until(*reset))
+			select {
+			case <-timer.C:
+			case <-pf.done:
+				timer.Stop()
+				return
+			case <-ctxDone:
+				timer.Stop()
+				return
+			}
+			continue
+		}
+		idle = time.NewTimer(pf.idleTimeout)
+		select {
+		case <-pf.demand:
+			idle.Stop()
+		case <-idle.C:
+			return
+		case <-pf.done:
+			idle.Stop()
+			return
+		case <-ctxDone:
+			idle.Stop()
+			return
+		}
+	}
+}
+
+// next returns the next prefetched page. ok is false once every page was returned.
+func (pf *prefetcher) next(params *Params) (result prefetchedPage, ok bool) {
+	select {
+	case result, ok = <-pf.pages:
+		return result, ok
+	default:
+	}
+
+	// No page is ready, ask for one in case prefetching is paused
+	select {
+	case pf.demand <- struct{}{}:
+	default:
+	}
+
+	var ctxDone <-chan struct{}
+	if params != nil && params.Context != nil {
+		ctxDone = params.Context.Done()
+	}
+	select {
+	case result, ok = <-pf.pages:
+		return result, ok
+	case <-ctxDone:
+		return prefetchedPage{err: params.Context.Err()}, true
+	}
+}
+
+func (pf *prefetcher) stop() {
+	pf.once.Do(func() {
+		close(pf.done)
+	})
+}
+
+// fetchPage fetches the next page of the list. When the client has a
+// PrefetchDepth, the page comes from the pages fetched in the background.
+func (p *pager) fetchPage(newPage func() listPage) (listPage, error) {
+	if p.invalid != nil {
+		return nil, p.invalid
+	}
+	if p.detached() {
+		return nil, errDetachedList
+	}
+
+	var page listPage
+	var err error
+	if len(p.chunks) > 0 && p.chunkFetch == nil {
+		p.chunkFetch = p.startChunks(newPage)
+	}
+	if depth := p.client.PrefetchDepth; depth > 0 && p.chunkFetch == nil && p.prefetch == nil && !p.prefetchDone {
+		p.prefetch = p.startPrefetch(depth, newPage)
+	}
+	if p.chunkFetch != nil {
+		if result, ok := p.chunkFetch.next(p.nextPagePath, p.params); ok {
+			page, err = result.page, result.err
+		}
+	} else if p.prefetch != nil {
+		result, ok := p.prefetch.next(p.params)
+		if !ok {
+			// every page was already returned, or the prefetching stopped
+			// while idle, fetch on demand from now on
+			p.prefetch = nil
+			p.prefetchDone = true
+		}
+		page, err = result.page, result.err
+	}
+	if page == nil && err == nil {
+		page = newPage()
+		err = p.client.Call(http.MethodGet, p.nextPagePath, p.params, page)
+	}
+	if err != nil {
+		return nil, err
+	}
+	meta := page.listMetadata()
+	if !meta.HasMore && len(p.chunks) > 0 {
+		// continue with the next chunk of IDs
+		meta.HasMore = true
+		meta.Next = p.chunks[0]
+		p.chunks = p.chunks[1:]
+	}
+	p.nextPagePath = meta.Next
+	p.fetched()
+	return page, nil
+}
+
+// count returns the number of items matching the list, summed over every
+// remaining chunk of IDs
+func (p *pager) count(newPage func() listPage) (*int64, error) {
+	if p.invalid != nil {
+		return nil, p.invalid
+	}
+	if p.detached() {
+		return nil, errDetachedList
+	}
+	var total *int64
+	for _, path := range append([]string{p.nextPagePath}, p.chunks...) {
+		resources := newPage()
+		err := p.client.Call(http.MethodHead, path, p.params, resources)
+		if err != nil {
+			return nil, err
+		}
+		count := resources.GetResponse().TotalRecords
+		if count == nil {
+			return nil, nil
+		}
+		if total == nil {
+			total = new(int64)
+		}
+		*total += *count
+	}
+	return total, nil
+}
+
+// Close stops fetching pages in the background. Lists only fetch pages in the
+// background when the client has a PrefetchDepth or they are filtered by more
+// than 200 IDs, and stop once the last page is fetched, their context is
+// cancelled, or no page was read for a minute. Close releases a list which is
+// abandoned before that at once, so call it (or defer it) when a loop over a
+// list can end early.
+func (p *pager) Close() {
+	if p.chunkFetch != nil {
+		p.chunkFetch.stop()
+	}
+	if p.prefetch != nil {
+		p.prefetch.stop()
+		p.prefetch = nil
+		p.prefetchDone = true
+	}
+}
+
+func (meta *ListMetadata) listMetadata() *ListMetadata {
+	return meta
+}
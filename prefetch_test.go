@@ -0,0 +1,198 @@
+package recurly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// prefetchServer serves numbered pages of accounts, one account per page
+type prefetchServer struct {
+	sync.Mutex
+	pages     int
+	remaining int
+	requested []string
+}
+
+func (s *prefetchServer) roundTrip(req *http.Request) *http.Response {
+	s.Lock()
+	defer s.Unlock()
+	s.requested = append(s.requested, req.URL.RequestURI())
+	page := len(s.requested)
+	hasMore := page < s.pages
+	body := fmt.Sprintf(`{"object":"list","has_more":%t,"next":"/accounts?page=%d","data":[{"id":"%d"}]}`, hasMore, page+1, page)
+	res := mockResponse(req, 200, String(body))
+	if s.remaining > 0 {
+		res.Header.Set("X-RateLimit-Limit", "2000")
+		res.Header.Set("X-RateLimit-Remaining", fmt.Sprint(s.remaining))
+		res.Header.Set("X-RateLimit-Reset", fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+	}
+	return res
+}
+
+func (s *prefetchServer) requests() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.requested)
+}
+
+// waitForRequests waits until the server received n requests
+func (s *prefetchServer) waitForRequests(t *T, n int) {
+	deadline := time.Now().Add(5 * time.Second)
+	for s.requests() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %d requests, got %d", n, s.requests())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (s *prefetchServer) client(depth int) *Client {
+	client := newClient("APIKEY", &http.Client{Transport: roundTripFunc(s.roundTrip)})
+	client.Log = NewLogger(LevelWarn)
+	client.PrefetchDepth = depth
+	return client
+}
+
+func TestPrefetchReturnsEveryPageInOrder(test *testing.T) {
+	t := &T{test}
+	server := &prefetchServer{pages: 5}
+	accounts := server.client(2).ListAccounts(nil)
+
+	var ids string
+	for accounts.Next() {
+		ids += accounts.Item().Id
+	}
+	if err := accounts.Err(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(ids, "12345", "Account ids")
+	t.Assert(accounts.Page(), 5, "Page")
+	t.Assert(server.requests(), 5, "Number of requests")
+	t.Assert(server.requested[1], "/accounts?page=2", "Second page")
+}
+
+func TestPrefetchFetchesAhead(test *testing.T) {
+	t := &T{test}
+	server := &prefetchServer{pages: 10}
+	accounts := server.client(3).ListAccounts(nil)
+	defer accounts.Close()
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	// the first page plus three pages ahead
+	server.waitForRequests(t, 4)
+	time.Sleep(20 * time.Millisecond)
+	t.Assert(server.requests(), 4, "Number of requests ahead of the consumer")
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(accounts.Data[0].Id, "2", "Second page")
+	server.waitForRequests(t, 5)
+}
+
+func TestPrefetchObeysRateLimit(test *testing.T) {
+	t := &T{test}
+	server := &prefetchServer{pages: 10, remaining: 2}
+	accounts := server.client(3).ListAccounts(nil)
+	defer accounts.Close()
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	// at most one request is made ahead of the consumer
+	if n := server.requests(); n > 2 {
+		t.Errorf("Expected at most 2 requests with a low rate limit, got %d", n)
+	}
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(accounts.Data[0].Id, "2", "Second page")
+	time.Sleep(20 * time.Millisecond)
+	if n := server.requests(); n > 3 {
+		t.Errorf("Expected at most 3 requests with a low rate limit, got %d", n)
+	}
+}
+
+func TestPrefetchClose(test *testing.T) {
+	t := &T{test}
+	server := &prefetchServer{pages: 10}
+	accounts := server.client(2).ListAccounts(nil)
+
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	server.waitForRequests(t, 3)
+	accounts.Close()
+	time.Sleep(20 * time.Millisecond)
+	t.Assert(server.requests(), 3, "Number of requests after Close")
+
+	// the list can still be read page by page
+	if err := accounts.Fetch(); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	t.Assert(server.requests(), 4, "Number of requests after Fetch")
+}
+
+func TestPrefetchContext(test *testing.T) {
+	t := &T{test}
+	server := &prefetchServer{pages: 10}
+	ctx, cancel := context.WithCancel(context.Background())
+	params := &ListAccountsParams{}
+	params.Context = ctx
+	accounts := server.client(2).ListAccounts(params)
+
+	t.Assert(accounts.Next(), true, "Next on the first page")
+	server.waitForRequests(t, 3)
+	cancel()
+	t.Assert(accounts.Next(), false, "Next after cancelling")
+	t.Assert(accounts.Err(), context.Canceled, "Err after cancelling")
+}
+
+// prefetching reports whether a goroutine fetches pages in the background
+func prefetching() bool {
+	stacks := make([]byte, 1<<20)
+	stacks = stacks[:runtime.Stack(stacks, true)]
+	return strings.Contains(string(stacks), "(*prefetcher).run")
+}
+
+func TestPrefetchStopsWhenAbandoned(test *testing.T) {
+	t := &T{test}
+	defer func(timeout time.Duration) { prefetchIdleTimeout = timeout }(prefetchIdleTimeout)
+	prefetchIdleTimeout = 20 * time.Millisecond
+
+	server := &prefetchServer{pages: 10}
+	accounts := server.client(2).ListAccounts(nil)
+	for accounts.Next() {
+		break
+	}
+
+	server.waitForRequests(t, 3)
+	deadline := time.Now().Add(5 * time.Second)
+	for prefetching() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the prefetching goroutine to stop")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// the first page, the one waiting to be read and the one held when
+	// the prefetching stopped
+	t.Assert(server.requests(), 3, "Number of requests when abandoned")
+
+	// the list can still be read, from the page fetched ahead then on
+	// demand, starting with the page held when the prefetching stopped
+	t.Assert(accounts.Next(), true, "Next after coming back")
+	t.Assert(accounts.Item().Id, "2", "Page fetched ahead")
+	t.Assert(accounts.Next(), true, "Next on demand")
+	t.Assert(server.requested[3], "/accounts?page=3", "Page fetched again")
+	t.Assert(server.requests(), 4, "Number of requests")
+}
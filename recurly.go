@@ -1,5 +1,7 @@
 package recurly
 
+//go:generate go run ./cmd/recurlygen
+
 import (
 	"time"
 )
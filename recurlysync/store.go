@@ -0,0 +1,252 @@
+package recurlysync
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Checkpoint is the progress of the sync of a resource type
+type Checkpoint struct {
+	// Watermark is the `updated_at` of the last record delivered. The next
+	// sync lists the records updated since then.
+	Watermark time.Time `json:"watermark"`
+}
+
+// Store persists the checkpoint of every resource type, and the last version
+// of every record delivered, which the next version is compared to.
+type Store interface {
+	// LoadCheckpoint returns the checkpoint of the resource type. It returns
+	// a zero Checkpoint if the resource type was never synced.
+	LoadCheckpoint(resource string) (Checkpoint, error)
+	// SaveCheckpoint replaces the checkpoint of the resource type
+	SaveCheckpoint(resource string, checkpoint Checkpoint) error
+	// LoadSnapshot returns the JSON of the last version delivered of a
+	// record, or nil if it was never delivered
+	LoadSnapshot(resource string, id string) ([]byte, error)
+	// SaveSnapshot replaces the last version delivered of a record
+	SaveSnapshot(resource string, id string, data []byte) error
+}
+
+// MemoryStore keeps checkpoints and snapshots in memory. It is safe for
+// concurrent use.
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+	snapshots   map[string][]byte
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		checkpoints: map[string]Checkpoint{},
+		snapshots:   map[string][]byte{},
+	}
+}
+
+// LoadCheckpoint returns the checkpoint of the resource type
+func (s *MemoryStore) LoadCheckpoint(resource string) (Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[resource], nil
+}
+
+// SaveCheckpoint replaces the checkpoint of the resource type
+func (s *MemoryStore) SaveCheckpoint(resource string, checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[resource] = checkpoint
+	return nil
+}
+
+// LoadSnapshot returns the last version delivered of a record
+func (s *MemoryStore) LoadSnapshot(resource string, id string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshots[resource+"/"+id], nil
+}
+
+// SaveSnapshot replaces the last version delivered of a record
+func (s *MemoryStore) SaveSnapshot(resource string, id string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[resource+"/"+id] = append([]byte(nil), data...)
+	return nil
+}
+
+// pruneInterval is how often a FileStore with a Retention removes the old
+// snapshots of a resource type
+const pruneInterval = time.Hour
+
+// FileStore keeps checkpoints and snapshots as JSON files in a directory:
+// `<resource>.checkpoint.json` for checkpoints and `<resource>/<id>.json`
+// for snapshots. Files are written to a temporary file and renamed.
+// Checkpoints are synced to disk first, so a crash never loses or truncates
+// one. Snapshots aren't: one lost in a crash only delivers its record again.
+//
+// There is one snapshot per record ever delivered, so without a Retention
+// the directory grows with the number of records.
+type FileStore struct {
+	Dir string
+
+	// Retention is how long the snapshot of a record is kept after the
+	// record last changed. Records changing after their snapshot was
+	// removed are delivered as created again. Zero keeps every snapshot.
+	// A Syncer rejects a Retention which isn't longer than its Overlap,
+	// since every sync would deliver the records of the overlap again.
+	Retention time.Duration
+
+	mu     sync.Mutex
+	pruned map[string]time.Time
+}
+
+// NewFileStore returns a FileStore in dir, creating the directory if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileStore{Dir: dir}, nil
+}
+
+func (s *FileStore) checkpointPath(resource string) string {
+	return filepath.Join(s.Dir, url.PathEscape(resource)+".checkpoint.json")
+}
+
+func (s *FileStore) snapshotPath(resource string, id string) string {
+	return filepath.Join(s.Dir, url.PathEscape(resource), url.PathEscape(id)+".json")
+}
+
+// LoadCheckpoint returns the checkpoint of the resource type
+func (s *FileStore) LoadCheckpoint(resource string) (Checkpoint, error) {
+	var checkpoint Checkpoint
+	data, err := ioutil.ReadFile(s.checkpointPath(resource))
+	if os.IsNotExist(err) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return checkpoint, err
+	}
+	err = json.Unmarshal(data, &checkpoint)
+	return checkpoint, err
+}
+
+// SaveCheckpoint replaces the checkpoint of the resource type
+func (s *FileStore) SaveCheckpoint(resource string, checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := writeFile(s.checkpointPath(resource), data, true); err != nil {
+		return err
+	}
+	return s.prune(resource)
+}
+
+// prune removes the snapshots of the resource type which are older than the
+// Retention, at most once per pruneInterval
+func (s *FileStore) prune(resource string) error {
+	if s.Retention <= 0 {
+		return nil
+	}
+	now := time.Now()
+	s.mu.Lock()
+	if s.pruned == nil {
+		s.pruned = map[string]time.Time{}
+	}
+	if last, ok := s.pruned[resource]; ok && now.Sub(last) < pruneInterval {
+		s.mu.Unlock()
+		return nil
+	}
+	s.pruned[resource] = now
+	s.mu.Unlock()
+
+	dir := filepath.Join(s.Dir, url.PathEscape(resource))
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.IsDir() || now.Sub(file.ModTime()) <= s.Retention {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, file.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadSnapshot returns the last version delivered of a record
+func (s *FileStore) LoadSnapshot(resource string, id string) ([]byte, error) {
+	data, err := ioutil.ReadFile(s.snapshotPath(resource, id))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// SaveSnapshot replaces the last version delivered of a record
+func (s *FileStore) SaveSnapshot(resource string, id string, data []byte) error {
+	path := s.snapshotPath(resource, id)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return writeFile(path, data, false)
+}
+
+// writeFile replaces the file at path through a temporary file. With sync,
+// the file is synced before it is renamed, and the directory after, so the
+// new content is on disk once it returns.
+func writeFile(path string, data []byte, sync bool) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if sync {
+		if err := tmp.Sync(); err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if !sync {
+		return nil
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+// syncDir syncs a directory, so the files renamed into it are on disk.
+// Directories can't be opened for syncing on Windows, so only the file is
+// synced there.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
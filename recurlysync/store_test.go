@@ -0,0 +1,93 @@
+package recurlysync
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func testStore(t *testing.T, store Store) {
+	checkpoint, err := store.LoadCheckpoint("accounts")
+	if err != nil || !checkpoint.Watermark.IsZero() {
+		t.Fatalf("Expected an empty checkpoint, got %v, %v", checkpoint, err)
+	}
+	snapshot, err := store.LoadSnapshot("accounts", "a")
+	if err != nil || snapshot != nil {
+		t.Fatalf("Expected no snapshot, got %s, %v", snapshot, err)
+	}
+
+	watermark := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := store.SaveCheckpoint("accounts", Checkpoint{Watermark: watermark}); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if err := store.SaveSnapshot("accounts", "a/b", []byte(`{"id":"a/b"}`)); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+
+	checkpoint, err = store.LoadCheckpoint("accounts")
+	if err != nil || !checkpoint.Watermark.Equal(watermark) {
+		t.Errorf("Unexpected checkpoint %v, %v", checkpoint, err)
+	}
+	if checkpoint, _ := store.LoadCheckpoint("invoices"); !checkpoint.Watermark.IsZero() {
+		t.Errorf("Expected checkpoints per resource type, got %v", checkpoint)
+	}
+	snapshot, err = store.LoadSnapshot("accounts", "a/b")
+	if err != nil || string(snapshot) != `{"id":"a/b"}` {
+		t.Errorf("Unexpected snapshot %s, %v", snapshot, err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recurlysync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	testStore(t, store)
+
+	// a new store in the same directory picks up where the last one stopped
+	reopened, _ := NewFileStore(dir)
+	if checkpoint, _ := reopened.LoadCheckpoint("accounts"); checkpoint.Watermark.IsZero() {
+		t.Errorf("Expected the checkpoint to be persisted")
+	}
+}
+
+func TestFileStoreRetention(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recurlysync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, _ := NewFileStore(dir)
+	store.Retention = time.Hour
+	for _, id := range []string{"old", "new"} {
+		if err := store.SaveSnapshot("accounts", id, []byte(`{}`)); err != nil {
+			t.Fatalf("Error not expected: %v", err)
+		}
+	}
+	old := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(store.snapshotPath("accounts", "old"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.SaveCheckpoint("accounts", Checkpoint{Watermark: time.Now()}); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if snapshot, _ := store.LoadSnapshot("accounts", "old"); snapshot != nil {
+		t.Errorf("Expected the old snapshot to be removed")
+	}
+	if snapshot, _ := store.LoadSnapshot("accounts", "new"); snapshot == nil {
+		t.Errorf("Expected the recent snapshot to be kept")
+	}
+}
@@ -0,0 +1,341 @@
+// Package recurlysync mirrors Recurly resources incrementally.
+//
+// A Syncer lists the records of a resource type updated since the last sync,
+// oldest first, and delivers a created or updated event for each of them.
+// Progress is kept in a Store: the `updated_at` watermark of each resource
+// type, and the last version delivered of each record, which the next version
+// is compared to.
+//
+// Delivery is at least once. The watermark only moves past a record after its
+// handler succeeded, and every sync starts a little before the watermark to
+// pick up records committed late. Records whose version was already delivered
+// are skipped, but a crash between a handler and the store being updated
+// delivers the record again, so handlers should be idempotent.
+package recurlysync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+// defaultOverlap is how long before the watermark a sync starts by default
+const defaultOverlap = time.Minute
+
+// ErrRetentionTooShort is returned by Sync when the Retention of its
+// FileStore isn't longer than its Overlap
+var ErrRetentionTooShort = errors.New("recurlysync: the retention of the store must be longer than the overlap")
+
+// EventType tells whether a record is new or changed
+type EventType string
+
+const (
+	// EventCreated is delivered for records which were never delivered before
+	EventCreated = EventType("created")
+	// EventUpdated is delivered for new versions of records delivered before
+	EventUpdated = EventType("updated")
+)
+
+// Event is a new version of a record
+type Event struct {
+	// Resource is the name of the resource type, e.g. "accounts"
+	Resource string
+	Type     EventType
+	ID       string
+	// UpdatedAt is the `updated_at` of the record
+	UpdatedAt time.Time
+	// Before is the last version delivered of the record, e.g. a
+	// *recurly.Account. It is nil for created events.
+	Before interface{}
+	// After is the new version of the record
+	After interface{}
+	// Changes lists the top level fields which differ between Before and
+	// After, by their JSON name. It is empty for created events.
+	Changes []Change
+}
+
+// Change is a field which differs between two versions of a record. The values
+// are decoded from JSON: nested objects are maps and numbers are float64.
+type Change struct {
+	Field  string
+	Before interface{}
+	After  interface{}
+}
+
+// Handler processes an event. Returning an error stops the sync before the
+// event is recorded as delivered.
+type Handler func(event Event) error
+
+// record is a single record of a source
+type record struct {
+	value     interface{}
+	id        string
+	updatedAt time.Time
+}
+
+// records pages through the records of a source
+type records struct {
+	next   func() bool
+	record func() record
+	err    func() error
+	page   func() int
+	close  func()
+}
+
+// Source is a resource type which can be synced
+type Source struct {
+	// Name identifies the resource type in the store
+	Name string
+
+	list   func(client *recurly.Client, ctx context.Context, begin *time.Time) records
+	decode func(data []byte) (interface{}, error)
+}
+
+// Accounts syncs accounts
+var Accounts = Source{
+	Name: "accounts",
+	list: func(client *recurly.Client, ctx context.Context, begin *time.Time) records {
+		params := &recurly.ListAccountsParams{
+			Sort:      recurly.String("updated_at"),
+			Order:     recurly.String("asc"),
+			BeginTime: begin,
+		}
+		params.Context = ctx
+		list := client.ListAccounts(params)
+		return records{
+			next: list.Next,
+			record: func() record {
+				item := list.Item()
+				return record{item, item.Id, item.UpdatedAt.Time}
+			},
+			err:   list.Err,
+			page:  list.Page,
+			close: list.Close,
+		}
+	},
+	decode: func(data []byte) (interface{}, error) {
+		v := &recurly.Account{}
+		return v, json.Unmarshal(data, v)
+	},
+}
+
+// Subscriptions syncs subscriptions
+var Subscriptions = Source{
+	Name: "subscriptions",
+	list: func(client *recurly.Client, ctx context.Context, begin *time.Time) records {
+		params := &recurly.ListSubscriptionsParams{
+			Sort:      recurly.String("updated_at"),
+			Order:     recurly.String("asc"),
+			BeginTime: begin,
+		}
+		params.Context = ctx
+		list := client.ListSubscriptions(params)
+		return records{
+			next: list.Next,
+			record: func() record {
+				item := list.Item()
+				return record{item, item.Id, item.UpdatedAt.Time}
+			},
+			err:   list.Err,
+			page:  list.Page,
+			close: list.Close,
+		}
+	},
+	decode: func(data []byte) (interface{}, error) {
+		v := &recurly.Subscription{}
+		return v, json.Unmarshal(data, v)
+	},
+}
+
+// Invoices syncs invoices
+var Invoices = Source{
+	Name: "invoices",
+	list: func(client *recurly.Client, ctx context.Context, begin *time.Time) records {
+		params := &recurly.ListInvoicesParams{
+			Sort:      recurly.String("updated_at"),
+			Order:     recurly.String("asc"),
+			BeginTime: begin,
+		}
+		params.Context = ctx
+		list := client.ListInvoices(params)
+		return records{
+			next: list.Next,
+			record: func() record {
+				item := list.Item()
+				return record{item, item.Id, item.UpdatedAt.Time}
+			},
+			err:   list.Err,
+			page:  list.Page,
+			close: list.Close,
+		}
+	},
+	decode: func(data []byte) (interface{}, error) {
+		v := &recurly.Invoice{}
+		return v, json.Unmarshal(data, v)
+	},
+}
+
+// Syncer delivers the records updated since the last sync
+type Syncer struct {
+	Client *recurly.Client
+	Store  Store
+
+	// Overlap is how long before the watermark each sync starts, to pick up
+	// records committed late, with an `updated_at` before the watermark.
+	// It defaults to a minute, and must be shorter than the Retention of a
+	// FileStore.
+	Overlap time.Duration
+}
+
+// New returns a Syncer which keeps its progress in store
+func New(client *recurly.Client, store Store) *Syncer {
+	return &Syncer{
+		Client:  client,
+		Store:   store,
+		Overlap: defaultOverlap,
+	}
+}
+
+// Sync delivers an event for every record of the source updated since the
+// last sync, oldest first. It returns the first error of the handler, the API
+// or the store, or ErrRetentionTooShort. The next sync starts from the last
+// event delivered.
+func (s *Syncer) Sync(ctx context.Context, source Source, handler Handler) error {
+	overlap := s.Overlap
+	if overlap <= 0 {
+		overlap = defaultOverlap
+	}
+	if store, ok := s.Store.(*FileStore); ok && store.Retention > 0 && store.Retention <= overlap {
+		return ErrRetentionTooShort
+	}
+	checkpoint, err := s.Store.LoadCheckpoint(source.Name)
+	if err != nil {
+		return err
+	}
+	var begin *time.Time
+	if !checkpoint.Watermark.IsZero() {
+		t := checkpoint.Watermark.Add(-overlap)
+		begin = &t
+	}
+
+	list := source.list(s.Client, ctx, begin)
+	defer list.close()
+
+	// The checkpoint is saved once per page, and when the sync ends
+	page := 0
+	saved := checkpoint
+	save := func() error {
+		if checkpoint == saved {
+			return nil
+		}
+		if err := s.Store.SaveCheckpoint(source.Name, checkpoint); err != nil {
+			return err
+		}
+		saved = checkpoint
+		return nil
+	}
+
+	for list.next() {
+		if list.page() != page {
+			if err := save(); err != nil {
+				return err
+			}
+			page = list.page()
+		}
+
+		delivered, err := s.deliver(source, list.record(), handler)
+		if err != nil {
+			save()
+			return err
+		}
+		if delivered.After(checkpoint.Watermark) {
+			checkpoint.Watermark = delivered
+		}
+	}
+	if err := list.err(); err != nil {
+		save()
+		return err
+	}
+	return save()
+}
+
+// deliver sends the event for a record unless its version was already
+// delivered, and returns the `updated_at` of the record
+func (s *Syncer) deliver(source Source, rec record, handler Handler) (time.Time, error) {
+	after, err := json.Marshal(rec.value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	before, err := s.Store.LoadSnapshot(source.Name, rec.id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	event := Event{
+		Resource:  source.Name,
+		Type:      EventCreated,
+		ID:        rec.id,
+		UpdatedAt: rec.updatedAt,
+		After:     rec.value,
+	}
+	if before != nil {
+		changes, err := diff(before, after)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if len(changes) == 0 {
+			// this version was already delivered
+			return rec.updatedAt, nil
+		}
+		event.Type = EventUpdated
+		event.Changes = changes
+		if event.Before, err = source.decode(before); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	if err := handler(event); err != nil {
+		return time.Time{}, err
+	}
+	if err := s.Store.SaveSnapshot(source.Name, rec.id, after); err != nil {
+		return time.Time{}, err
+	}
+	return rec.updatedAt, nil
+}
+
+// diff compares the top level fields of two JSON objects
+func diff(before []byte, after []byte) ([]Change, error) {
+	var b, a map[string]interface{}
+	if err := json.Unmarshal(before, &b); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(after, &a); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]bool{}
+	for field := range b {
+		fields[field] = true
+	}
+	for field := range a {
+		fields[field] = true
+	}
+	var names []string
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	var changes []Change
+	for _, field := range names {
+		if !reflect.DeepEqual(b[field], a[field]) {
+			changes = append(changes, Change{Field: field, Before: b[field], After: a[field]})
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,200 @@
+package recurlysync
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/recurly/recurly-client-go/v3"
+	"github.com/recurly/recurly-client-go/v3/recurlytest"
+)
+
+var syncTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func account(id string, email string, updated time.Duration) *recurly.Account {
+	return &recurly.Account{Id: id, Email: email, UpdatedAt: recurly.NewNullTime(syncTime.Add(updated))}
+}
+
+func collect(events *[]Event) Handler {
+	return func(event Event) error {
+		*events = append(*events, event)
+		return nil
+	}
+}
+
+func TestSyncDeliversCreatedAndUpdatedEvents(t *testing.T) {
+	mock := recurlytest.NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/accounts",
+		recurlytest.RespondWithList([]*recurly.Account{
+			account("a", "a@example.com", 0),
+			account("b", "b@example.com", time.Minute),
+		}, "").Assert(func(req *http.Request) {
+			query := req.URL.Query()
+			if query.Get("sort") != "updated_at" || query.Get("order") != "asc" {
+				t.Errorf("Expected accounts sorted by updated_at, got %v", req.URL)
+			}
+			if query.Get("begin_time") != "" {
+				t.Errorf("Expected no begin_time on the first sync, got %v", req.URL)
+			}
+		}),
+		recurlytest.RespondWithList([]*recurly.Account{
+			account("b", "b@example.com", time.Minute),
+			account("a", "new@example.com", 2*time.Minute),
+		}, "").Assert(func(req *http.Request) {
+			if begin := req.URL.Query().Get("begin_time"); begin != "2020-01-01T00:00:00Z" {
+				t.Errorf("Expected begin_time a minute before the watermark, got %v", begin)
+			}
+		}),
+	)
+	store := NewMemoryStore()
+	syncer := New(mock.Client(), store)
+
+	var events []Event
+	if err := syncer.Sync(context.Background(), Accounts, collect(&events)); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if len(events) != 2 || events[0].Type != EventCreated || events[1].Type != EventCreated {
+		t.Fatalf("Expected two created events, got %+v", events)
+	}
+	if events[0].ID != "a" || events[0].Resource != "accounts" || events[0].Before != nil {
+		t.Errorf("Unexpected event %+v", events[0])
+	}
+	checkpoint, _ := store.LoadCheckpoint("accounts")
+	if !checkpoint.Watermark.Equal(syncTime.Add(time.Minute)) {
+		t.Errorf("Unexpected watermark %v", checkpoint.Watermark)
+	}
+
+	events = nil
+	if err := syncer.Sync(context.Background(), Accounts, collect(&events)); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	// b was already delivered at this version
+	if len(events) != 1 || events[0].Type != EventUpdated || events[0].ID != "a" {
+		t.Fatalf("Expected an updated event for a, got %+v", events)
+	}
+	before, ok := events[0].Before.(*recurly.Account)
+	if !ok || before.Email != "a@example.com" {
+		t.Errorf("Unexpected Before %+v", events[0].Before)
+	}
+	if events[0].After.(*recurly.Account).Email != "new@example.com" {
+		t.Errorf("Unexpected After %+v", events[0].After)
+	}
+	var fields []string
+	for _, change := range events[0].Changes {
+		fields = append(fields, change.Field)
+	}
+	if len(fields) != 2 || fields[0] != "email" || fields[1] != "updated_at" {
+		t.Errorf("Unexpected changes %v", fields)
+	}
+	if events[0].Changes[0].Before != "a@example.com" || events[0].Changes[0].After != "new@example.com" {
+		t.Errorf("Unexpected change %+v", events[0].Changes[0])
+	}
+	mock.AssertExhausted()
+}
+
+func TestSyncRedeliversAfterHandlerError(t *testing.T) {
+	page := []*recurly.Account{
+		account("a", "a@example.com", 0),
+		account("b", "b@example.com", time.Minute),
+	}
+	mock := recurlytest.NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/accounts",
+		recurlytest.RespondWithList(page, ""),
+		recurlytest.RespondWithList(page, ""),
+	)
+	store := NewMemoryStore()
+	syncer := New(mock.Client(), store)
+
+	failure := errors.New("handler failed")
+	var events []Event
+	err := syncer.Sync(context.Background(), Accounts, func(event Event) error {
+		if event.ID == "b" {
+			return failure
+		}
+		events = append(events, event)
+		return nil
+	})
+	if err != failure {
+		t.Fatalf("Expected the handler error, got %v", err)
+	}
+	checkpoint, _ := store.LoadCheckpoint("accounts")
+	if !checkpoint.Watermark.Equal(syncTime) {
+		t.Errorf("Expected the watermark to stop at a, got %v", checkpoint.Watermark)
+	}
+
+	events = nil
+	if err := syncer.Sync(context.Background(), Accounts, collect(&events)); err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if len(events) != 1 || events[0].ID != "b" || events[0].Type != EventCreated {
+		t.Errorf("Expected b to be delivered again, got %+v", events)
+	}
+	mock.AssertExhausted()
+}
+
+func TestSyncSavesCheckpointPerPage(t *testing.T) {
+	mock := recurlytest.NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/accounts",
+		recurlytest.RespondWithList([]*recurly.Account{account("a", "a@example.com", 0)}, "/accounts?cursor=2"),
+		recurlytest.RespondWithError(recurly.ErrorTypeInternalServer, "Oops"),
+	)
+	store := NewMemoryStore()
+	syncer := New(mock.Client(), store)
+
+	var events []Event
+	err := syncer.Sync(context.Background(), Accounts, collect(&events))
+	if _, ok := err.(*recurly.Error); !ok {
+		t.Fatalf("Expected *recurly.Error, got %v", err)
+	}
+	checkpoint, _ := store.LoadCheckpoint("accounts")
+	if !checkpoint.Watermark.Equal(syncTime) {
+		t.Errorf("Expected the watermark of the first page, got %v", checkpoint.Watermark)
+	}
+}
+
+func TestSyncSubscriptionsAndInvoices(t *testing.T) {
+	mock := recurlytest.NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/subscriptions", recurlytest.RespondWithList([]*recurly.Subscription{
+		{Id: "sub", UpdatedAt: recurly.NewNullTime(syncTime)},
+	}, ""))
+	mock.Queue(http.MethodGet, "/invoices", recurlytest.RespondWithList([]*recurly.Invoice{
+		{Id: "inv", UpdatedAt: recurly.NewNullTime(syncTime)},
+	}, ""))
+	syncer := New(mock.Client(), NewMemoryStore())
+
+	var events []Event
+	for _, source := range []Source{Subscriptions, Invoices} {
+		if err := syncer.Sync(context.Background(), source, collect(&events)); err != nil {
+			t.Fatalf("Error not expected: %v", err)
+		}
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events, got %+v", events)
+	}
+	if _, ok := events[0].After.(*recurly.Subscription); !ok || events[0].Resource != "subscriptions" {
+		t.Errorf("Unexpected event %+v", events[0])
+	}
+	if _, ok := events[1].After.(*recurly.Invoice); !ok || events[1].Resource != "invoices" {
+		t.Errorf("Unexpected event %+v", events[1])
+	}
+}
+
+func TestSyncRejectsRetentionWithinOverlap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recurlysync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, _ := NewFileStore(dir)
+	store.Retention = 30 * time.Second
+	syncer := New(recurlytest.NewMockTransport(t).Client(), store)
+	err = syncer.Sync(context.Background(), Accounts, collect(&[]Event{}))
+	if err != ErrRetentionTooShort {
+		t.Errorf("Expected ErrRetentionTooShort, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
+package recurlytest
+
+import (
+	"reflect"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+// enumValues are the first value of every enum type of the fields of
+// resources and requests, which Populate sets their fields to
+var enumValues = map[reflect.Type]string{
+	reflect.TypeOf(recurly.AccountAcquisitionChannel("")):         "referral",
+	reflect.TypeOf(recurly.AccountState("")):                      "active",
+	reflect.TypeOf(recurly.AccountTransactionType("")):            "moto",
+	reflect.TypeOf(recurly.AccountType("")):                       "checking",
+	reflect.TypeOf(recurly.AddOnState("")):                        "active",
+	reflect.TypeOf(recurly.AvsCheck("")):                          "A",
+	reflect.TypeOf(recurly.BillTo("")):                            "self",
+	reflect.TypeOf(recurly.BillingAddressRequirement("")):         "full",
+	reflect.TypeOf(recurly.BillingInfoTransactionType("")):        "moto",
+	reflect.TypeOf(recurly.CardType("")):                          "American Express",
+	reflect.TypeOf(recurly.CollectionMethod("")):                  "automatic",
+	reflect.TypeOf(recurly.CouponDiscountTrialUnit("")):           "day",
+	reflect.TypeOf(recurly.CouponDiscountType("")):                "percent",
+	reflect.TypeOf(recurly.CouponDuration("")):                    "forever",
+	reflect.TypeOf(recurly.CouponRedemptionState("")):             "active",
+	reflect.TypeOf(recurly.CouponState("")):                       "redeemable",
+	reflect.TypeOf(recurly.CouponType("")):                        "single_code",
+	reflect.TypeOf(recurly.CreditPaymentAction("")):               "payment",
+	reflect.TypeOf(recurly.CreditReasonCode("")):                  "general",
+	reflect.TypeOf(recurly.CvvCheck("")):                          "D",
+	reflect.TypeOf(recurly.DiscountType("")):                      "percent",
+	reflect.TypeOf(recurly.ExternalRefundPaymentMethod("")):       "credit_card",
+	reflect.TypeOf(recurly.FraudInfoDecision("")):                 "approve",
+	reflect.TypeOf(recurly.FreeTrialUnit("")):                     "day",
+	reflect.TypeOf(recurly.IntervalUnit("")):                      "days",
+	reflect.TypeOf(recurly.InvoiceCollectTransactionType("")):     "moto",
+	reflect.TypeOf(recurly.InvoiceOrigin("")):                     "purchase",
+	reflect.TypeOf(recurly.InvoiceRefundType("")):                 "amount",
+	reflect.TypeOf(recurly.InvoiceState("")):                      "open",
+	reflect.TypeOf(recurly.InvoiceType("")):                       "charge",
+	reflect.TypeOf(recurly.ItemState("")):                         "active",
+	reflect.TypeOf(recurly.LegacyCategory("")):                    "charge",
+	reflect.TypeOf(recurly.LineItemCreateOrigin("")):              "external_gift_card",
+	reflect.TypeOf(recurly.LineItemOrigin("")):                    "plan",
+	reflect.TypeOf(recurly.LineItemState("")):                     "pending",
+	reflect.TypeOf(recurly.LineItemType("")):                      "charge",
+	reflect.TypeOf(recurly.PaymentMethodObject("")):               "credit_card",
+	reflect.TypeOf(recurly.PlanState("")):                         "active",
+	reflect.TypeOf(recurly.PreferredLocale("")):                   "da-DK",
+	reflect.TypeOf(recurly.PurchaseTransactionType("")):           "moto",
+	reflect.TypeOf(recurly.RedemptionResource("")):                "account",
+	reflect.TypeOf(recurly.RefundMethod("")):                      "transaction_first",
+	reflect.TypeOf(recurly.RelatedType("")):                       "account",
+	reflect.TypeOf(recurly.RevenueScheduleType("")):               "never",
+	reflect.TypeOf(recurly.SetupFeeRevenueScheduleType("")):       "never",
+	reflect.TypeOf(recurly.SiteFeature("")):                       "credit_memos",
+	reflect.TypeOf(recurly.SiteMode("")):                          "development",
+	reflect.TypeOf(recurly.SubscriptionChangeTimeframe("")):       "now",
+	reflect.TypeOf(recurly.SubscriptionChangeTransactionType("")): "moto",
+	reflect.TypeOf(recurly.SubscriptionState("")):                 "active",
+	reflect.TypeOf(recurly.SubscriptionTimeframe("")):             "now",
+	reflect.TypeOf(recurly.SubscriptionTransactionType("")):       "moto",
+	reflect.TypeOf(recurly.TemporalUnit("")):                      "day",
+	reflect.TypeOf(recurly.TransactionOrigin("")):                 "api",
+	reflect.TypeOf(recurly.TransactionStatus("")):                 "pending",
+	reflect.TypeOf(recurly.TransactionType("")):                   "authorization",
+	reflect.TypeOf(recurly.TrialUnit("")):                         "days",
+	reflect.TypeOf(recurly.UniqueCouponCodeState("")):             "redeemable",
+	reflect.TypeOf(recurly.UserAccess("")):                        "api_only",
+}
@@ -0,0 +1,205 @@
+package recurlytest
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+// FixtureTime is the timestamp used for every time field of a fixture
+var FixtureTime = time.Date(2020, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// maxFixtureDepth limits how deep Populate descends into nested resources
+const maxFixtureDepth = 5
+
+// Populate fills every exported field of the struct pointed to by v with a
+// deterministic, non-zero value:
+//
+//	strings are set to the field's JSON name, and enums to their first value
+//	numbers are set to 1
+//	booleans are set to true
+//	times are set to FixtureTime
+//	slices and maps get a single populated element
+//	pointers and nested structs are allocated and populated
+func Populate(v interface{}) {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		panic("recurlytest: Populate requires a non-nil pointer")
+	}
+	populate(value.Elem(), "value", 0)
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	nullTimeType = reflect.TypeOf(recurly.NullTime{})
+)
+
+func populate(value reflect.Value, name string, depth int) {
+	if depth > maxFixtureDepth {
+		return
+	}
+	if value.Type() == timeType {
+		value.Set(reflect.ValueOf(FixtureTime))
+		return
+	}
+	if value.Type() == nullTimeType {
+		value.Set(reflect.ValueOf(recurly.NewNullTime(FixtureTime)))
+		return
+	}
+	if enum, ok := enumValues[value.Type()]; ok {
+		value.SetString(enum)
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(name)
+	case reflect.Bool:
+		value.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		value.SetFloat(1)
+	case reflect.Ptr:
+		elem := reflect.New(value.Type().Elem())
+		populate(elem.Elem(), name, depth+1)
+		value.Set(elem)
+	case reflect.Slice:
+		slice := reflect.MakeSlice(value.Type(), 1, 1)
+		populate(slice.Index(0), name, depth+1)
+		value.Set(slice)
+	case reflect.Map:
+		m := reflect.MakeMap(value.Type())
+		key := reflect.New(value.Type().Key()).Elem()
+		elem := reflect.New(value.Type().Elem()).Elem()
+		populate(key, "key", depth+1)
+		populate(elem, name, depth+1)
+		m.SetMapIndex(key, elem)
+		value.Set(m)
+	case reflect.Interface:
+		if value.NumMethod() == 0 {
+			value.Set(reflect.ValueOf(name))
+		}
+	case reflect.Struct:
+		structType := value.Type()
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			if field.PkgPath != "" || field.Anonymous {
+				// skip unexported fields and embedded request Params
+				continue
+			}
+			populate(value.Field(i), jsonName(field), depth+1)
+		}
+	}
+}
+
+// jsonName returns the name a struct field is encoded as
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+// Account returns a fully populated account
+func Account() *recurly.Account {
+	account := &recurly.Account{}
+	Populate(account)
+	account.Id = "abcd1234"
+	account.Object = "account"
+	account.State = "active"
+	account.Code = "account-code"
+	return account
+}
+
+// BillingInfo returns fully populated billing info
+func BillingInfo() *recurly.BillingInfo {
+	billingInfo := &recurly.BillingInfo{}
+	Populate(billingInfo)
+	billingInfo.Id = "bi1234"
+	billingInfo.Object = "billing_info"
+	billingInfo.AccountId = "abcd1234"
+	return billingInfo
+}
+
+// Subscription returns a fully populated subscription
+func Subscription() *recurly.Subscription {
+	subscription := &recurly.Subscription{}
+	Populate(subscription)
+	subscription.Id = "sub1234"
+	subscription.Object = "subscription"
+	subscription.State = "active"
+	subscription.Currency = "USD"
+	return subscription
+}
+
+// Plan returns a fully populated plan
+func Plan() *recurly.Plan {
+	plan := &recurly.Plan{}
+	Populate(plan)
+	plan.Id = "plan1234"
+	plan.Object = "plan"
+	plan.Code = "plan-code"
+	plan.State = "active"
+	return plan
+}
+
+// Item returns a fully populated item
+func Item() *recurly.Item {
+	item := &recurly.Item{}
+	Populate(item)
+	item.Id = "item1234"
+	item.Object = "item"
+	item.Code = "item-code"
+	item.State = "active"
+	return item
+}
+
+// Coupon returns a fully populated coupon
+func Coupon() *recurly.Coupon {
+	coupon := &recurly.Coupon{}
+	Populate(coupon)
+	coupon.Id = "coupon1234"
+	coupon.Object = "coupon"
+	coupon.Code = "coupon-code"
+	coupon.State = "redeemable"
+	return coupon
+}
+
+// Invoice returns a fully populated invoice
+func Invoice() *recurly.Invoice {
+	invoice := &recurly.Invoice{}
+	Populate(invoice)
+	invoice.Id = "invoice1234"
+	invoice.Object = "invoice"
+	invoice.State = "paid"
+	invoice.Currency = "USD"
+	return invoice
+}
+
+// LineItem returns a fully populated line item
+func LineItem() *recurly.LineItem {
+	lineItem := &recurly.LineItem{}
+	Populate(lineItem)
+	lineItem.Id = "lineitem1234"
+	lineItem.Object = "line_item"
+	lineItem.State = "invoiced"
+	lineItem.Currency = "USD"
+	return lineItem
+}
+
+// Transaction returns a fully populated transaction
+func Transaction() *recurly.Transaction {
+	transaction := &recurly.Transaction{}
+	Populate(transaction)
+	transaction.Id = "transaction1234"
+	transaction.Object = "transaction"
+	transaction.Status = "success"
+	transaction.Currency = "USD"
+	return transaction
+}
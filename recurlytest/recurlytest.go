@@ -0,0 +1,105 @@
+// Package recurlytest provides helpers for testing code that uses the Recurly client
+// without talking to the Recurly API.
+//
+// A Scenario mocks a single request/response exchange, a MockTransport scripts
+// responses for any number of operations, and the fixture helpers build fully
+// populated resources to return from either of them.
+package recurlytest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+// roundTripFunc is a function used to mock the transport barrier of http.Client
+type roundTripFunc func(req *http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+// A testing "Scenario" is composed of 2 functions:
+//
+//	AssertRequest: asserts the correct request properties are being sent to the transport layer
+//	MakeResponse: returns a canned response to test the response handling code
+type Scenario struct {
+	T             testing.TB
+	AssertRequest func(req *http.Request)
+	MakeResponse  func(req *http.Request) *http.Response
+}
+
+// MockHTTPClient returns a *recurly.Client which implements the testing scenario
+func (s *Scenario) MockHTTPClient() *recurly.Client {
+	return NewClient(roundTripFunc(func(req *http.Request) *http.Response {
+		// Check the request has the expected properties
+		if s.AssertRequest != nil {
+			s.AssertRequest(req)
+		}
+		if s.T != nil {
+			AssertDefaultHeaders(s.T, req)
+		}
+
+		// Return the canned Response
+		return s.MakeResponse(req)
+	}))
+}
+
+// NewClient returns a *recurly.Client which sends every request through the given transport
+func NewClient(transport http.RoundTripper) *recurly.Client {
+	client := recurly.NewClient("APIKEY")
+	client.HTTPClient = &http.Client{
+		Transport: transport,
+	}
+	// override the logger to keep noise down
+	client.Log = recurly.NewLogger(recurly.LevelWarn)
+	return client
+}
+
+// AssertDefaultHeaders asserts the headers the client sets on every request
+func AssertDefaultHeaders(t testing.TB, req *http.Request) {
+	t.Helper()
+	expected := map[string]string{
+		"Accept":          "application/vnd.recurly." + recurly.APIVersion,
+		"Accept-Encoding": "gzip",
+		"Content-Type":    "application/json; charset=utf-8",
+	}
+	for name, value := range expected {
+		if got := req.Header.Get(name); got != value {
+			t.Errorf("Request Header %q is incorrect. Expected: %v Got: %v", name, value, got)
+		}
+	}
+}
+
+// MockResponse creates an http.Response with the default Recurly headers
+func MockResponse(req *http.Request, statusCode int, body string) *http.Response {
+	headers := make(http.Header)
+	headers.Add("Content-Type", "application/json; charset=utf-8")
+	headers.Add("Recurly-Version", "recurly."+recurly.APIVersion)
+	headers.Add("X-RateLimit-Limit", "2000")
+	headers.Add("X-RateLimit-Remaining", "1999")
+	headers.Add("X-RateLimit-Reset", "1586203320")
+	headers.Add("X-Request-Id", "msy-1234")
+	headers.Add("Recurly-Total-Records", "100")
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		Header:     headers,
+		Request:    req,
+	}
+}
+
+// RequestBody reads the body of the request and returns it as a string. The body
+// is replaced so it can be read again.
+func RequestBody(req *http.Request) string {
+	if req.Body == nil {
+		return ""
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return string(body)
+}
@@ -0,0 +1,222 @@
+package recurlytest
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+func TestScenario(t *testing.T) {
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			if req.URL.String() != "https://v3.recurly.com/accounts/abcd1234" {
+				t.Errorf("Request URL is incorrect. Got: %v", req.URL)
+			}
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			return MockResponse(req, 200, `{"id": "abcd1234"}`)
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	account, err := client.GetAccount("abcd1234")
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if account.Id != "abcd1234" {
+		t.Errorf("account.Id is incorrect. Got: %v", account.Id)
+	}
+}
+
+func TestScenarioWithoutT(t *testing.T) {
+	scenario := &Scenario{
+		MakeResponse: func(req *http.Request) *http.Response {
+			return MockResponse(req, 200, `{"id": "abcd1234"}`)
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	account, err := client.GetAccount("abcd1234")
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if account.Id != "abcd1234" {
+		t.Errorf("account.Id is incorrect. Got: %v", account.Id)
+	}
+}
+
+func TestMockTransportWithoutT(t *testing.T) {
+	mock := &MockTransport{}
+	mock.Queue(http.MethodGet, "/accounts/{account_id}", Respond(http.StatusOK, `{"id": "abcd1234"}`))
+	client := mock.Client()
+
+	account, err := client.GetAccount("abcd1234")
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if account.Id != "abcd1234" {
+		t.Errorf("account.Id is incorrect. Got: %v", account.Id)
+	}
+	if _, err := client.GetAccount("abcd1234"); err == nil {
+		t.Errorf("Expected an error without a queued response")
+	}
+	mock.AssertExhausted()
+}
+
+func TestMockTransportQueuesResponsesPerOperation(t *testing.T) {
+	mock := NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/accounts/{account_id}",
+		Respond(200, `{"id": "first"}`),
+		Respond(200, `{"id": "second"}`),
+	)
+	mock.Queue(http.MethodPost, "/accounts", Respond(201, `{"id": "created"}`).Assert(func(req *http.Request) {
+		if body := RequestBody(req); body != `{"code":"new_account"}` {
+			t.Errorf("Request Body is incorrect. Got: %v", body)
+		}
+	}))
+	client := mock.Client()
+
+	created, err := client.CreateAccount(&recurly.AccountCreate{Code: recurly.String("new_account")})
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	first, _ := client.GetAccount("abcd1234")
+	second, _ := client.GetAccount("efgh5678")
+
+	if created.Id != "created" || first.Id != "first" || second.Id != "second" {
+		t.Errorf("Responses returned out of order: %v, %v, %v", created.Id, first.Id, second.Id)
+	}
+	if len(mock.Requests()) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(mock.Requests()))
+	}
+	if mock.Requests()[2].URL.Path != "/accounts/efgh5678" {
+		t.Errorf("Unexpected request path %v", mock.Requests()[2].URL.Path)
+	}
+	mock.AssertExhausted()
+}
+
+func TestMockTransportMatchesTemplatedSuffix(t *testing.T) {
+	op := newOperation(http.MethodGet, "/invoices/{invoice_id}.pdf")
+	req, _ := http.NewRequest(http.MethodGet, "https://v3.recurly.com/invoices/abcd1234.pdf", nil)
+	if !op.matches(req) {
+		t.Error("Expected templated segment with suffix to match")
+	}
+	req, _ = http.NewRequest(http.MethodGet, "https://v3.recurly.com/invoices/abcd1234", nil)
+	if op.matches(req) {
+		t.Error("Expected segment without suffix not to match")
+	}
+}
+
+func TestRespondWithError(t *testing.T) {
+	mock := NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/accounts/{account_id}",
+		RespondWithError(recurly.ErrorTypeNotFound, "Couldn't find Account", recurly.ErrorParam{Property: "account_id", Message: "not found"}),
+	)
+	mock.Queue(http.MethodPost, "/purchases",
+		RespondWithTransactionError("Declined", &recurly.TransactionError{Category: recurly.TransactionErrorCategorySoft}),
+	)
+	client := mock.Client()
+
+	_, err := client.GetAccount("abcd1234")
+	e, ok := err.(*recurly.Error)
+	if !ok {
+		t.Fatalf("Expected *recurly.Error, got %T", err)
+	}
+	if e.Type != recurly.ErrorTypeNotFound || e.Message != "Couldn't find Account" || len(e.Params) != 1 {
+		t.Errorf("Unexpected error %+v", e)
+	}
+	if e.GetResponse().StatusCode != 404 {
+		t.Errorf("Expected status 404, got %d", e.GetResponse().StatusCode)
+	}
+
+	_, err = client.CreatePurchase(&recurly.PurchaseCreate{})
+	e, ok = err.(*recurly.Error)
+	if !ok {
+		t.Fatalf("Expected *recurly.Error, got %T", err)
+	}
+	if e.Type != recurly.ErrorTypeTransaction || e.TransactionError.Category != recurly.TransactionErrorCategorySoft {
+		t.Errorf("Unexpected error %+v", e)
+	}
+}
+
+func TestRespondWithList(t *testing.T) {
+	mock := NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/accounts",
+		RespondWithList([]*recurly.Account{Account()}, "/accounts?cursor=next"),
+		RespondWithList([]*recurly.Account{Account()}, ""),
+	)
+	client := mock.Client()
+
+	accounts := client.ListAccounts(nil)
+	pages := 0
+	for accounts.HasMore {
+		if err := accounts.Fetch(); err != nil {
+			t.Fatalf("Error not expected: %v", err)
+		}
+		pages++
+		if accounts.Data[0].Id != "abcd1234" {
+			t.Errorf("Unexpected account %v", accounts.Data[0].Id)
+		}
+	}
+	if pages != 2 {
+		t.Errorf("Expected 2 pages, got %d", pages)
+	}
+	if query := mock.Requests()[1].URL.RawQuery; query != "cursor=next" {
+		t.Errorf("Unexpected next page query %v", query)
+	}
+	mock.AssertExhausted()
+}
+
+func TestFixturesArePopulated(t *testing.T) {
+	fixtures := []interface{}{
+		Account(), BillingInfo(), Subscription(), Plan(), Item(), Coupon(), Invoice(), LineItem(), Transaction(),
+	}
+	for _, fixture := range fixtures {
+		value := reflect.ValueOf(fixture).Elem()
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			if reflect.DeepEqual(value.Field(i).Interface(), reflect.Zero(field.Type).Interface()) {
+				t.Errorf("%s.%s is not populated", value.Type().Name(), field.Name)
+			}
+		}
+	}
+}
+
+func TestFixtureRoundTripsThroughClient(t *testing.T) {
+	mock := NewMockTransport(t)
+	mock.Queue(http.MethodGet, "/subscriptions/{subscription_id}", RespondWithResource(200, Subscription()))
+	client := mock.Client()
+
+	subscription, err := client.GetSubscription("sub1234")
+	if err != nil {
+		t.Fatalf("Error not expected: %v", err)
+	}
+	if subscription.Plan.Code != "code" || !subscription.CreatedAt.Equal(FixtureTime) {
+		t.Errorf("Unexpected subscription %+v", subscription)
+	}
+}
+
+func TestFixtureEnumsAreKnown(t *testing.T) {
+	billingInfo := BillingInfo()
+	if !billingInfo.PaymentMethod.Object.IsKnown() {
+		t.Errorf("PaymentMethod.Object is not known: %q", billingInfo.PaymentMethod.Object)
+	}
+	if !billingInfo.PaymentMethod.CardType.IsKnown() {
+		t.Errorf("PaymentMethod.CardType is not known: %q", billingInfo.PaymentMethod.CardType)
+	}
+	if _, ok := billingInfo.PaymentMethod.Variant().(*recurly.CardPaymentMethod); !ok {
+		t.Errorf("Expected a *CardPaymentMethod, got %T", billingInfo.PaymentMethod.Variant())
+	}
+
+	request := &recurly.AccountCreate{}
+	Populate(request)
+	if !request.PreferredLocale.IsKnown() {
+		t.Errorf("AccountCreate.PreferredLocale is not known: %q", *request.PreferredLocale)
+	}
+}
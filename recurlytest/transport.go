@@ -0,0 +1,314 @@
+package recurlytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/recurly/recurly-client-go/v3"
+)
+
+// Response is a canned response queued on a MockTransport
+type Response struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Body is the raw response body
+	Body string
+	// Header contains headers added to (or replacing) the default Recurly headers
+	Header http.Header
+	// AssertRequest, if set, is called with the request this response answers
+	AssertRequest func(req *http.Request)
+}
+
+// WithHeader sets a response header and returns the response
+func (r *Response) WithHeader(key string, value string) *Response {
+	if r.Header == nil {
+		r.Header = make(http.Header)
+	}
+	r.Header.Set(key, value)
+	return r
+}
+
+// Assert sets the function used to assert the request this response answers
+func (r *Response) Assert(assert func(req *http.Request)) *Response {
+	r.AssertRequest = assert
+	return r
+}
+
+// Respond creates a response with the given status code and raw body
+func Respond(statusCode int, body string) *Response {
+	return &Response{StatusCode: statusCode, Body: body}
+}
+
+// RespondWithResource creates a response whose body is the JSON encoding of v
+func RespondWithResource(statusCode int, v interface{}) *Response {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("recurlytest: cannot encode resource: %v", err))
+	}
+	return Respond(statusCode, string(body))
+}
+
+// RespondWithList creates a 200 response containing a page of a list. data must
+// be a slice of resources. When next is not empty, the page reports that more
+// results are available at that path.
+func RespondWithList(data interface{}, next string) *Response {
+	page := struct {
+		Object  string      `json:"object"`
+		HasMore bool        `json:"has_more"`
+		Next    string      `json:"next"`
+		Data    interface{} `json:"data"`
+	}{
+		Object:  "list",
+		HasMore: next != "",
+		Next:    next,
+		Data:    data,
+	}
+	return RespondWithResource(http.StatusOK, page)
+}
+
+// RespondWithError creates a response containing an error of the given type. The
+// status code is the one the API uses for that type.
+func RespondWithError(errType recurly.ErrorType, message string, params ...recurly.ErrorParam) *Response {
+	return RespondWithResource(ErrorStatus(errType), errorBody(errType, message, params, nil))
+}
+
+// RespondWithTransactionError creates a 422 response for a failed transaction
+func RespondWithTransactionError(message string, transactionError *recurly.TransactionError) *Response {
+	return RespondWithResource(ErrorStatus(recurly.ErrorTypeTransaction), errorBody(recurly.ErrorTypeTransaction, message, nil, transactionError))
+}
+
+func errorBody(errType recurly.ErrorType, message string, params []recurly.ErrorParam, transactionError *recurly.TransactionError) interface{} {
+	type details struct {
+		Type             recurly.ErrorType         `json:"type"`
+		Message          string                    `json:"message"`
+		Params           []recurly.ErrorParam      `json:"params,omitempty"`
+		TransactionError *recurly.TransactionError `json:"transaction_error,omitempty"`
+	}
+	return struct {
+		Error details `json:"error"`
+	}{
+		Error: details{
+			Type:             errType,
+			Message:          message,
+			Params:           params,
+			TransactionError: transactionError,
+		},
+	}
+}
+
+// errorStatuses maps each error type to the HTTP status the API responds with
+var errorStatuses = map[recurly.ErrorType]int{
+	recurly.ErrorTypeBadRequest:              http.StatusBadRequest,
+	recurly.ErrorTypeInvalidToken:            http.StatusBadRequest,
+	recurly.ErrorTypeUnavailableInApiVersion: http.StatusBadRequest,
+	recurly.ErrorTypeUnauthorized:            http.StatusUnauthorized,
+	recurly.ErrorTypeInvalidApiKey:           http.StatusUnauthorized,
+	recurly.ErrorTypeForbidden:               http.StatusForbidden,
+	recurly.ErrorTypeInvalidPermissions:      http.StatusForbidden,
+	recurly.ErrorTypeNotFound:                http.StatusNotFound,
+	recurly.ErrorTypeInvalidApiVersion:       http.StatusNotAcceptable,
+	recurly.ErrorTypeUnknownApiVersion:       http.StatusNotAcceptable,
+	recurly.ErrorTypeInvalidContentType:      http.StatusUnsupportedMediaType,
+	recurly.ErrorTypeValidation:              http.StatusUnprocessableEntity,
+	recurly.ErrorTypeTransaction:             http.StatusUnprocessableEntity,
+	recurly.ErrorTypeImmutableSubscription:   http.StatusUnprocessableEntity,
+	recurly.ErrorTypeMissingFeature:          http.StatusUnprocessableEntity,
+	recurly.ErrorTypeRateLimited:             http.StatusTooManyRequests,
+	recurly.ErrorTypeSimulaneousRequest:      http.StatusTooManyRequests,
+	recurly.ErrorTypeInternalServer:          http.StatusInternalServerError,
+	recurly.ErrorTypeBadGateway:              http.StatusBadGateway,
+	recurly.ErrorTypeServiceUnavailable:      http.StatusServiceUnavailable,
+	recurly.ErrorTypeTimeout:                 http.StatusGatewayTimeout,
+}
+
+// ErrorStatus returns the HTTP status code used for the given error type
+func ErrorStatus(errType recurly.ErrorType) int {
+	if status, ok := errorStatuses[errType]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// operation identifies an API operation by its method and path template
+type operation struct {
+	method   string
+	segments []string
+}
+
+func newOperation(method string, path string) operation {
+	return operation{
+		method:   strings.ToUpper(method),
+		segments: splitPath(path),
+	}
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// matches reports whether the request matches the operation. Templated
+// segments such as `{account_id}` match any value.
+func (op operation) matches(req *http.Request) bool {
+	if op.method != req.Method {
+		return false
+	}
+	segments := splitPath(req.URL.Path)
+	if len(segments) != len(op.segments) {
+		return false
+	}
+	for i, segment := range op.segments {
+		if !segmentMatches(segment, segments[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// segmentMatches compares a single path segment against its template, e.g.
+// `{invoice_id}.pdf` matches `abcd1234.pdf`
+func segmentMatches(template string, segment string) bool {
+	start := strings.Index(template, "{")
+	end := strings.LastIndex(template, "}")
+	if start < 0 || end < start {
+		return template == segment
+	}
+	prefix, suffix := template[:start], template[end+1:]
+	return len(segment) > len(prefix)+len(suffix) &&
+		strings.HasPrefix(segment, prefix) &&
+		strings.HasSuffix(segment, suffix)
+}
+
+func (op operation) String() string {
+	return fmt.Sprintf("%s /%s", op.method, strings.Join(op.segments, "/"))
+}
+
+type queue struct {
+	operation operation
+	responses []*Response
+}
+
+// MockTransport is an http.RoundTripper which answers requests with responses
+// queued for each operation. Operations are identified by their HTTP method and
+// path template, e.g. `GET /accounts/{account_id}`. Responses for an operation
+// are returned in the order they were queued.
+type MockTransport struct {
+	// T receives the failures. Without it, requests still get their
+	// queued responses, or a 404, but failures aren't reported.
+	T testing.TB
+
+	mu       sync.Mutex
+	queues   []*queue
+	requests []*http.Request
+}
+
+// NewMockTransport returns a MockTransport which reports failures to t
+func NewMockTransport(t testing.TB) *MockTransport {
+	return &MockTransport{T: t}
+}
+
+// Client returns a *recurly.Client which sends every request through the transport
+func (m *MockTransport) Client() *recurly.Client {
+	return NewClient(m)
+}
+
+// Queue adds responses for the operation identified by method and path
+func (m *MockTransport) Queue(method string, path string, responses ...*Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	op := newOperation(method, path)
+	for _, q := range m.queues {
+		if q.operation.String() == op.String() {
+			q.responses = append(q.responses, responses...)
+			return
+		}
+	}
+	m.queues = append(m.queues, &queue{operation: op, responses: responses})
+}
+
+// Requests returns every request the transport has received, in order
+func (m *MockTransport) Requests() []*http.Request {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	requests := make([]*http.Request, len(m.requests))
+	copy(requests, m.requests)
+	return requests
+}
+
+// Pending returns the number of queued responses that have not been returned yet
+func (m *MockTransport) Pending() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pending := 0
+	for _, q := range m.queues {
+		pending += len(q.responses)
+	}
+	return pending
+}
+
+// AssertExhausted fails the test if any queued response was not returned
+func (m *MockTransport) AssertExhausted() {
+	if m.T == nil {
+		return
+	}
+	m.T.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, q := range m.queues {
+		if len(q.responses) > 0 {
+			m.T.Errorf("%d queued response(s) for %s were never requested", len(q.responses), q.operation)
+		}
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (m *MockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// buffer the body so it can be read by assertions and after the fact
+	RequestBody(req)
+	if m.T != nil {
+		AssertDefaultHeaders(m.T, req)
+	}
+
+	response := m.next(req)
+	if response == nil {
+		if m.T != nil {
+			m.T.Errorf("No response queued for %s %s", req.Method, req.URL.Path)
+		}
+		return MockResponse(req, http.StatusNotFound, ""), nil
+	}
+	if response.AssertRequest != nil {
+		response.AssertRequest(req)
+	}
+
+	res := MockResponse(req, response.StatusCode, response.Body)
+	for key, values := range response.Header {
+		res.Header.Del(key)
+		for _, value := range values {
+			res.Header.Add(key, value)
+		}
+	}
+	return res, nil
+}
+
+// next records the request and dequeues the response for it
+func (m *MockTransport) next(req *http.Request) *Response {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = append(m.requests, req)
+	for _, q := range m.queues {
+		if q.operation.matches(req) && len(q.responses) > 0 {
+			response := q.responses[0]
+			q.responses = q.responses[1:]
+			return response
+		}
+	}
+	return nil
+}
@@ -1,6 +1,11 @@
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
 package recurly
 
-import "time"
+import (
+	"regexp"
+	"time"
+)
 
 type AccountCreate struct {
 	Params `json:"-"`
@@ -19,7 +24,7 @@ type AccountCreate struct {
 	Email *string `json:"email,omitempty"`
 
 	// Used to determine the language and locale of emails sent on behalf of the merchant to the customer. The list of locales is restricted to those the merchant has enabled on the site.
-	PreferredLocale *string `json:"preferred_locale,omitempty"`
+	PreferredLocale *PreferredLocale `json:"preferred_locale,omitempty"`
 
 	// Additional email address that should receive account correspondence. These should be separated only by commas. These CC emails will receive all emails that the `email` field also receives.
 	CcEmails *string `json:"cc_emails,omitempty"`
@@ -46,17 +51,17 @@ type AccountCreate struct {
 	ParentAccountId *string `json:"parent_account_id,omitempty"`
 
 	// An enumerable describing the billing behavior of the account, specifically whether the account is self-paying or will rely on the parent account to pay.
-	BillTo *string `json:"bill_to,omitempty"`
+	BillTo *BillTo `json:"bill_to,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *AccountTransactionType `json:"transaction_type,omitempty"`
 
 	Address *AddressCreate `json:"address,omitempty"`
 
 	BillingInfo *BillingInfoCreate `json:"billing_info,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 }
 
 func (attr *AccountCreate) toParams() *Params {
@@ -68,6 +73,169 @@ func (attr *AccountCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AccountCreate) Clone() *AccountCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	clone.Acquisition = attr.Acquisition.Clone()
+	if attr.ShippingAddresses != nil {
+		clone.ShippingAddresses = make([]ShippingAddressCreate, len(attr.ShippingAddresses))
+		for i := range attr.ShippingAddresses {
+			clone.ShippingAddresses[i] = *attr.ShippingAddresses[i].Clone()
+		}
+	}
+	if attr.Username != nil && !IsNull(attr.Username) {
+		value := *attr.Username
+		clone.Username = &value
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		value := *attr.Email
+		clone.Email = &value
+	}
+	if attr.PreferredLocale != nil {
+		value := *attr.PreferredLocale
+		clone.PreferredLocale = &value
+	}
+	if attr.CcEmails != nil && !IsNull(attr.CcEmails) {
+		value := *attr.CcEmails
+		clone.CcEmails = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		value := *attr.VatNumber
+		clone.VatNumber = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.ExemptionCertificate != nil && !IsNull(attr.ExemptionCertificate) {
+		value := *attr.ExemptionCertificate
+		clone.ExemptionCertificate = &value
+	}
+	if attr.ParentAccountCode != nil && !IsNull(attr.ParentAccountCode) {
+		value := *attr.ParentAccountCode
+		clone.ParentAccountCode = &value
+	}
+	if attr.ParentAccountId != nil && !IsNull(attr.ParentAccountId) {
+		value := *attr.ParentAccountId
+		clone.ParentAccountId = &value
+	}
+	if attr.BillTo != nil {
+		value := *attr.BillTo
+		clone.BillTo = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	clone.Address = attr.Address.Clone()
+	clone.BillingInfo = attr.BillingInfo.Clone()
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AccountCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AccountCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AccountCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, nil, nil)
+	}
+	if attr.Acquisition != nil {
+		invalid = attr.Acquisition.validate(invalid, path+"acquisition.")
+	}
+	for i := range attr.ShippingAddresses {
+		invalid = attr.ShippingAddresses[i].validate(invalid, indexPath(path, "shipping_addresses", i)+".")
+	}
+	if attr.Username != nil && !IsNull(attr.Username) {
+		invalid = checkString(invalid, path+"username", *attr.Username, 0, 255, nil, nil)
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		invalid = checkString(invalid, path+"email", *attr.Email, 0, 255, nil, nil)
+	}
+	if attr.PreferredLocale != nil && *attr.PreferredLocale != "" {
+		invalid = checkString(invalid, path+"preferred_locale", string(*attr.PreferredLocale), 0, 0, nil, []string{"da-DK", "de-CH", "de-DE", "en-AU", "en-CA", "en-GB", "en-NZ", "en-US", "es-ES", "es-MX", "es-US", "fr-CA", "fr-FR", "hi-IN", "ja-JP", "nl-BE", "nl-NL", "pt-BR", "pt-PT", "ru-RU", "tr-TR", "zh-CN"})
+	}
+	if attr.CcEmails != nil && !IsNull(attr.CcEmails) {
+		invalid = checkString(invalid, path+"cc_emails", *attr.CcEmails, 0, 255, nil, nil)
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		invalid = checkString(invalid, path+"first_name", *attr.FirstName, 0, 255, nil, nil)
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		invalid = checkString(invalid, path+"last_name", *attr.LastName, 0, 255, nil, nil)
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		invalid = checkString(invalid, path+"company", *attr.Company, 0, 50, nil, nil)
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		invalid = checkString(invalid, path+"vat_number", *attr.VatNumber, 0, 20, nil, nil)
+	}
+	if attr.ExemptionCertificate != nil && !IsNull(attr.ExemptionCertificate) {
+		invalid = checkString(invalid, path+"exemption_certificate", *attr.ExemptionCertificate, 0, 30, nil, nil)
+	}
+	if attr.ParentAccountCode != nil && !IsNull(attr.ParentAccountCode) {
+		invalid = checkString(invalid, path+"parent_account_code", *attr.ParentAccountCode, 0, 50, nil, nil)
+	}
+	if attr.ParentAccountId != nil && !IsNull(attr.ParentAccountId) {
+		invalid = checkString(invalid, path+"parent_account_id", *attr.ParentAccountId, 0, 13, nil, nil)
+	}
+	if attr.BillTo != nil && *attr.BillTo != "" {
+		invalid = checkString(invalid, path+"bill_to", string(*attr.BillTo), 0, 6, nil, []string{"self", "parent"})
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	if attr.BillingInfo != nil {
+		invalid = attr.BillingInfo.validate(invalid, path+"billing_info.")
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	return invalid
+}
+
 type AccountAcquisitionUpdatable struct {
 	Params `json:"-"`
 
@@ -75,7 +243,7 @@ type AccountAcquisitionUpdatable struct {
 	Cost *AccountAcquisitionCostCreate `json:"cost,omitempty"`
 
 	// The channel through which the account was acquired.
-	Channel *string `json:"channel,omitempty"`
+	Channel *AccountAcquisitionChannel `json:"channel,omitempty"`
 
 	// An arbitrary subchannel string representing a distinction/subcategory within a broader channel.
 	Subchannel *string `json:"subchannel,omitempty"`
@@ -93,8 +261,58 @@ func (attr *AccountAcquisitionUpdatable) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AccountAcquisitionUpdatable) Clone() *AccountAcquisitionUpdatable {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.Cost = attr.Cost.Clone()
+	if attr.Channel != nil {
+		value := *attr.Channel
+		clone.Channel = &value
+	}
+	if attr.Subchannel != nil && !IsNull(attr.Subchannel) {
+		value := *attr.Subchannel
+		clone.Subchannel = &value
+	}
+	if attr.Campaign != nil && !IsNull(attr.Campaign) {
+		value := *attr.Campaign
+		clone.Campaign = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AccountAcquisitionUpdatable) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AccountAcquisitionUpdatable) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AccountAcquisitionUpdatable) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Cost != nil {
+		invalid = attr.Cost.validate(invalid, path+"cost.")
+	}
+	if attr.Channel != nil && *attr.Channel != "" {
+		invalid = checkString(invalid, path+"channel", string(*attr.Channel), 0, 0, nil, []string{"referral", "social_media", "email", "paid_search", "organic_search", "direct_traffic", "marketing_content", "blog", "events", "outbound_sales", "advertising", "public_relations", "other"})
+	}
+	return invalid
+}
+
 type AccountAcquisitionCostCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// 3-letter ISO 4217 currency code.
 	Currency *string `json:"currency,omitempty"`
@@ -112,6 +330,54 @@ func (attr *AccountAcquisitionCostCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AccountAcquisitionCostCreate) Clone() *AccountAcquisitionCostCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		value := *attr.Amount
+		clone.Amount = &value
+	}
+	return &clone
+}
+
+// SetAmountMoney sets Amount to the exact amount m, which is sent
+// with every digit of m, unless Amount is changed since.
+func (attr *AccountAcquisitionCostCreate) SetAmountMoney(m Money) {
+	attr.Amount = attr.setMoney("amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AccountAcquisitionCostCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AccountAcquisitionCostCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AccountAcquisitionCostCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	return invalid
+}
+
 type ShippingAddressCreate struct {
 	Params `json:"-"`
 
@@ -154,6 +420,133 @@ func (attr *ShippingAddressCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ShippingAddressCreate) Clone() *ShippingAddressCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Nickname != nil && !IsNull(attr.Nickname) {
+		value := *attr.Nickname
+		clone.Nickname = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		value := *attr.Email
+		clone.Email = &value
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		value := *attr.VatNumber
+		clone.VatNumber = &value
+	}
+	if attr.Phone != nil && !IsNull(attr.Phone) {
+		value := *attr.Phone
+		clone.Phone = &value
+	}
+	if attr.Street1 != nil && !IsNull(attr.Street1) {
+		value := *attr.Street1
+		clone.Street1 = &value
+	}
+	if attr.Street2 != nil && !IsNull(attr.Street2) {
+		value := *attr.Street2
+		clone.Street2 = &value
+	}
+	if attr.City != nil && !IsNull(attr.City) {
+		value := *attr.City
+		clone.City = &value
+	}
+	if attr.Region != nil && !IsNull(attr.Region) {
+		value := *attr.Region
+		clone.Region = &value
+	}
+	if attr.PostalCode != nil && !IsNull(attr.PostalCode) {
+		value := *attr.PostalCode
+		clone.PostalCode = &value
+	}
+	if attr.Country != nil && !IsNull(attr.Country) {
+		value := *attr.Country
+		clone.Country = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ShippingAddressCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ShippingAddressCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ShippingAddressCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Nickname != nil && !IsNull(attr.Nickname) {
+		invalid = checkString(invalid, path+"nickname", *attr.Nickname, 0, 255, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"first_name", attr.FirstName != nil && !IsNull(attr.FirstName))
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		invalid = checkString(invalid, path+"first_name", *attr.FirstName, 0, 255, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"last_name", attr.LastName != nil && !IsNull(attr.LastName))
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		invalid = checkString(invalid, path+"last_name", *attr.LastName, 0, 255, nil, nil)
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		invalid = checkString(invalid, path+"company", *attr.Company, 0, 255, nil, nil)
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		invalid = checkString(invalid, path+"email", *attr.Email, 0, 255, nil, nil)
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		invalid = checkString(invalid, path+"vat_number", *attr.VatNumber, 0, 20, nil, nil)
+	}
+	if attr.Phone != nil && !IsNull(attr.Phone) {
+		invalid = checkString(invalid, path+"phone", *attr.Phone, 0, 30, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"street1", attr.Street1 != nil && !IsNull(attr.Street1))
+	if attr.Street1 != nil && !IsNull(attr.Street1) {
+		invalid = checkString(invalid, path+"street1", *attr.Street1, 0, 255, nil, nil)
+	}
+	if attr.Street2 != nil && !IsNull(attr.Street2) {
+		invalid = checkString(invalid, path+"street2", *attr.Street2, 0, 255, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"city", attr.City != nil && !IsNull(attr.City))
+	if attr.City != nil && !IsNull(attr.City) {
+		invalid = checkString(invalid, path+"city", *attr.City, 0, 255, nil, nil)
+	}
+	if attr.Region != nil && !IsNull(attr.Region) {
+		invalid = checkString(invalid, path+"region", *attr.Region, 0, 255, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"postal_code", attr.PostalCode != nil && !IsNull(attr.PostalCode))
+	if attr.PostalCode != nil && !IsNull(attr.PostalCode) {
+		invalid = checkString(invalid, path+"postal_code", *attr.PostalCode, 0, 20, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"country", attr.Country != nil && !IsNull(attr.Country))
+	if attr.Country != nil && !IsNull(attr.Country) {
+		invalid = checkString(invalid, path+"country", *attr.Country, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
 type AddressCreate struct {
 	Params `json:"-"`
 
@@ -194,6 +587,72 @@ func (attr *AddressCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AddressCreate) Clone() *AddressCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Phone != nil && !IsNull(attr.Phone) {
+		value := *attr.Phone
+		clone.Phone = &value
+	}
+	if attr.Street1 != nil && !IsNull(attr.Street1) {
+		value := *attr.Street1
+		clone.Street1 = &value
+	}
+	if attr.Street2 != nil && !IsNull(attr.Street2) {
+		value := *attr.Street2
+		clone.Street2 = &value
+	}
+	if attr.City != nil && !IsNull(attr.City) {
+		value := *attr.City
+		clone.City = &value
+	}
+	if attr.Region != nil && !IsNull(attr.Region) {
+		value := *attr.Region
+		clone.Region = &value
+	}
+	if attr.PostalCode != nil && !IsNull(attr.PostalCode) {
+		value := *attr.PostalCode
+		clone.PostalCode = &value
+	}
+	if attr.Country != nil && !IsNull(attr.Country) {
+		value := *attr.Country
+		clone.Country = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AddressCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AddressCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AddressCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	return invalid
+}
+
 type BillingInfoCreate struct {
 	Params `json:"-"`
 
@@ -245,7 +704,7 @@ type BillingInfoCreate struct {
 	FraudSessionId *string `json:"fraud_session_id,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *BillingInfoTransactionType `json:"transaction_type,omitempty"`
 
 	// A token generated by Recurly.js after completing a 3-D Secure device fingerprinting or authentication challenge.
 	ThreeDSecureActionResultTokenId *string `json:"three_d_secure_action_result_token_id,omitempty"`
@@ -263,7 +722,7 @@ type BillingInfoCreate struct {
 	RoutingNumber *string `json:"routing_number,omitempty"`
 
 	// The bank account type. (ACH only)
-	AccountType *string `json:"account_type,omitempty"`
+	AccountType *AccountType `json:"account_type,omitempty"`
 }
 
 func (attr *BillingInfoCreate) toParams() *Params {
@@ -275,6 +734,179 @@ func (attr *BillingInfoCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *BillingInfoCreate) Clone() *BillingInfoCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.TokenId != nil && !IsNull(attr.TokenId) {
+		value := *attr.TokenId
+		clone.TokenId = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	clone.Address = attr.Address.Clone()
+	if attr.Number != nil && !IsNull(attr.Number) {
+		value := *attr.Number
+		clone.Number = &value
+	}
+	if attr.Month != nil && !IsNull(attr.Month) {
+		value := *attr.Month
+		clone.Month = &value
+	}
+	if attr.Year != nil && !IsNull(attr.Year) {
+		value := *attr.Year
+		clone.Year = &value
+	}
+	if attr.Cvv != nil && !IsNull(attr.Cvv) {
+		value := *attr.Cvv
+		clone.Cvv = &value
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		value := *attr.VatNumber
+		clone.VatNumber = &value
+	}
+	if attr.IpAddress != nil && !IsNull(attr.IpAddress) {
+		value := *attr.IpAddress
+		clone.IpAddress = &value
+	}
+	if attr.GatewayToken != nil && !IsNull(attr.GatewayToken) {
+		value := *attr.GatewayToken
+		clone.GatewayToken = &value
+	}
+	if attr.GatewayCode != nil && !IsNull(attr.GatewayCode) {
+		value := *attr.GatewayCode
+		clone.GatewayCode = &value
+	}
+	if attr.AmazonBillingAgreementId != nil && !IsNull(attr.AmazonBillingAgreementId) {
+		value := *attr.AmazonBillingAgreementId
+		clone.AmazonBillingAgreementId = &value
+	}
+	if attr.PaypalBillingAgreementId != nil && !IsNull(attr.PaypalBillingAgreementId) {
+		value := *attr.PaypalBillingAgreementId
+		clone.PaypalBillingAgreementId = &value
+	}
+	if attr.FraudSessionId != nil && !IsNull(attr.FraudSessionId) {
+		value := *attr.FraudSessionId
+		clone.FraudSessionId = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	if attr.ThreeDSecureActionResultTokenId != nil && !IsNull(attr.ThreeDSecureActionResultTokenId) {
+		value := *attr.ThreeDSecureActionResultTokenId
+		clone.ThreeDSecureActionResultTokenId = &value
+	}
+	if attr.Iban != nil && !IsNull(attr.Iban) {
+		value := *attr.Iban
+		clone.Iban = &value
+	}
+	if attr.NameOnAccount != nil && !IsNull(attr.NameOnAccount) {
+		value := *attr.NameOnAccount
+		clone.NameOnAccount = &value
+	}
+	if attr.AccountNumber != nil && !IsNull(attr.AccountNumber) {
+		value := *attr.AccountNumber
+		clone.AccountNumber = &value
+	}
+	if attr.RoutingNumber != nil && !IsNull(attr.RoutingNumber) {
+		value := *attr.RoutingNumber
+		clone.RoutingNumber = &value
+	}
+	if attr.AccountType != nil {
+		value := *attr.AccountType
+		clone.AccountType = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr BillingInfoCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *BillingInfoCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *BillingInfoCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.TokenId != nil && !IsNull(attr.TokenId) {
+		invalid = checkString(invalid, path+"token_id", *attr.TokenId, 0, 22, nil, nil)
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		invalid = checkString(invalid, path+"first_name", *attr.FirstName, 0, 50, nil, nil)
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		invalid = checkString(invalid, path+"last_name", *attr.LastName, 0, 50, nil, nil)
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		invalid = checkString(invalid, path+"company", *attr.Company, 0, 100, nil, nil)
+	}
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	if attr.Month != nil && !IsNull(attr.Month) {
+		invalid = checkString(invalid, path+"month", *attr.Month, 0, 2, nil, nil)
+	}
+	if attr.Year != nil && !IsNull(attr.Year) {
+		invalid = checkString(invalid, path+"year", *attr.Year, 0, 4, nil, nil)
+	}
+	if attr.Cvv != nil && !IsNull(attr.Cvv) {
+		invalid = checkString(invalid, path+"cvv", *attr.Cvv, 0, 4, nil, nil)
+	}
+	if attr.IpAddress != nil && !IsNull(attr.IpAddress) {
+		invalid = checkString(invalid, path+"ip_address", *attr.IpAddress, 0, 20, nil, nil)
+	}
+	if attr.GatewayToken != nil && !IsNull(attr.GatewayToken) {
+		invalid = checkString(invalid, path+"gateway_token", *attr.GatewayToken, 0, 50, nil, nil)
+	}
+	if attr.GatewayCode != nil && !IsNull(attr.GatewayCode) {
+		invalid = checkString(invalid, path+"gateway_code", *attr.GatewayCode, 0, 12, nil, nil)
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	if attr.ThreeDSecureActionResultTokenId != nil && !IsNull(attr.ThreeDSecureActionResultTokenId) {
+		invalid = checkString(invalid, path+"three_d_secure_action_result_token_id", *attr.ThreeDSecureActionResultTokenId, 0, 22, nil, nil)
+	}
+	if attr.Iban != nil && !IsNull(attr.Iban) {
+		invalid = checkString(invalid, path+"iban", *attr.Iban, 0, 34, nil, nil)
+	}
+	if attr.NameOnAccount != nil && !IsNull(attr.NameOnAccount) {
+		invalid = checkString(invalid, path+"name_on_account", *attr.NameOnAccount, 0, 255, nil, nil)
+	}
+	if attr.AccountNumber != nil && !IsNull(attr.AccountNumber) {
+		invalid = checkString(invalid, path+"account_number", *attr.AccountNumber, 0, 255, nil, nil)
+	}
+	if attr.RoutingNumber != nil && !IsNull(attr.RoutingNumber) {
+		invalid = checkString(invalid, path+"routing_number", *attr.RoutingNumber, 0, 15, nil, nil)
+	}
+	if attr.AccountType != nil && *attr.AccountType != "" {
+		invalid = checkString(invalid, path+"account_type", string(*attr.AccountType), 0, 0, nil, []string{"checking", "savings"})
+	}
+	return invalid
+}
+
 type CustomFieldCreate struct {
 	Params `json:"-"`
 
@@ -294,6 +926,52 @@ func (attr *CustomFieldCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *CustomFieldCreate) Clone() *CustomFieldCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.Value != nil && !IsNull(attr.Value) {
+		value := *attr.Value
+		clone.Value = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr CustomFieldCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *CustomFieldCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *CustomFieldCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"name", attr.Name != nil && !IsNull(attr.Name))
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 50, namePattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"value", attr.Value != nil && !IsNull(attr.Value))
+	if attr.Value != nil && !IsNull(attr.Value) {
+		invalid = checkString(invalid, path+"value", *attr.Value, 0, 100, nil, nil)
+	}
+	return invalid
+}
+
 type AccountUpdate struct {
 	Params `json:"-"`
 
@@ -304,7 +982,7 @@ type AccountUpdate struct {
 	Email *string `json:"email,omitempty"`
 
 	// Used to determine the language and locale of emails sent on behalf of the merchant to the customer. The list of locales is restricted to those the merchant has enabled on the site.
-	PreferredLocale *string `json:"preferred_locale,omitempty"`
+	PreferredLocale *PreferredLocale `json:"preferred_locale,omitempty"`
 
 	// Additional email address that should receive account correspondence. These should be separated only by commas. These CC emails will receive all emails that the `email` field also receives.
 	CcEmails *string `json:"cc_emails,omitempty"`
@@ -331,17 +1009,17 @@ type AccountUpdate struct {
 	ParentAccountId *string `json:"parent_account_id,omitempty"`
 
 	// An enumerable describing the billing behavior of the account, specifically whether the account is self-paying or will rely on the parent account to pay.
-	BillTo *string `json:"bill_to,omitempty"`
+	BillTo *BillTo `json:"bill_to,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *AccountTransactionType `json:"transaction_type,omitempty"`
 
 	Address *AddressCreate `json:"address,omitempty"`
 
 	BillingInfo *BillingInfoCreate `json:"billing_info,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 }
 
 func (attr *AccountUpdate) toParams() *Params {
@@ -353,6 +1031,148 @@ func (attr *AccountUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AccountUpdate) Clone() *AccountUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Username != nil && !IsNull(attr.Username) {
+		value := *attr.Username
+		clone.Username = &value
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		value := *attr.Email
+		clone.Email = &value
+	}
+	if attr.PreferredLocale != nil {
+		value := *attr.PreferredLocale
+		clone.PreferredLocale = &value
+	}
+	if attr.CcEmails != nil && !IsNull(attr.CcEmails) {
+		value := *attr.CcEmails
+		clone.CcEmails = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		value := *attr.VatNumber
+		clone.VatNumber = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.ExemptionCertificate != nil && !IsNull(attr.ExemptionCertificate) {
+		value := *attr.ExemptionCertificate
+		clone.ExemptionCertificate = &value
+	}
+	if attr.ParentAccountCode != nil && !IsNull(attr.ParentAccountCode) {
+		value := *attr.ParentAccountCode
+		clone.ParentAccountCode = &value
+	}
+	if attr.ParentAccountId != nil && !IsNull(attr.ParentAccountId) {
+		value := *attr.ParentAccountId
+		clone.ParentAccountId = &value
+	}
+	if attr.BillTo != nil {
+		value := *attr.BillTo
+		clone.BillTo = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	clone.Address = attr.Address.Clone()
+	clone.BillingInfo = attr.BillingInfo.Clone()
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AccountUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AccountUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AccountUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Username != nil && !IsNull(attr.Username) {
+		invalid = checkString(invalid, path+"username", *attr.Username, 0, 255, nil, nil)
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		invalid = checkString(invalid, path+"email", *attr.Email, 0, 255, nil, nil)
+	}
+	if attr.PreferredLocale != nil && *attr.PreferredLocale != "" {
+		invalid = checkString(invalid, path+"preferred_locale", string(*attr.PreferredLocale), 0, 0, nil, []string{"da-DK", "de-CH", "de-DE", "en-AU", "en-CA", "en-GB", "en-NZ", "en-US", "es-ES", "es-MX", "es-US", "fr-CA", "fr-FR", "hi-IN", "ja-JP", "nl-BE", "nl-NL", "pt-BR", "pt-PT", "ru-RU", "tr-TR", "zh-CN"})
+	}
+	if attr.CcEmails != nil && !IsNull(attr.CcEmails) {
+		invalid = checkString(invalid, path+"cc_emails", *attr.CcEmails, 0, 255, nil, nil)
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		invalid = checkString(invalid, path+"first_name", *attr.FirstName, 0, 255, nil, nil)
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		invalid = checkString(invalid, path+"last_name", *attr.LastName, 0, 255, nil, nil)
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		invalid = checkString(invalid, path+"company", *attr.Company, 0, 50, nil, nil)
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		invalid = checkString(invalid, path+"vat_number", *attr.VatNumber, 0, 20, nil, nil)
+	}
+	if attr.ExemptionCertificate != nil && !IsNull(attr.ExemptionCertificate) {
+		invalid = checkString(invalid, path+"exemption_certificate", *attr.ExemptionCertificate, 0, 30, nil, nil)
+	}
+	if attr.ParentAccountCode != nil && !IsNull(attr.ParentAccountCode) {
+		invalid = checkString(invalid, path+"parent_account_code", *attr.ParentAccountCode, 0, 50, nil, nil)
+	}
+	if attr.ParentAccountId != nil && !IsNull(attr.ParentAccountId) {
+		invalid = checkString(invalid, path+"parent_account_id", *attr.ParentAccountId, 0, 13, nil, nil)
+	}
+	if attr.BillTo != nil && *attr.BillTo != "" {
+		invalid = checkString(invalid, path+"bill_to", string(*attr.BillTo), 0, 6, nil, []string{"self", "parent"})
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	if attr.BillingInfo != nil {
+		invalid = attr.BillingInfo.validate(invalid, path+"billing_info.")
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	return invalid
+}
+
 type CouponRedemptionCreate struct {
 	Params `json:"-"`
 
@@ -372,6 +1192,48 @@ func (attr *CouponRedemptionCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *CouponRedemptionCreate) Clone() *CouponRedemptionCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.CouponId != nil && !IsNull(attr.CouponId) {
+		value := *attr.CouponId
+		clone.CouponId = &value
+	}
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr CouponRedemptionCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *CouponRedemptionCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *CouponRedemptionCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"coupon_id", attr.CouponId != nil && !IsNull(attr.CouponId))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	return invalid
+}
+
 type InvoiceCreate struct {
 	Params `json:"-"`
 
@@ -379,7 +1241,7 @@ type InvoiceCreate struct {
 	Currency *string `json:"currency,omitempty"`
 
 	// An automatic invoice means a corresponding transaction is run using the account's billing information at the same time the invoice is created. Manual invoices are created without a corresponding transaction. The merchant must enter a manual payment transaction or have the customer pay the invoice with an automatic method, like credit card, PayPal, Amazon, or ACH bank payment.
-	CollectionMethod *string `json:"collection_method,omitempty"`
+	CollectionMethod *CollectionMethod `json:"collection_method,omitempty"`
 
 	// This will default to the Customer Notes text specified on the Invoice Settings for charge invoices. Specify custom notes to add or override Customer Notes on charge invoices.
 	ChargeCustomerNotes *string `json:"charge_customer_notes,omitempty"`
@@ -409,11 +1271,87 @@ func (attr *InvoiceCreate) toParams() *Params {
 	}
 }
 
-type LineItemCreate struct {
-	Params `json:"-"`
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *InvoiceCreate) Clone() *InvoiceCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.CollectionMethod != nil {
+		value := *attr.CollectionMethod
+		clone.CollectionMethod = &value
+	}
+	if attr.ChargeCustomerNotes != nil && !IsNull(attr.ChargeCustomerNotes) {
+		value := *attr.ChargeCustomerNotes
+		clone.ChargeCustomerNotes = &value
+	}
+	if attr.CreditCustomerNotes != nil && !IsNull(attr.CreditCustomerNotes) {
+		value := *attr.CreditCustomerNotes
+		clone.CreditCustomerNotes = &value
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		value := *attr.NetTerms
+		clone.NetTerms = &value
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		value := *attr.PoNumber
+		clone.PoNumber = &value
+	}
+	if attr.TermsAndConditions != nil && !IsNull(attr.TermsAndConditions) {
+		value := *attr.TermsAndConditions
+		clone.TermsAndConditions = &value
+	}
+	if attr.VatReverseChargeNotes != nil && !IsNull(attr.VatReverseChargeNotes) {
+		value := *attr.VatReverseChargeNotes
+		clone.VatReverseChargeNotes = &value
+	}
+	return &clone
+}
 
-	// 3-letter ISO 4217 currency code. If `item_code`/`item_id` is part of the request then `currency` is optional, if the site has a single default currency. `currency` is required if `item_code`/`item_id` is present, and there are multiple currencies defined on the site. If `item_code`/`item_id` is not present `currency` is required.
-	Currency *string `json:"currency,omitempty"`
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr InvoiceCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *InvoiceCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *InvoiceCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"currency", attr.Currency != nil && !IsNull(attr.Currency))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	if attr.CollectionMethod != nil && *attr.CollectionMethod != "" {
+		invalid = checkString(invalid, path+"collection_method", string(*attr.CollectionMethod), 0, 0, nil, []string{"automatic", "manual"})
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		invalid = checkNumber(invalid, path+"net_terms", float64(*attr.NetTerms), Float(0), nil)
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		invalid = checkString(invalid, path+"po_number", *attr.PoNumber, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
+type LineItemCreate struct {
+	Params `json:"-"`
+	exactAmounts
+
+	// 3-letter ISO 4217 currency code. If `item_code`/`item_id` is part of the request then `currency` is optional, if the site has a single default currency. `currency` is required if `item_code`/`item_id` is present, and there are multiple currencies defined on the site. If `item_code`/`item_id` is not present `currency` is required.
+	Currency *string `json:"currency,omitempty"`
 
 	// A positive or negative amount with `type=charge` will result in a positive `unit_amount`.
 	// A positive or negative amount with `type=credit` will result in a negative `unit_amount`.
@@ -434,13 +1372,13 @@ type LineItemCreate struct {
 	ItemId *string `json:"item_id,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Line item type. If `item_code`/`item_id` is present then `type` should not be present. If `item_code`/`item_id` is not present then `type` is required.
-	Type *string `json:"type,omitempty"`
+	Type *LineItemType `json:"type,omitempty"`
 
 	// The reason the credit was given when line item is `type=credit`. When the Credit Invoices feature is enabled, the value can be set and will default to `general`. When the Credit Invoices feature is not enabled, the value will always be `null`.
-	CreditReasonCode *string `json:"credit_reason_code,omitempty"`
+	CreditReasonCode *CreditReasonCode `json:"credit_reason_code,omitempty"`
 
 	// Accounting Code for the `LineItem`. If `item_code`/`item_id` is part of the request then `accounting_code` must be absent.
 	AccountingCode *string `json:"accounting_code,omitempty"`
@@ -455,7 +1393,7 @@ type LineItemCreate struct {
 	ProductCode *string `json:"product_code,omitempty"`
 
 	// Only allowed if the Gift Cards feature is enabled on your site and `type` is `credit`. Can only have a value of `external_gift_card`. Set this value in order to track gift card credits from external gift cards (like InComm). It also skips billing information requirements.
-	Origin *string `json:"origin,omitempty"`
+	Origin *LineItemCreateOrigin `json:"origin,omitempty"`
 
 	// If an end date is present, this is value indicates the beginning of a billing time range. If no end date is present it indicates billing for a specific date. Defaults to the current date-time.
 	StartDate *time.Time `json:"start_date,omitempty"`
@@ -473,6 +1411,143 @@ func (attr *LineItemCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *LineItemCreate) Clone() *LineItemCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		value := *attr.Description
+		clone.Description = &value
+	}
+	if attr.ItemCode != nil && !IsNull(attr.ItemCode) {
+		value := *attr.ItemCode
+		clone.ItemCode = &value
+	}
+	if attr.ItemId != nil && !IsNull(attr.ItemId) {
+		value := *attr.ItemId
+		clone.ItemId = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.Type != nil {
+		value := *attr.Type
+		clone.Type = &value
+	}
+	if attr.CreditReasonCode != nil {
+		value := *attr.CreditReasonCode
+		clone.CreditReasonCode = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.ProductCode != nil && !IsNull(attr.ProductCode) {
+		value := *attr.ProductCode
+		clone.ProductCode = &value
+	}
+	if attr.Origin != nil {
+		value := *attr.Origin
+		clone.Origin = &value
+	}
+	if attr.StartDate != nil && !IsNull(attr.StartDate) {
+		value := *attr.StartDate
+		clone.StartDate = &value
+	}
+	if attr.EndDate != nil && !IsNull(attr.EndDate) {
+		value := *attr.EndDate
+		clone.EndDate = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *LineItemCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr LineItemCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *LineItemCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *LineItemCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"currency", attr.Currency != nil && !IsNull(attr.Currency))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"unit_amount", attr.UnitAmount != nil && !IsNull(attr.UnitAmount))
+	if attr.Description != nil && !IsNull(attr.Description) {
+		invalid = checkString(invalid, path+"description", *attr.Description, 0, 255, nil, nil)
+	}
+	if attr.ItemCode != nil && !IsNull(attr.ItemCode) {
+		invalid = checkString(invalid, path+"item_code", *attr.ItemCode, 0, 50, itemCodePattern, nil)
+	}
+	if attr.ItemId != nil && !IsNull(attr.ItemId) {
+		invalid = checkString(invalid, path+"item_id", *attr.ItemId, 0, 13, nil, nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start", "at_invoice"})
+	}
+	invalid = checkRequired(invalid, path+"type", attr.Type != nil && *attr.Type != "")
+	if attr.Type != nil && *attr.Type != "" {
+		invalid = checkString(invalid, path+"type", string(*attr.Type), 0, 0, nil, []string{"charge", "credit"})
+	}
+	if attr.CreditReasonCode != nil && *attr.CreditReasonCode != "" {
+		invalid = checkString(invalid, path+"credit_reason_code", string(*attr.CreditReasonCode), 0, 0, nil, []string{"general", "service", "promotional"})
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	if attr.ProductCode != nil && !IsNull(attr.ProductCode) {
+		invalid = checkString(invalid, path+"product_code", *attr.ProductCode, 0, 50, nil, nil)
+	}
+	if attr.Origin != nil && *attr.Origin != "" {
+		invalid = checkString(invalid, path+"origin", string(*attr.Origin), 0, 0, nil, []string{"external_gift_card"})
+	}
+	return invalid
+}
+
 type ShippingAddressUpdate struct {
 	Params `json:"-"`
 
@@ -518,6 +1593,134 @@ func (attr *ShippingAddressUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ShippingAddressUpdate) Clone() *ShippingAddressUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Id != nil && !IsNull(attr.Id) {
+		value := *attr.Id
+		clone.Id = &value
+	}
+	if attr.Nickname != nil && !IsNull(attr.Nickname) {
+		value := *attr.Nickname
+		clone.Nickname = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		value := *attr.Email
+		clone.Email = &value
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		value := *attr.VatNumber
+		clone.VatNumber = &value
+	}
+	if attr.Phone != nil && !IsNull(attr.Phone) {
+		value := *attr.Phone
+		clone.Phone = &value
+	}
+	if attr.Street1 != nil && !IsNull(attr.Street1) {
+		value := *attr.Street1
+		clone.Street1 = &value
+	}
+	if attr.Street2 != nil && !IsNull(attr.Street2) {
+		value := *attr.Street2
+		clone.Street2 = &value
+	}
+	if attr.City != nil && !IsNull(attr.City) {
+		value := *attr.City
+		clone.City = &value
+	}
+	if attr.Region != nil && !IsNull(attr.Region) {
+		value := *attr.Region
+		clone.Region = &value
+	}
+	if attr.PostalCode != nil && !IsNull(attr.PostalCode) {
+		value := *attr.PostalCode
+		clone.PostalCode = &value
+	}
+	if attr.Country != nil && !IsNull(attr.Country) {
+		value := *attr.Country
+		clone.Country = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ShippingAddressUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ShippingAddressUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ShippingAddressUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Id != nil && !IsNull(attr.Id) {
+		invalid = checkString(invalid, path+"id", *attr.Id, 0, 13, nil, nil)
+	}
+	if attr.Nickname != nil && !IsNull(attr.Nickname) {
+		invalid = checkString(invalid, path+"nickname", *attr.Nickname, 0, 255, nil, nil)
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		invalid = checkString(invalid, path+"first_name", *attr.FirstName, 0, 255, nil, nil)
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		invalid = checkString(invalid, path+"last_name", *attr.LastName, 0, 255, nil, nil)
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		invalid = checkString(invalid, path+"company", *attr.Company, 0, 255, nil, nil)
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		invalid = checkString(invalid, path+"email", *attr.Email, 0, 255, nil, nil)
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		invalid = checkString(invalid, path+"vat_number", *attr.VatNumber, 0, 20, nil, nil)
+	}
+	if attr.Phone != nil && !IsNull(attr.Phone) {
+		invalid = checkString(invalid, path+"phone", *attr.Phone, 0, 30, nil, nil)
+	}
+	if attr.Street1 != nil && !IsNull(attr.Street1) {
+		invalid = checkString(invalid, path+"street1", *attr.Street1, 0, 255, nil, nil)
+	}
+	if attr.Street2 != nil && !IsNull(attr.Street2) {
+		invalid = checkString(invalid, path+"street2", *attr.Street2, 0, 255, nil, nil)
+	}
+	if attr.City != nil && !IsNull(attr.City) {
+		invalid = checkString(invalid, path+"city", *attr.City, 0, 255, nil, nil)
+	}
+	if attr.Region != nil && !IsNull(attr.Region) {
+		invalid = checkString(invalid, path+"region", *attr.Region, 0, 255, nil, nil)
+	}
+	if attr.PostalCode != nil && !IsNull(attr.PostalCode) {
+		invalid = checkString(invalid, path+"postal_code", *attr.PostalCode, 0, 20, nil, nil)
+	}
+	if attr.Country != nil && !IsNull(attr.Country) {
+		invalid = checkString(invalid, path+"country", *attr.Country, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
 type CouponCreate struct {
 	Params `json:"-"`
 
@@ -543,13 +1746,13 @@ type CouponCreate struct {
 	Code *string `json:"code,omitempty"`
 
 	// The type of discount provided by the coupon (how the amount discounted is calculated)
-	DiscountType *string `json:"discount_type,omitempty"`
+	DiscountType *DiscountType `json:"discount_type,omitempty"`
 
 	// The percent of the price discounted by the coupon.  Required if `discount_type` is `percent`.
 	DiscountPercent *int `json:"discount_percent,omitempty"`
 
 	// Description of the unit of time the coupon is for. Used with `free_trial_amount` to determine the duration of time the coupon is for.  Required if `discount_type` is `free_trial`.
-	FreeTrialUnit *string `json:"free_trial_unit,omitempty"`
+	FreeTrialUnit *FreeTrialUnit `json:"free_trial_unit,omitempty"`
 
 	// Sets the duration of time the `free_trial_unit` is for. Required if `discount_type` is `free_trial`.
 	FreeTrialAmount *int `json:"free_trial_amount,omitempty"`
@@ -570,16 +1773,16 @@ type CouponCreate struct {
 	// - "single_use" coupons applies to the first invoice only.
 	// - "temporal" coupons will apply to invoices for the duration determined by the `temporal_unit` and `temporal_amount` attributes.
 	// - "forever" coupons will apply to invoices forever.
-	Duration *string `json:"duration,omitempty"`
+	Duration *CouponDuration `json:"duration,omitempty"`
 
 	// If `duration` is "temporal" than `temporal_amount` is an integer which is multiplied by `temporal_unit` to define the duration that the coupon will be applied to invoices for.
 	TemporalAmount *int `json:"temporal_amount,omitempty"`
 
 	// If `duration` is "temporal" than `temporal_unit` is multiplied by `temporal_amount` to define the duration that the coupon will be applied to invoices for.
-	TemporalUnit *string `json:"temporal_unit,omitempty"`
+	TemporalUnit *TemporalUnit `json:"temporal_unit,omitempty"`
 
 	// Whether the coupon is "single_code" or "bulk". Bulk coupons will require a `unique_code_template` and will generate unique codes through the `/generate` endpoint.
-	CouponType *string `json:"coupon_type,omitempty"`
+	CouponType *CouponType `json:"coupon_type,omitempty"`
 
 	// On a bulk coupon, the template from which unique coupon codes are generated.
 	// - You must start the template with your coupon_code wrapped in single quotes.
@@ -591,7 +1794,7 @@ type CouponCreate struct {
 	UniqueCodeTemplate *string `json:"unique_code_template,omitempty"`
 
 	// Whether the discount is for all eligible charges on the account, or only a specific subscription.
-	RedemptionResource *string `json:"redemption_resource,omitempty"`
+	RedemptionResource *RedemptionResource `json:"redemption_resource,omitempty"`
 }
 
 func (attr *CouponCreate) toParams() *Params {
@@ -603,8 +1806,154 @@ func (attr *CouponCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *CouponCreate) Clone() *CouponCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.MaxRedemptions != nil && !IsNull(attr.MaxRedemptions) {
+		value := *attr.MaxRedemptions
+		clone.MaxRedemptions = &value
+	}
+	if attr.MaxRedemptionsPerAccount != nil && !IsNull(attr.MaxRedemptionsPerAccount) {
+		value := *attr.MaxRedemptionsPerAccount
+		clone.MaxRedemptionsPerAccount = &value
+	}
+	if attr.HostedDescription != nil && !IsNull(attr.HostedDescription) {
+		value := *attr.HostedDescription
+		clone.HostedDescription = &value
+	}
+	if attr.InvoiceDescription != nil && !IsNull(attr.InvoiceDescription) {
+		value := *attr.InvoiceDescription
+		clone.InvoiceDescription = &value
+	}
+	if attr.RedeemByDate != nil && !IsNull(attr.RedeemByDate) {
+		value := *attr.RedeemByDate
+		clone.RedeemByDate = &value
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.DiscountType != nil {
+		value := *attr.DiscountType
+		clone.DiscountType = &value
+	}
+	if attr.DiscountPercent != nil && !IsNull(attr.DiscountPercent) {
+		value := *attr.DiscountPercent
+		clone.DiscountPercent = &value
+	}
+	if attr.FreeTrialUnit != nil {
+		value := *attr.FreeTrialUnit
+		clone.FreeTrialUnit = &value
+	}
+	if attr.FreeTrialAmount != nil && !IsNull(attr.FreeTrialAmount) {
+		value := *attr.FreeTrialAmount
+		clone.FreeTrialAmount = &value
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]CouponPricing, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	if attr.AppliesToNonPlanCharges != nil && !IsNull(attr.AppliesToNonPlanCharges) {
+		value := *attr.AppliesToNonPlanCharges
+		clone.AppliesToNonPlanCharges = &value
+	}
+	if attr.AppliesToAllPlans != nil && !IsNull(attr.AppliesToAllPlans) {
+		value := *attr.AppliesToAllPlans
+		clone.AppliesToAllPlans = &value
+	}
+	if attr.PlanCodes != nil {
+		clone.PlanCodes = append(make([]string, 0, len(attr.PlanCodes)), attr.PlanCodes...)
+	}
+	if attr.Duration != nil {
+		value := *attr.Duration
+		clone.Duration = &value
+	}
+	if attr.TemporalAmount != nil && !IsNull(attr.TemporalAmount) {
+		value := *attr.TemporalAmount
+		clone.TemporalAmount = &value
+	}
+	if attr.TemporalUnit != nil {
+		value := *attr.TemporalUnit
+		clone.TemporalUnit = &value
+	}
+	if attr.CouponType != nil {
+		value := *attr.CouponType
+		clone.CouponType = &value
+	}
+	if attr.UniqueCodeTemplate != nil && !IsNull(attr.UniqueCodeTemplate) {
+		value := *attr.UniqueCodeTemplate
+		clone.UniqueCodeTemplate = &value
+	}
+	if attr.RedemptionResource != nil {
+		value := *attr.RedemptionResource
+		clone.RedemptionResource = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr CouponCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *CouponCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *CouponCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"name", attr.Name != nil && !IsNull(attr.Name))
+	if attr.InvoiceDescription != nil && !IsNull(attr.InvoiceDescription) {
+		invalid = checkString(invalid, path+"invoice_description", *attr.InvoiceDescription, 0, 255, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	invalid = checkRequired(invalid, path+"discount_type", attr.DiscountType != nil && *attr.DiscountType != "")
+	if attr.DiscountType != nil && *attr.DiscountType != "" {
+		invalid = checkString(invalid, path+"discount_type", string(*attr.DiscountType), 0, 0, nil, []string{"percent", "fixed", "free_trial"})
+	}
+	if attr.FreeTrialUnit != nil && *attr.FreeTrialUnit != "" {
+		invalid = checkString(invalid, path+"free_trial_unit", string(*attr.FreeTrialUnit), 0, 0, nil, []string{"day", "week", "month"})
+	}
+	if attr.FreeTrialAmount != nil && !IsNull(attr.FreeTrialAmount) {
+		invalid = checkNumber(invalid, path+"free_trial_amount", float64(*attr.FreeTrialAmount), Float(1), Float(9999))
+	}
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	if attr.Duration != nil && *attr.Duration != "" {
+		invalid = checkString(invalid, path+"duration", string(*attr.Duration), 0, 0, nil, []string{"forever", "single_use", "temporal"})
+	}
+	if attr.TemporalUnit != nil && *attr.TemporalUnit != "" {
+		invalid = checkString(invalid, path+"temporal_unit", string(*attr.TemporalUnit), 0, 0, nil, []string{"day", "week", "month", "year"})
+	}
+	if attr.CouponType != nil && *attr.CouponType != "" {
+		invalid = checkString(invalid, path+"coupon_type", string(*attr.CouponType), 0, 0, nil, []string{"single_code", "bulk"})
+	}
+	if attr.RedemptionResource != nil && *attr.RedemptionResource != "" {
+		invalid = checkString(invalid, path+"redemption_resource", string(*attr.RedemptionResource), 0, 0, nil, []string{"account", "subscription"})
+	}
+	return invalid
+}
+
 type CouponPricing struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// 3-letter ISO 4217 currency code.
 	Currency *string `json:"currency,omitempty"`
@@ -622,6 +1971,54 @@ func (attr *CouponPricing) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *CouponPricing) Clone() *CouponPricing {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.Discount != nil && !IsNull(attr.Discount) {
+		value := *attr.Discount
+		clone.Discount = &value
+	}
+	return &clone
+}
+
+// SetDiscountMoney sets Discount to the exact amount m, which is sent
+// with every digit of m, unless Discount is changed since.
+func (attr *CouponPricing) SetDiscountMoney(m Money) {
+	attr.Discount = attr.setMoney("discount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr CouponPricing) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *CouponPricing) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *CouponPricing) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 0, currencyPattern, nil)
+	}
+	return invalid
+}
+
 type CouponUpdate struct {
 	Params `json:"-"`
 
@@ -653,6 +2050,63 @@ func (attr *CouponUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *CouponUpdate) Clone() *CouponUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.MaxRedemptions != nil && !IsNull(attr.MaxRedemptions) {
+		value := *attr.MaxRedemptions
+		clone.MaxRedemptions = &value
+	}
+	if attr.MaxRedemptionsPerAccount != nil && !IsNull(attr.MaxRedemptionsPerAccount) {
+		value := *attr.MaxRedemptionsPerAccount
+		clone.MaxRedemptionsPerAccount = &value
+	}
+	if attr.HostedDescription != nil && !IsNull(attr.HostedDescription) {
+		value := *attr.HostedDescription
+		clone.HostedDescription = &value
+	}
+	if attr.InvoiceDescription != nil && !IsNull(attr.InvoiceDescription) {
+		value := *attr.InvoiceDescription
+		clone.InvoiceDescription = &value
+	}
+	if attr.RedeemByDate != nil && !IsNull(attr.RedeemByDate) {
+		value := *attr.RedeemByDate
+		clone.RedeemByDate = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr CouponUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *CouponUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *CouponUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.InvoiceDescription != nil && !IsNull(attr.InvoiceDescription) {
+		invalid = checkString(invalid, path+"invoice_description", *attr.InvoiceDescription, 0, 255, nil, nil)
+	}
+	return invalid
+}
+
 type CouponBulkCreate struct {
 	Params `json:"-"`
 
@@ -669,6 +2123,43 @@ func (attr *CouponBulkCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *CouponBulkCreate) Clone() *CouponBulkCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.NumberOfUniqueCodes != nil && !IsNull(attr.NumberOfUniqueCodes) {
+		value := *attr.NumberOfUniqueCodes
+		clone.NumberOfUniqueCodes = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr CouponBulkCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *CouponBulkCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *CouponBulkCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.NumberOfUniqueCodes != nil && !IsNull(attr.NumberOfUniqueCodes) {
+		invalid = checkNumber(invalid, path+"number_of_unique_codes", float64(*attr.NumberOfUniqueCodes), Float(1), Float(200))
+	}
+	return invalid
+}
+
 type ItemCreate struct {
 	Params `json:"-"`
 
@@ -688,7 +2179,7 @@ type ItemCreate struct {
 	AccountingCode *string `json:"accounting_code,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Used by Avalara, Vertex, and Recurly’s EU VAT tax feature. The tax code values are specific to each tax system. If you are using Recurly’s EU VAT feature you can use `unknown`, `physical`, or `digital`.
 	TaxCode *string `json:"tax_code,omitempty"`
@@ -697,7 +2188,7 @@ type ItemCreate struct {
 	TaxExempt *bool `json:"tax_exempt,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 
 	// Item Pricing
 	Currencies []PricingCreate `json:"currencies,omitempty"`
@@ -712,8 +2203,109 @@ func (attr *ItemCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ItemCreate) Clone() *ItemCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		value := *attr.Description
+		clone.Description = &value
+	}
+	if attr.ExternalSku != nil && !IsNull(attr.ExternalSku) {
+		value := *attr.ExternalSku
+		clone.ExternalSku = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]PricingCreate, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ItemCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ItemCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ItemCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, itemCodePattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"name", attr.Name != nil && !IsNull(attr.Name))
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 255, nil, nil)
+	}
+	if attr.ExternalSku != nil && !IsNull(attr.ExternalSku) {
+		invalid = checkString(invalid, path+"external_sku", *attr.ExternalSku, 0, 50, nil, nil)
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	return invalid
+}
+
 type PricingCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// 3-letter ISO 4217 currency code.
 	Currency *string `json:"currency,omitempty"`
@@ -731,6 +2323,59 @@ func (attr *PricingCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *PricingCreate) Clone() *PricingCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *PricingCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr PricingCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *PricingCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *PricingCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"currency", attr.Currency != nil && !IsNull(attr.Currency))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"unit_amount", attr.UnitAmount != nil && !IsNull(attr.UnitAmount))
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), Float(100000))
+	}
+	return invalid
+}
+
 type ItemUpdate struct {
 	Params `json:"-"`
 
@@ -750,7 +2395,7 @@ type ItemUpdate struct {
 	AccountingCode *string `json:"accounting_code,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Used by Avalara, Vertex, and Recurly’s EU VAT tax feature. The tax code values are specific to each tax system. If you are using Recurly’s EU VAT feature you can use `unknown`, `physical`, or `digital`.
 	TaxCode *string `json:"tax_code,omitempty"`
@@ -759,7 +2404,7 @@ type ItemUpdate struct {
 	TaxExempt *bool `json:"tax_exempt,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 
 	// Item Pricing
 	Currencies []PricingCreate `json:"currencies,omitempty"`
@@ -774,6 +2419,104 @@ func (attr *ItemUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ItemUpdate) Clone() *ItemUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		value := *attr.Description
+		clone.Description = &value
+	}
+	if attr.ExternalSku != nil && !IsNull(attr.ExternalSku) {
+		value := *attr.ExternalSku
+		clone.ExternalSku = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]PricingCreate, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ItemUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ItemUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ItemUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, itemCodePattern, nil)
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 255, nil, nil)
+	}
+	if attr.ExternalSku != nil && !IsNull(attr.ExternalSku) {
+		invalid = checkString(invalid, path+"external_sku", *attr.ExternalSku, 0, 50, nil, nil)
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	return invalid
+}
+
 type InvoiceUpdatable struct {
 	Params `json:"-"`
 
@@ -804,6 +2547,66 @@ func (attr *InvoiceUpdatable) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *InvoiceUpdatable) Clone() *InvoiceUpdatable {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		value := *attr.PoNumber
+		clone.PoNumber = &value
+	}
+	if attr.VatReverseChargeNotes != nil && !IsNull(attr.VatReverseChargeNotes) {
+		value := *attr.VatReverseChargeNotes
+		clone.VatReverseChargeNotes = &value
+	}
+	if attr.TermsAndConditions != nil && !IsNull(attr.TermsAndConditions) {
+		value := *attr.TermsAndConditions
+		clone.TermsAndConditions = &value
+	}
+	if attr.CustomerNotes != nil && !IsNull(attr.CustomerNotes) {
+		value := *attr.CustomerNotes
+		clone.CustomerNotes = &value
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		value := *attr.NetTerms
+		clone.NetTerms = &value
+	}
+	clone.Address = attr.Address.Clone()
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr InvoiceUpdatable) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *InvoiceUpdatable) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *InvoiceUpdatable) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		invalid = checkString(invalid, path+"po_number", *attr.PoNumber, 0, 50, nil, nil)
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		invalid = checkNumber(invalid, path+"net_terms", float64(*attr.NetTerms), Float(0), Float(999))
+	}
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	return invalid
+}
+
 type InvoiceAddressCreate struct {
 	Params `json:"-"`
 
@@ -850,14 +2653,88 @@ func (attr *InvoiceAddressCreate) toParams() *Params {
 	}
 }
 
-type InvoiceCollect struct {
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *InvoiceAddressCreate) Clone() *InvoiceAddressCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.NameOnAccount != nil && !IsNull(attr.NameOnAccount) {
+		value := *attr.NameOnAccount
+		clone.NameOnAccount = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Phone != nil && !IsNull(attr.Phone) {
+		value := *attr.Phone
+		clone.Phone = &value
+	}
+	if attr.Street1 != nil && !IsNull(attr.Street1) {
+		value := *attr.Street1
+		clone.Street1 = &value
+	}
+	if attr.Street2 != nil && !IsNull(attr.Street2) {
+		value := *attr.Street2
+		clone.Street2 = &value
+	}
+	if attr.City != nil && !IsNull(attr.City) {
+		value := *attr.City
+		clone.City = &value
+	}
+	if attr.Region != nil && !IsNull(attr.Region) {
+		value := *attr.Region
+		clone.Region = &value
+	}
+	if attr.PostalCode != nil && !IsNull(attr.PostalCode) {
+		value := *attr.PostalCode
+		clone.PostalCode = &value
+	}
+	if attr.Country != nil && !IsNull(attr.Country) {
+		value := *attr.Country
+		clone.Country = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr InvoiceAddressCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *InvoiceAddressCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *InvoiceAddressCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	return invalid
+}
+
+type InvoiceCollect struct {
 	Params `json:"-"`
 
 	// A token generated by Recurly.js after completing a 3-D Secure device fingerprinting or authentication challenge.
 	ThreeDSecureActionResultTokenId *string `json:"three_d_secure_action_result_token_id,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *InvoiceCollectTransactionType `json:"transaction_type,omitempty"`
 }
 
 func (attr *InvoiceCollect) toParams() *Params {
@@ -869,11 +2746,56 @@ func (attr *InvoiceCollect) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *InvoiceCollect) Clone() *InvoiceCollect {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.ThreeDSecureActionResultTokenId != nil && !IsNull(attr.ThreeDSecureActionResultTokenId) {
+		value := *attr.ThreeDSecureActionResultTokenId
+		clone.ThreeDSecureActionResultTokenId = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr InvoiceCollect) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *InvoiceCollect) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *InvoiceCollect) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.ThreeDSecureActionResultTokenId != nil && !IsNull(attr.ThreeDSecureActionResultTokenId) {
+		invalid = checkString(invalid, path+"three_d_secure_action_result_token_id", *attr.ThreeDSecureActionResultTokenId, 0, 22, nil, nil)
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	return invalid
+}
+
 type InvoiceRefund struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// The type of refund. Amount and line items cannot both be specified in the request.
-	Type *string `json:"type,omitempty"`
+	Type *InvoiceRefundType `json:"type,omitempty"`
 
 	// The amount to be refunded. The amount will be split between the line items.
 	// If no amount is specified, it will default to refunding the total refundable amount on the invoice.
@@ -887,7 +2809,7 @@ type InvoiceRefund struct {
 	// - `credit_first` – Issues credit back to the account first, then refunds any remaining amount back to the transaction. Default value when Credit Invoices feature is not enabled.
 	// - `all_credit` – Issues credit to the account for the entire amount of the refund. Only available when the Credit Invoices feature is enabled.
 	// - `all_transaction` – Refunds the entire amount back to transactions, using transactions from previous invoices if necessary. Only available when the Credit Invoices feature is enabled.
-	RefundMethod *string `json:"refund_method,omitempty"`
+	RefundMethod *RefundMethod `json:"refund_method,omitempty"`
 
 	// Used as the Customer Notes on the credit invoice.
 	// This field can only be include when the Credit Invoices feature is enabled.
@@ -911,6 +2833,79 @@ func (attr *InvoiceRefund) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *InvoiceRefund) Clone() *InvoiceRefund {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Type != nil {
+		value := *attr.Type
+		clone.Type = &value
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		value := *attr.Amount
+		clone.Amount = &value
+	}
+	if attr.LineItems != nil {
+		clone.LineItems = make([]LineItemRefund, len(attr.LineItems))
+		for i := range attr.LineItems {
+			clone.LineItems[i] = *attr.LineItems[i].Clone()
+		}
+	}
+	if attr.RefundMethod != nil {
+		value := *attr.RefundMethod
+		clone.RefundMethod = &value
+	}
+	if attr.CreditCustomerNotes != nil && !IsNull(attr.CreditCustomerNotes) {
+		value := *attr.CreditCustomerNotes
+		clone.CreditCustomerNotes = &value
+	}
+	clone.ExternalRefund = attr.ExternalRefund.Clone()
+	return &clone
+}
+
+// SetAmountMoney sets Amount to the exact amount m, which is sent
+// with every digit of m, unless Amount is changed since.
+func (attr *InvoiceRefund) SetAmountMoney(m Money) {
+	attr.Amount = attr.setMoney("amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr InvoiceRefund) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *InvoiceRefund) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *InvoiceRefund) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"type", attr.Type != nil && *attr.Type != "")
+	if attr.Type != nil && *attr.Type != "" {
+		invalid = checkString(invalid, path+"type", string(*attr.Type), 0, 0, nil, []string{"amount", "line_items"})
+	}
+	for i := range attr.LineItems {
+		invalid = attr.LineItems[i].validate(invalid, indexPath(path, "line_items", i)+".")
+	}
+	if attr.RefundMethod != nil && *attr.RefundMethod != "" {
+		invalid = checkString(invalid, path+"refund_method", string(*attr.RefundMethod), 0, 0, nil, []string{"transaction_first", "credit_first", "all_credit", "all_transaction"})
+	}
+	if attr.ExternalRefund != nil {
+		invalid = attr.ExternalRefund.validate(invalid, path+"external_refund.")
+	}
+	return invalid
+}
+
 type LineItemRefund struct {
 	Params `json:"-"`
 
@@ -934,11 +2929,56 @@ func (attr *LineItemRefund) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *LineItemRefund) Clone() *LineItemRefund {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Id != nil && !IsNull(attr.Id) {
+		value := *attr.Id
+		clone.Id = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	if attr.Prorate != nil && !IsNull(attr.Prorate) {
+		value := *attr.Prorate
+		clone.Prorate = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr LineItemRefund) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *LineItemRefund) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *LineItemRefund) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Id != nil && !IsNull(attr.Id) {
+		invalid = checkString(invalid, path+"id", *attr.Id, 0, 13, nil, nil)
+	}
+	return invalid
+}
+
 type ExternalRefund struct {
 	Params `json:"-"`
 
 	// Payment method used for external refund transaction.
-	PaymentMethod *string `json:"payment_method,omitempty"`
+	PaymentMethod *ExternalRefundPaymentMethod `json:"payment_method,omitempty"`
 
 	// Used as the refund transactions' description.
 	Description *string `json:"description,omitempty"`
@@ -956,6 +2996,55 @@ func (attr *ExternalRefund) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ExternalRefund) Clone() *ExternalRefund {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.PaymentMethod != nil {
+		value := *attr.PaymentMethod
+		clone.PaymentMethod = &value
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		value := *attr.Description
+		clone.Description = &value
+	}
+	if attr.RefundedAt != nil && !IsNull(attr.RefundedAt) {
+		value := *attr.RefundedAt
+		clone.RefundedAt = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ExternalRefund) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ExternalRefund) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ExternalRefund) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"payment_method", attr.PaymentMethod != nil && *attr.PaymentMethod != "")
+	if attr.PaymentMethod != nil && *attr.PaymentMethod != "" {
+		invalid = checkString(invalid, path+"payment_method", string(*attr.PaymentMethod), 0, 0, nil, []string{"credit_card", "paypal", "amazon", "roku", "ach", "apple_pay", "sepadirectdebit", "eft", "wire_transfer", "money_order", "check", "other"})
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		invalid = checkString(invalid, path+"description", *attr.Description, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
 type PlanCreate struct {
 	Params `json:"-"`
 
@@ -972,13 +3061,13 @@ type PlanCreate struct {
 	AccountingCode *string `json:"accounting_code,omitempty"`
 
 	// Unit for the plan's billing interval.
-	IntervalUnit *string `json:"interval_unit,omitempty"`
+	IntervalUnit *IntervalUnit `json:"interval_unit,omitempty"`
 
 	// Length of the plan's billing interval in `interval_unit`.
 	IntervalLength *int `json:"interval_length,omitempty"`
 
 	// Units for the plan's trial period.
-	TrialUnit *string `json:"trial_unit,omitempty"`
+	TrialUnit *TrialUnit `json:"trial_unit,omitempty"`
 
 	// Length of plan's trial period in `trial_units`. `0` means `no trial`.
 	TrialLength *int `json:"trial_length,omitempty"`
@@ -990,10 +3079,10 @@ type PlanCreate struct {
 	AutoRenew *bool `json:"auto_renew,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Setup fee revenue schedule type
-	SetupFeeRevenueScheduleType *string `json:"setup_fee_revenue_schedule_type,omitempty"`
+	SetupFeeRevenueScheduleType *SetupFeeRevenueScheduleType `json:"setup_fee_revenue_schedule_type,omitempty"`
 
 	// Accounting code for invoice line items for the plan's setup fee. If no value is provided, it defaults to plan's accounting code.
 	SetupFeeAccountingCode *string `json:"setup_fee_accounting_code,omitempty"`
@@ -1023,8 +3112,160 @@ func (attr *PlanCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *PlanCreate) Clone() *PlanCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		value := *attr.Description
+		clone.Description = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.IntervalUnit != nil {
+		value := *attr.IntervalUnit
+		clone.IntervalUnit = &value
+	}
+	if attr.IntervalLength != nil && !IsNull(attr.IntervalLength) {
+		value := *attr.IntervalLength
+		clone.IntervalLength = &value
+	}
+	if attr.TrialUnit != nil {
+		value := *attr.TrialUnit
+		clone.TrialUnit = &value
+	}
+	if attr.TrialLength != nil && !IsNull(attr.TrialLength) {
+		value := *attr.TrialLength
+		clone.TrialLength = &value
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		value := *attr.TotalBillingCycles
+		clone.TotalBillingCycles = &value
+	}
+	if attr.AutoRenew != nil && !IsNull(attr.AutoRenew) {
+		value := *attr.AutoRenew
+		clone.AutoRenew = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.SetupFeeRevenueScheduleType != nil {
+		value := *attr.SetupFeeRevenueScheduleType
+		clone.SetupFeeRevenueScheduleType = &value
+	}
+	if attr.SetupFeeAccountingCode != nil && !IsNull(attr.SetupFeeAccountingCode) {
+		value := *attr.SetupFeeAccountingCode
+		clone.SetupFeeAccountingCode = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]PlanPricingCreate, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	clone.HostedPages = attr.HostedPages.Clone()
+	if attr.AddOns != nil {
+		clone.AddOns = make([]AddOnCreate, len(attr.AddOns))
+		for i := range attr.AddOns {
+			clone.AddOns[i] = *attr.AddOns[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr PlanCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *PlanCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *PlanCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, codePattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"name", attr.Name != nil && !IsNull(attr.Name))
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 255, nil, nil)
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.IntervalUnit != nil && *attr.IntervalUnit != "" {
+		invalid = checkString(invalid, path+"interval_unit", string(*attr.IntervalUnit), 0, 0, nil, []string{"days", "months"})
+	}
+	if attr.IntervalLength != nil && !IsNull(attr.IntervalLength) {
+		invalid = checkNumber(invalid, path+"interval_length", float64(*attr.IntervalLength), Float(1), nil)
+	}
+	if attr.TrialUnit != nil && *attr.TrialUnit != "" {
+		invalid = checkString(invalid, path+"trial_unit", string(*attr.TrialUnit), 0, 0, nil, []string{"days", "months"})
+	}
+	if attr.TrialLength != nil && !IsNull(attr.TrialLength) {
+		invalid = checkNumber(invalid, path+"trial_length", float64(*attr.TrialLength), Float(0), nil)
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		invalid = checkNumber(invalid, path+"total_billing_cycles", float64(*attr.TotalBillingCycles), Float(0), nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.SetupFeeRevenueScheduleType != nil && *attr.SetupFeeRevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"setup_fee_revenue_schedule_type", string(*attr.SetupFeeRevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.SetupFeeAccountingCode != nil && !IsNull(attr.SetupFeeAccountingCode) {
+		invalid = checkString(invalid, path+"setup_fee_accounting_code", *attr.SetupFeeAccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"currencies", len(attr.Currencies) > 0)
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	if attr.HostedPages != nil {
+		invalid = attr.HostedPages.validate(invalid, path+"hosted_pages.")
+	}
+	for i := range attr.AddOns {
+		invalid = attr.AddOns[i].validate(invalid, indexPath(path, "add_ons", i)+".")
+	}
+	return invalid
+}
+
 type PlanPricingCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// 3-letter ISO 4217 currency code.
 	Currency *string `json:"currency,omitempty"`
@@ -1045,6 +3286,70 @@ func (attr *PlanPricingCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *PlanPricingCreate) Clone() *PlanPricingCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.SetupFee != nil && !IsNull(attr.SetupFee) {
+		value := *attr.SetupFee
+		clone.SetupFee = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	return &clone
+}
+
+// SetSetupFeeMoney sets SetupFee to the exact amount m, which is sent
+// with every digit of m, unless SetupFee is changed since.
+func (attr *PlanPricingCreate) SetSetupFeeMoney(m Money) {
+	attr.SetupFee = attr.setMoney("setup_fee", m)
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *PlanPricingCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr PlanPricingCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *PlanPricingCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *PlanPricingCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	if attr.SetupFee != nil && !IsNull(attr.SetupFee) {
+		invalid = checkNumber(invalid, path+"setup_fee", float64(*attr.SetupFee), Float(0), Float(100000))
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), Float(100000))
+	}
+	return invalid
+}
+
 type PlanHostedPagesCreate struct {
 	Params `json:"-"`
 
@@ -1070,6 +3375,52 @@ func (attr *PlanHostedPagesCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *PlanHostedPagesCreate) Clone() *PlanHostedPagesCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.SuccessUrl != nil && !IsNull(attr.SuccessUrl) {
+		value := *attr.SuccessUrl
+		clone.SuccessUrl = &value
+	}
+	if attr.CancelUrl != nil && !IsNull(attr.CancelUrl) {
+		value := *attr.CancelUrl
+		clone.CancelUrl = &value
+	}
+	if attr.BypassConfirmation != nil && !IsNull(attr.BypassConfirmation) {
+		value := *attr.BypassConfirmation
+		clone.BypassConfirmation = &value
+	}
+	if attr.DisplayQuantity != nil && !IsNull(attr.DisplayQuantity) {
+		value := *attr.DisplayQuantity
+		clone.DisplayQuantity = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr PlanHostedPagesCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *PlanHostedPagesCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *PlanHostedPagesCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	return invalid
+}
+
 type AddOnCreate struct {
 	Params `json:"-"`
 
@@ -1092,7 +3443,7 @@ type AddOnCreate struct {
 	AccountingCode *string `json:"accounting_code,omitempty"`
 
 	// When this add-on is invoiced, the line item will use this revenue schedule. If `item_code`/`item_id` is part of the request then `revenue_schedule_type` must be absent in the request as the value will be set from the item.
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Determines if the quantity field is displayed on the hosted pages for the add-on.
 	DisplayQuantity *bool `json:"display_quantity,omitempty"`
@@ -1116,8 +3467,115 @@ func (attr *AddOnCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AddOnCreate) Clone() *AddOnCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.ItemCode != nil && !IsNull(attr.ItemCode) {
+		value := *attr.ItemCode
+		clone.ItemCode = &value
+	}
+	if attr.ItemId != nil && !IsNull(attr.ItemId) {
+		value := *attr.ItemId
+		clone.ItemId = &value
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		value := *attr.PlanId
+		clone.PlanId = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.DisplayQuantity != nil && !IsNull(attr.DisplayQuantity) {
+		value := *attr.DisplayQuantity
+		clone.DisplayQuantity = &value
+	}
+	if attr.DefaultQuantity != nil && !IsNull(attr.DefaultQuantity) {
+		value := *attr.DefaultQuantity
+		clone.DefaultQuantity = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]AddOnPricingCreate, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AddOnCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AddOnCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AddOnCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.ItemCode != nil && !IsNull(attr.ItemCode) {
+		invalid = checkString(invalid, path+"item_code", *attr.ItemCode, 0, 50, itemCodePattern, nil)
+	}
+	if attr.ItemId != nil && !IsNull(attr.ItemId) {
+		invalid = checkString(invalid, path+"item_id", *attr.ItemId, 0, 13, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"name", attr.Name != nil && !IsNull(attr.Name))
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 255, nil, nil)
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		invalid = checkString(invalid, path+"plan_id", *attr.PlanId, 0, 13, nil, nil)
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"currencies", len(attr.Currencies) > 0)
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	return invalid
+}
+
 type AddOnPricingCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// 3-letter ISO 4217 currency code.
 	Currency *string `json:"currency,omitempty"`
@@ -1135,6 +3593,59 @@ func (attr *AddOnPricingCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AddOnPricingCreate) Clone() *AddOnPricingCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *AddOnPricingCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AddOnPricingCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AddOnPricingCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AddOnPricingCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"currency", attr.Currency != nil && !IsNull(attr.Currency))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"unit_amount", attr.UnitAmount != nil && !IsNull(attr.UnitAmount))
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), Float(100000))
+	}
+	return invalid
+}
+
 type PlanUpdate struct {
 	Params `json:"-"`
 
@@ -1154,7 +3665,7 @@ type PlanUpdate struct {
 	AccountingCode *string `json:"accounting_code,omitempty"`
 
 	// Units for the plan's trial period.
-	TrialUnit *string `json:"trial_unit,omitempty"`
+	TrialUnit *TrialUnit `json:"trial_unit,omitempty"`
 
 	// Length of plan's trial period in `trial_units`. `0` means `no trial`.
 	TrialLength *int `json:"trial_length,omitempty"`
@@ -1166,10 +3677,10 @@ type PlanUpdate struct {
 	AutoRenew *bool `json:"auto_renew,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Setup fee revenue schedule type
-	SetupFeeRevenueScheduleType *string `json:"setup_fee_revenue_schedule_type,omitempty"`
+	SetupFeeRevenueScheduleType *SetupFeeRevenueScheduleType `json:"setup_fee_revenue_schedule_type,omitempty"`
 
 	// Accounting code for invoice line items for the plan's setup fee. If no value is provided, it defaults to plan's accounting code.
 	SetupFeeAccountingCode *string `json:"setup_fee_accounting_code,omitempty"`
@@ -1199,6 +3710,147 @@ func (attr *PlanUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *PlanUpdate) Clone() *PlanUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Id != nil && !IsNull(attr.Id) {
+		value := *attr.Id
+		clone.Id = &value
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.Description != nil && !IsNull(attr.Description) {
+		value := *attr.Description
+		clone.Description = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.TrialUnit != nil {
+		value := *attr.TrialUnit
+		clone.TrialUnit = &value
+	}
+	if attr.TrialLength != nil && !IsNull(attr.TrialLength) {
+		value := *attr.TrialLength
+		clone.TrialLength = &value
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		value := *attr.TotalBillingCycles
+		clone.TotalBillingCycles = &value
+	}
+	if attr.AutoRenew != nil && !IsNull(attr.AutoRenew) {
+		value := *attr.AutoRenew
+		clone.AutoRenew = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.SetupFeeRevenueScheduleType != nil {
+		value := *attr.SetupFeeRevenueScheduleType
+		clone.SetupFeeRevenueScheduleType = &value
+	}
+	if attr.SetupFeeAccountingCode != nil && !IsNull(attr.SetupFeeAccountingCode) {
+		value := *attr.SetupFeeAccountingCode
+		clone.SetupFeeAccountingCode = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]PlanPricingCreate, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	clone.HostedPages = attr.HostedPages.Clone()
+	if attr.AddOns != nil {
+		clone.AddOns = make([]AddOnCreate, len(attr.AddOns))
+		for i := range attr.AddOns {
+			clone.AddOns[i] = *attr.AddOns[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr PlanUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *PlanUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *PlanUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Id != nil && !IsNull(attr.Id) {
+		invalid = checkString(invalid, path+"id", *attr.Id, 0, 13, nil, nil)
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, codePattern, nil)
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 255, nil, nil)
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.TrialUnit != nil && *attr.TrialUnit != "" {
+		invalid = checkString(invalid, path+"trial_unit", string(*attr.TrialUnit), 0, 0, nil, []string{"days", "months"})
+	}
+	if attr.TrialLength != nil && !IsNull(attr.TrialLength) {
+		invalid = checkNumber(invalid, path+"trial_length", float64(*attr.TrialLength), Float(0), nil)
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		invalid = checkNumber(invalid, path+"total_billing_cycles", float64(*attr.TotalBillingCycles), Float(0), nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.SetupFeeRevenueScheduleType != nil && *attr.SetupFeeRevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"setup_fee_revenue_schedule_type", string(*attr.SetupFeeRevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.SetupFeeAccountingCode != nil && !IsNull(attr.SetupFeeAccountingCode) {
+		invalid = checkString(invalid, path+"setup_fee_accounting_code", *attr.SetupFeeAccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	if attr.HostedPages != nil {
+		invalid = attr.HostedPages.validate(invalid, path+"hosted_pages.")
+	}
+	for i := range attr.AddOns {
+		invalid = attr.AddOns[i].validate(invalid, indexPath(path, "add_ons", i)+".")
+	}
+	return invalid
+}
+
 type AddOnUpdate struct {
 	Params `json:"-"`
 
@@ -1215,7 +3867,7 @@ type AddOnUpdate struct {
 	AccountingCode *string `json:"accounting_code,omitempty"`
 
 	// When this add-on is invoiced, the line item will use this revenue schedule. If an `Item` is associated to the `AddOn` then `revenue_schedule_type` must be absent in the request as the value will be set from the item.
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Optional field used by Avalara, Vertex, and Recurly's EU VAT tax feature to determine taxation rules. If you have your own AvaTax or Vertex account configured, use their tax codes to assign specific tax rules. If you are using Recurly's EU VAT feature, you can use values of `unknown`, `physical`, or `digital`. If an `Item` is associated to the `AddOn` then `tax code` must be absent.
 	TaxCode *string `json:"tax_code,omitempty"`
@@ -1239,14 +3891,104 @@ func (attr *AddOnUpdate) toParams() *Params {
 	}
 }
 
-type SubscriptionCreate struct {
-	Params `json:"-"`
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AddOnUpdate) Clone() *AddOnUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Id != nil && !IsNull(attr.Id) {
+		value := *attr.Id
+		clone.Id = &value
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		value := *attr.Name
+		clone.Name = &value
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		value := *attr.AccountingCode
+		clone.AccountingCode = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		value := *attr.TaxCode
+		clone.TaxCode = &value
+	}
+	if attr.DisplayQuantity != nil && !IsNull(attr.DisplayQuantity) {
+		value := *attr.DisplayQuantity
+		clone.DisplayQuantity = &value
+	}
+	if attr.DefaultQuantity != nil && !IsNull(attr.DefaultQuantity) {
+		value := *attr.DefaultQuantity
+		clone.DefaultQuantity = &value
+	}
+	if attr.Currencies != nil {
+		clone.Currencies = make([]AddOnPricingCreate, len(attr.Currencies))
+		for i := range attr.Currencies {
+			clone.Currencies[i] = *attr.Currencies[i].Clone()
+		}
+	}
+	return &clone
+}
 
-	// You must provide either a `plan_code` or `plan_id`. If both are provided the `plan_id` will be used.
-	PlanCode *string `json:"plan_code,omitempty"`
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AddOnUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
 
-	// You must provide either a `plan_code` or `plan_id`. If both are provided the `plan_id` will be used.
-	PlanId *string `json:"plan_id,omitempty"`
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AddOnUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AddOnUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Id != nil && !IsNull(attr.Id) {
+		invalid = checkString(invalid, path+"id", *attr.Id, 0, 13, nil, nil)
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, nil, nil)
+	}
+	if attr.Name != nil && !IsNull(attr.Name) {
+		invalid = checkString(invalid, path+"name", *attr.Name, 0, 255, nil, nil)
+	}
+	if attr.AccountingCode != nil && !IsNull(attr.AccountingCode) {
+		invalid = checkString(invalid, path+"accounting_code", *attr.AccountingCode, 0, 20, itemCodePattern, nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.TaxCode != nil && !IsNull(attr.TaxCode) {
+		invalid = checkString(invalid, path+"tax_code", *attr.TaxCode, 0, 50, nil, nil)
+	}
+	for i := range attr.Currencies {
+		invalid = attr.Currencies[i].validate(invalid, indexPath(path, "currencies", i)+".")
+	}
+	return invalid
+}
+
+type SubscriptionCreate struct {
+	Params `json:"-"`
+	exactAmounts
+
+	// You must provide either a `plan_code` or `plan_id`. If both are provided the `plan_id` will be used.
+	PlanCode *string `json:"plan_code,omitempty"`
+
+	// You must provide either a `plan_code` or `plan_id`. If both are provided the `plan_id` will be used.
+	PlanId *string `json:"plan_id,omitempty"`
 
 	Account *AccountCreate `json:"account,omitempty"`
 
@@ -1254,7 +3996,7 @@ type SubscriptionCreate struct {
 	Shipping *SubscriptionShippingCreate `json:"shipping,omitempty"`
 
 	// Collection method
-	CollectionMethod *string `json:"collection_method,omitempty"`
+	CollectionMethod *CollectionMethod `json:"collection_method,omitempty"`
 
 	// 3-letter ISO 4217 currency code.
 	Currency *string `json:"currency,omitempty"`
@@ -1272,7 +4014,7 @@ type SubscriptionCreate struct {
 	CouponCode *string `json:"coupon_code,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 
 	// If set, overrides the default trial behavior for the subscription. The date must be in the future.
 	TrialEndsAt *time.Time `json:"trial_ends_at,omitempty"`
@@ -1293,7 +4035,7 @@ type SubscriptionCreate struct {
 	AutoRenew *bool `json:"auto_renew,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// This will default to the Terms and Conditions text specified on the Invoice Settings page in your Recurly admin. Specify custom notes to add or override Terms and Conditions. Custom notes will stay with a subscription on all renewals.
 	TermsAndConditions *string `json:"terms_and_conditions,omitempty"`
@@ -1311,7 +4053,7 @@ type SubscriptionCreate struct {
 	NetTerms *int `json:"net_terms,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *SubscriptionTransactionType `json:"transaction_type,omitempty"`
 }
 
 func (attr *SubscriptionCreate) toParams() *Params {
@@ -1323,8 +4065,188 @@ func (attr *SubscriptionCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionCreate) Clone() *SubscriptionCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.PlanCode != nil && !IsNull(attr.PlanCode) {
+		value := *attr.PlanCode
+		clone.PlanCode = &value
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		value := *attr.PlanId
+		clone.PlanId = &value
+	}
+	clone.Account = attr.Account.Clone()
+	clone.Shipping = attr.Shipping.Clone()
+	if attr.CollectionMethod != nil {
+		value := *attr.CollectionMethod
+		clone.CollectionMethod = &value
+	}
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	if attr.AddOns != nil {
+		clone.AddOns = make([]SubscriptionAddOnCreate, len(attr.AddOns))
+		for i := range attr.AddOns {
+			clone.AddOns[i] = *attr.AddOns[i].Clone()
+		}
+	}
+	if attr.CouponCode != nil && !IsNull(attr.CouponCode) {
+		value := *attr.CouponCode
+		clone.CouponCode = &value
+	}
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	if attr.TrialEndsAt != nil && !IsNull(attr.TrialEndsAt) {
+		value := *attr.TrialEndsAt
+		clone.TrialEndsAt = &value
+	}
+	if attr.StartsAt != nil && !IsNull(attr.StartsAt) {
+		value := *attr.StartsAt
+		clone.StartsAt = &value
+	}
+	if attr.NextBillDate != nil && !IsNull(attr.NextBillDate) {
+		value := *attr.NextBillDate
+		clone.NextBillDate = &value
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		value := *attr.TotalBillingCycles
+		clone.TotalBillingCycles = &value
+	}
+	if attr.RenewalBillingCycles != nil && !IsNull(attr.RenewalBillingCycles) {
+		value := *attr.RenewalBillingCycles
+		clone.RenewalBillingCycles = &value
+	}
+	if attr.AutoRenew != nil && !IsNull(attr.AutoRenew) {
+		value := *attr.AutoRenew
+		clone.AutoRenew = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.TermsAndConditions != nil && !IsNull(attr.TermsAndConditions) {
+		value := *attr.TermsAndConditions
+		clone.TermsAndConditions = &value
+	}
+	if attr.CustomerNotes != nil && !IsNull(attr.CustomerNotes) {
+		value := *attr.CustomerNotes
+		clone.CustomerNotes = &value
+	}
+	if attr.CreditCustomerNotes != nil && !IsNull(attr.CreditCustomerNotes) {
+		value := *attr.CreditCustomerNotes
+		clone.CreditCustomerNotes = &value
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		value := *attr.PoNumber
+		clone.PoNumber = &value
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		value := *attr.NetTerms
+		clone.NetTerms = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *SubscriptionCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkOneOf(invalid, path, []string{"plan_code", "plan_id"}, attr.PlanCode != nil, attr.PlanId != nil)
+	if attr.PlanCode != nil && !IsNull(attr.PlanCode) {
+		invalid = checkString(invalid, path+"plan_code", *attr.PlanCode, 0, 50, nil, nil)
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		invalid = checkString(invalid, path+"plan_id", *attr.PlanId, 0, 13, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"account", attr.Account != nil)
+	if attr.Account != nil {
+		invalid = attr.Account.validate(invalid, path+"account.")
+	}
+	if attr.Shipping != nil {
+		invalid = attr.Shipping.validate(invalid, path+"shipping.")
+	}
+	if attr.CollectionMethod != nil && *attr.CollectionMethod != "" {
+		invalid = checkString(invalid, path+"collection_method", string(*attr.CollectionMethod), 0, 0, nil, []string{"automatic", "manual"})
+	}
+	invalid = checkRequired(invalid, path+"currency", attr.Currency != nil && !IsNull(attr.Currency))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), Float(100000))
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		invalid = checkNumber(invalid, path+"quantity", float64(*attr.Quantity), Float(0), nil)
+	}
+	for i := range attr.AddOns {
+		invalid = attr.AddOns[i].validate(invalid, indexPath(path, "add_ons", i)+".")
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		invalid = checkNumber(invalid, path+"total_billing_cycles", float64(*attr.TotalBillingCycles), Float(1), nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		invalid = checkString(invalid, path+"po_number", *attr.PoNumber, 0, 50, nil, nil)
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		invalid = checkNumber(invalid, path+"net_terms", float64(*attr.NetTerms), Float(0), nil)
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	return invalid
+}
+
 type SubscriptionShippingCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	Address *ShippingAddressCreate `json:"address,omitempty"`
 
@@ -1350,8 +4272,75 @@ func (attr *SubscriptionShippingCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionShippingCreate) Clone() *SubscriptionShippingCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	clone.Address = attr.Address.Clone()
+	if attr.AddressId != nil && !IsNull(attr.AddressId) {
+		value := *attr.AddressId
+		clone.AddressId = &value
+	}
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		value := *attr.MethodId
+		clone.MethodId = &value
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		value := *attr.MethodCode
+		clone.MethodCode = &value
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		value := *attr.Amount
+		clone.Amount = &value
+	}
+	return &clone
+}
+
+// SetAmountMoney sets Amount to the exact amount m, which is sent
+// with every digit of m, unless Amount is changed since.
+func (attr *SubscriptionShippingCreate) SetAmountMoney(m Money) {
+	attr.Amount = attr.setMoney("amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionShippingCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionShippingCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionShippingCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	if attr.AddressId != nil && !IsNull(attr.AddressId) {
+		invalid = checkString(invalid, path+"address_id", *attr.AddressId, 0, 13, nil, nil)
+	}
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		invalid = checkString(invalid, path+"method_id", *attr.MethodId, 0, 13, nil, nil)
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		invalid = checkString(invalid, path+"method_code", *attr.MethodCode, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
 type SubscriptionAddOnCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// Add-on code
 	Code *string `json:"code,omitempty"`
@@ -1363,7 +4352,7 @@ type SubscriptionAddOnCreate struct {
 	UnitAmount *float64 `json:"unit_amount,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 }
 
 func (attr *SubscriptionAddOnCreate) toParams() *Params {
@@ -1375,14 +4364,80 @@ func (attr *SubscriptionAddOnCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionAddOnCreate) Clone() *SubscriptionAddOnCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *SubscriptionAddOnCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionAddOnCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionAddOnCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionAddOnCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, nil, nil)
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		invalid = checkNumber(invalid, path+"quantity", float64(*attr.Quantity), Float(0), nil)
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	return invalid
+}
+
 type SubscriptionUpdate struct {
 	Params `json:"-"`
 
 	// Change collection method
-	CollectionMethod *string `json:"collection_method,omitempty"`
+	CollectionMethod *CollectionMethod `json:"collection_method,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 
 	// The remaining billing cycles in the current term.
 	RemainingBillingCycles *int `json:"remaining_billing_cycles,omitempty"`
@@ -1397,7 +4452,7 @@ type SubscriptionUpdate struct {
 	NextBillDate *time.Time `json:"next_bill_date,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Specify custom notes to add or override Terms and Conditions. Custom notes will stay with a subscription on all renewals.
 	TermsAndConditions *string `json:"terms_and_conditions,omitempty"`
@@ -1424,6 +4479,101 @@ func (attr *SubscriptionUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionUpdate) Clone() *SubscriptionUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.CollectionMethod != nil {
+		value := *attr.CollectionMethod
+		clone.CollectionMethod = &value
+	}
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	if attr.RemainingBillingCycles != nil && !IsNull(attr.RemainingBillingCycles) {
+		value := *attr.RemainingBillingCycles
+		clone.RemainingBillingCycles = &value
+	}
+	if attr.RenewalBillingCycles != nil && !IsNull(attr.RenewalBillingCycles) {
+		value := *attr.RenewalBillingCycles
+		clone.RenewalBillingCycles = &value
+	}
+	if attr.AutoRenew != nil && !IsNull(attr.AutoRenew) {
+		value := *attr.AutoRenew
+		clone.AutoRenew = &value
+	}
+	if attr.NextBillDate != nil && !IsNull(attr.NextBillDate) {
+		value := *attr.NextBillDate
+		clone.NextBillDate = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.TermsAndConditions != nil && !IsNull(attr.TermsAndConditions) {
+		value := *attr.TermsAndConditions
+		clone.TermsAndConditions = &value
+	}
+	if attr.CustomerNotes != nil && !IsNull(attr.CustomerNotes) {
+		value := *attr.CustomerNotes
+		clone.CustomerNotes = &value
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		value := *attr.PoNumber
+		clone.PoNumber = &value
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		value := *attr.NetTerms
+		clone.NetTerms = &value
+	}
+	clone.Shipping = attr.Shipping.Clone()
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.CollectionMethod != nil && *attr.CollectionMethod != "" {
+		invalid = checkString(invalid, path+"collection_method", string(*attr.CollectionMethod), 0, 0, nil, []string{"automatic", "manual"})
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		invalid = checkString(invalid, path+"po_number", *attr.PoNumber, 0, 50, nil, nil)
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		invalid = checkNumber(invalid, path+"net_terms", float64(*attr.NetTerms), Float(0), nil)
+	}
+	if attr.Shipping != nil {
+		invalid = attr.Shipping.validate(invalid, path+"shipping.")
+	}
+	return invalid
+}
+
 type SubscriptionShippingUpdate struct {
 	Params `json:"-"`
 
@@ -1445,11 +4595,56 @@ func (attr *SubscriptionShippingUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionShippingUpdate) Clone() *SubscriptionShippingUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Object != nil && !IsNull(attr.Object) {
+		value := *attr.Object
+		clone.Object = &value
+	}
+	clone.Address = attr.Address.Clone()
+	if attr.AddressId != nil && !IsNull(attr.AddressId) {
+		value := *attr.AddressId
+		clone.AddressId = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionShippingUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionShippingUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionShippingUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	if attr.AddressId != nil && !IsNull(attr.AddressId) {
+		invalid = checkString(invalid, path+"address_id", *attr.AddressId, 0, 13, nil, nil)
+	}
+	return invalid
+}
+
 type SubscriptionCancel struct {
 	Params `json:"-"`
 
 	// The timeframe parameter controls when the expiration takes place. The `bill_date` timeframe causes the subscription to expire when the subscription is scheduled to bill next. The `term_end` timeframe causes the subscription to continue to bill until the end of the subscription term, then expire.
-	Timeframe *string `json:"timeframe,omitempty"`
+	Timeframe *SubscriptionTimeframe `json:"timeframe,omitempty"`
 }
 
 func (attr *SubscriptionCancel) toParams() *Params {
@@ -1461,6 +4656,43 @@ func (attr *SubscriptionCancel) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionCancel) Clone() *SubscriptionCancel {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Timeframe != nil {
+		value := *attr.Timeframe
+		clone.Timeframe = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionCancel) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionCancel) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionCancel) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Timeframe != nil && *attr.Timeframe != "" {
+		invalid = checkString(invalid, path+"timeframe", string(*attr.Timeframe), 0, 0, nil, []string{"bill_date", "term_end"})
+	}
+	return invalid
+}
+
 type SubscriptionPause struct {
 	Params `json:"-"`
 
@@ -1477,11 +4709,47 @@ func (attr *SubscriptionPause) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionPause) Clone() *SubscriptionPause {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.RemainingPauseCycles != nil && !IsNull(attr.RemainingPauseCycles) {
+		value := *attr.RemainingPauseCycles
+		clone.RemainingPauseCycles = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionPause) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionPause) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionPause) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"remaining_pause_cycles", attr.RemainingPauseCycles != nil && !IsNull(attr.RemainingPauseCycles))
+	return invalid
+}
+
 type SubscriptionChangeCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// The timeframe parameter controls when the upgrade or downgrade takes place. The subscription change can occur now, when the subscription is next billed, or when the subscription term ends. Generally, if you're performing an upgrade, you will want the change to occur immediately (now). If you're performing a downgrade, you should set the timeframe to `term_end` or `bill_date` so the change takes effect at a scheduled billing date. The `renewal` timeframe option is accepted as an alias for `term_end`.
-	Timeframe *string `json:"timeframe,omitempty"`
+	Timeframe *SubscriptionChangeTimeframe `json:"timeframe,omitempty"`
 
 	// If you want to change to a new plan, you can provide the plan's code or id. If both are provided the `plan_id` will be used.
 	PlanId *string `json:"plan_id,omitempty"`
@@ -1505,10 +4773,10 @@ type SubscriptionChangeCreate struct {
 	AddOns []SubscriptionAddOnUpdate `json:"add_ons,omitempty"`
 
 	// Collection method
-	CollectionMethod *string `json:"collection_method,omitempty"`
+	CollectionMethod *CollectionMethod `json:"collection_method,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// For manual invoicing, this identifies the PO number associated with the subscription.
 	PoNumber *string `json:"po_number,omitempty"`
@@ -1517,7 +4785,7 @@ type SubscriptionChangeCreate struct {
 	NetTerms *int `json:"net_terms,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *SubscriptionChangeTransactionType `json:"transaction_type,omitempty"`
 }
 
 func (attr *SubscriptionChangeCreate) toParams() *Params {
@@ -1529,8 +4797,132 @@ func (attr *SubscriptionChangeCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionChangeCreate) Clone() *SubscriptionChangeCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Timeframe != nil {
+		value := *attr.Timeframe
+		clone.Timeframe = &value
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		value := *attr.PlanId
+		clone.PlanId = &value
+	}
+	if attr.PlanCode != nil && !IsNull(attr.PlanCode) {
+		value := *attr.PlanCode
+		clone.PlanCode = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	clone.Shipping = attr.Shipping.Clone()
+	if attr.CouponCodes != nil {
+		clone.CouponCodes = append(make([]string, 0, len(attr.CouponCodes)), attr.CouponCodes...)
+	}
+	if attr.AddOns != nil {
+		clone.AddOns = make([]SubscriptionAddOnUpdate, len(attr.AddOns))
+		for i := range attr.AddOns {
+			clone.AddOns[i] = *attr.AddOns[i].Clone()
+		}
+	}
+	if attr.CollectionMethod != nil {
+		value := *attr.CollectionMethod
+		clone.CollectionMethod = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		value := *attr.PoNumber
+		clone.PoNumber = &value
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		value := *attr.NetTerms
+		clone.NetTerms = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *SubscriptionChangeCreate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionChangeCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionChangeCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionChangeCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Timeframe != nil && *attr.Timeframe != "" {
+		invalid = checkString(invalid, path+"timeframe", string(*attr.Timeframe), 0, 0, nil, []string{"now", "bill_date", "term_end", "renewal"})
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		invalid = checkString(invalid, path+"plan_id", *attr.PlanId, 0, 13, nil, nil)
+	}
+	if attr.PlanCode != nil && !IsNull(attr.PlanCode) {
+		invalid = checkString(invalid, path+"plan_code", *attr.PlanCode, 0, 50, nil, nil)
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), Float(100000))
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		invalid = checkNumber(invalid, path+"quantity", float64(*attr.Quantity), Float(0), nil)
+	}
+	if attr.Shipping != nil {
+		invalid = attr.Shipping.validate(invalid, path+"shipping.")
+	}
+	for i := range attr.AddOns {
+		invalid = attr.AddOns[i].validate(invalid, indexPath(path, "add_ons", i)+".")
+	}
+	if attr.CollectionMethod != nil && *attr.CollectionMethod != "" {
+		invalid = checkString(invalid, path+"collection_method", string(*attr.CollectionMethod), 0, 0, nil, []string{"automatic", "manual"})
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		invalid = checkString(invalid, path+"po_number", *attr.PoNumber, 0, 50, nil, nil)
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		invalid = checkNumber(invalid, path+"net_terms", float64(*attr.NetTerms), Float(0), nil)
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	return invalid
+}
+
 type SubscriptionChangeShippingCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// The id of the shipping method used to deliver the subscription. To remove shipping set this to `null` and the `amount=0`. If `method_id` and `method_code` are both present, `method_id` will be used.
 	MethodId *string `json:"method_id,omitempty"`
@@ -1551,8 +4943,64 @@ func (attr *SubscriptionChangeShippingCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionChangeShippingCreate) Clone() *SubscriptionChangeShippingCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		value := *attr.MethodId
+		clone.MethodId = &value
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		value := *attr.MethodCode
+		clone.MethodCode = &value
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		value := *attr.Amount
+		clone.Amount = &value
+	}
+	return &clone
+}
+
+// SetAmountMoney sets Amount to the exact amount m, which is sent
+// with every digit of m, unless Amount is changed since.
+func (attr *SubscriptionChangeShippingCreate) SetAmountMoney(m Money) {
+	attr.Amount = attr.setMoney("amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionChangeShippingCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionChangeShippingCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionChangeShippingCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		invalid = checkString(invalid, path+"method_id", *attr.MethodId, 0, 13, nil, nil)
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		invalid = checkString(invalid, path+"method_code", *attr.MethodCode, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
 type SubscriptionAddOnUpdate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// Set this to include or modify an existing subscription add-on.
 	Id *string `json:"id,omitempty"`
@@ -1567,7 +5015,7 @@ type SubscriptionAddOnUpdate struct {
 	UnitAmount *float64 `json:"unit_amount,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 }
 
 func (attr *SubscriptionAddOnUpdate) toParams() *Params {
@@ -1579,6 +5027,78 @@ func (attr *SubscriptionAddOnUpdate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionAddOnUpdate) Clone() *SubscriptionAddOnUpdate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.Id != nil && !IsNull(attr.Id) {
+		value := *attr.Id
+		clone.Id = &value
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *SubscriptionAddOnUpdate) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionAddOnUpdate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionAddOnUpdate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionAddOnUpdate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Id != nil && !IsNull(attr.Id) {
+		invalid = checkString(invalid, path+"id", *attr.Id, 0, 13, nil, nil)
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, nil, nil)
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		invalid = checkNumber(invalid, path+"quantity", float64(*attr.Quantity), Float(0), nil)
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	return invalid
+}
+
 type PurchaseCreate struct {
 	Params `json:"-"`
 
@@ -1588,7 +5108,7 @@ type PurchaseCreate struct {
 	Account *AccountPurchase `json:"account,omitempty"`
 
 	// Must be set to manual in order to preview a purchase for an Account that does not have payment information associated with the Billing Info.
-	CollectionMethod *string `json:"collection_method,omitempty"`
+	CollectionMethod *CollectionMethod `json:"collection_method,omitempty"`
 
 	// For manual invoicing, this identifies the PO number associated with the subscription.
 	PoNumber *string `json:"po_number,omitempty"`
@@ -1626,7 +5146,7 @@ type PurchaseCreate struct {
 	GiftCardRedemptionCode *string `json:"gift_card_redemption_code,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *PurchaseTransactionType `json:"transaction_type,omitempty"`
 }
 
 func (attr *PurchaseCreate) toParams() *Params {
@@ -1638,6 +5158,129 @@ func (attr *PurchaseCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *PurchaseCreate) Clone() *PurchaseCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		value := *attr.Currency
+		clone.Currency = &value
+	}
+	clone.Account = attr.Account.Clone()
+	if attr.CollectionMethod != nil {
+		value := *attr.CollectionMethod
+		clone.CollectionMethod = &value
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		value := *attr.PoNumber
+		clone.PoNumber = &value
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		value := *attr.NetTerms
+		clone.NetTerms = &value
+	}
+	if attr.TermsAndConditions != nil && !IsNull(attr.TermsAndConditions) {
+		value := *attr.TermsAndConditions
+		clone.TermsAndConditions = &value
+	}
+	if attr.CustomerNotes != nil && !IsNull(attr.CustomerNotes) {
+		value := *attr.CustomerNotes
+		clone.CustomerNotes = &value
+	}
+	if attr.VatReverseChargeNotes != nil && !IsNull(attr.VatReverseChargeNotes) {
+		value := *attr.VatReverseChargeNotes
+		clone.VatReverseChargeNotes = &value
+	}
+	if attr.CreditCustomerNotes != nil && !IsNull(attr.CreditCustomerNotes) {
+		value := *attr.CreditCustomerNotes
+		clone.CreditCustomerNotes = &value
+	}
+	if attr.GatewayCode != nil && !IsNull(attr.GatewayCode) {
+		value := *attr.GatewayCode
+		clone.GatewayCode = &value
+	}
+	clone.Shipping = attr.Shipping.Clone()
+	if attr.LineItems != nil {
+		clone.LineItems = make([]LineItemCreate, len(attr.LineItems))
+		for i := range attr.LineItems {
+			clone.LineItems[i] = *attr.LineItems[i].Clone()
+		}
+	}
+	if attr.Subscriptions != nil {
+		clone.Subscriptions = make([]SubscriptionPurchase, len(attr.Subscriptions))
+		for i := range attr.Subscriptions {
+			clone.Subscriptions[i] = *attr.Subscriptions[i].Clone()
+		}
+	}
+	if attr.CouponCodes != nil {
+		clone.CouponCodes = append(make([]string, 0, len(attr.CouponCodes)), attr.CouponCodes...)
+	}
+	if attr.GiftCardRedemptionCode != nil && !IsNull(attr.GiftCardRedemptionCode) {
+		value := *attr.GiftCardRedemptionCode
+		clone.GiftCardRedemptionCode = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr PurchaseCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *PurchaseCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *PurchaseCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkRequired(invalid, path+"currency", attr.Currency != nil && !IsNull(attr.Currency))
+	if attr.Currency != nil && !IsNull(attr.Currency) {
+		invalid = checkString(invalid, path+"currency", *attr.Currency, 0, 3, currencyPattern, nil)
+	}
+	invalid = checkRequired(invalid, path+"account", attr.Account != nil)
+	if attr.Account != nil {
+		invalid = attr.Account.validate(invalid, path+"account.")
+	}
+	if attr.CollectionMethod != nil && *attr.CollectionMethod != "" {
+		invalid = checkString(invalid, path+"collection_method", string(*attr.CollectionMethod), 0, 0, nil, []string{"automatic", "manual"})
+	}
+	if attr.PoNumber != nil && !IsNull(attr.PoNumber) {
+		invalid = checkString(invalid, path+"po_number", *attr.PoNumber, 0, 50, nil, nil)
+	}
+	if attr.NetTerms != nil && !IsNull(attr.NetTerms) {
+		invalid = checkNumber(invalid, path+"net_terms", float64(*attr.NetTerms), Float(0), nil)
+	}
+	if attr.GatewayCode != nil && !IsNull(attr.GatewayCode) {
+		invalid = checkString(invalid, path+"gateway_code", *attr.GatewayCode, 0, 13, nil, nil)
+	}
+	if attr.Shipping != nil {
+		invalid = attr.Shipping.validate(invalid, path+"shipping.")
+	}
+	for i := range attr.LineItems {
+		invalid = attr.LineItems[i].validate(invalid, indexPath(path, "line_items", i)+".")
+	}
+	for i := range attr.Subscriptions {
+		invalid = attr.Subscriptions[i].validate(invalid, indexPath(path, "subscriptions", i)+".")
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	return invalid
+}
+
 type AccountPurchase struct {
 	Params `json:"-"`
 
@@ -1656,7 +5299,7 @@ type AccountPurchase struct {
 	Email *string `json:"email,omitempty"`
 
 	// Used to determine the language and locale of emails sent on behalf of the merchant to the customer. The list of locales is restricted to those the merchant has enabled on the site.
-	PreferredLocale *string `json:"preferred_locale,omitempty"`
+	PreferredLocale *PreferredLocale `json:"preferred_locale,omitempty"`
 
 	// Additional email address that should receive account correspondence. These should be separated only by commas. These CC emails will receive all emails that the `email` field also receives.
 	CcEmails *string `json:"cc_emails,omitempty"`
@@ -1683,17 +5326,17 @@ type AccountPurchase struct {
 	ParentAccountId *string `json:"parent_account_id,omitempty"`
 
 	// An enumerable describing the billing behavior of the account, specifically whether the account is self-paying or will rely on the parent account to pay.
-	BillTo *string `json:"bill_to,omitempty"`
+	BillTo *BillTo `json:"bill_to,omitempty"`
 
 	// An optional type designation for the payment gateway transaction created by this request. Supports 'moto' value, which is the acronym for mail order and telephone transactions.
-	TransactionType *string `json:"transaction_type,omitempty"`
+	TransactionType *AccountTransactionType `json:"transaction_type,omitempty"`
 
 	Address *AddressCreate `json:"address,omitempty"`
 
 	BillingInfo *BillingInfoCreate `json:"billing_info,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 }
 
 func (attr *AccountPurchase) toParams() *Params {
@@ -1705,6 +5348,167 @@ func (attr *AccountPurchase) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *AccountPurchase) Clone() *AccountPurchase {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.Id != nil && !IsNull(attr.Id) {
+		value := *attr.Id
+		clone.Id = &value
+	}
+	if attr.Code != nil && !IsNull(attr.Code) {
+		value := *attr.Code
+		clone.Code = &value
+	}
+	clone.Acquisition = attr.Acquisition.Clone()
+	if attr.Username != nil && !IsNull(attr.Username) {
+		value := *attr.Username
+		clone.Username = &value
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		value := *attr.Email
+		clone.Email = &value
+	}
+	if attr.PreferredLocale != nil {
+		value := *attr.PreferredLocale
+		clone.PreferredLocale = &value
+	}
+	if attr.CcEmails != nil && !IsNull(attr.CcEmails) {
+		value := *attr.CcEmails
+		clone.CcEmails = &value
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		value := *attr.FirstName
+		clone.FirstName = &value
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		value := *attr.LastName
+		clone.LastName = &value
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		value := *attr.Company
+		clone.Company = &value
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		value := *attr.VatNumber
+		clone.VatNumber = &value
+	}
+	if attr.TaxExempt != nil && !IsNull(attr.TaxExempt) {
+		value := *attr.TaxExempt
+		clone.TaxExempt = &value
+	}
+	if attr.ExemptionCertificate != nil && !IsNull(attr.ExemptionCertificate) {
+		value := *attr.ExemptionCertificate
+		clone.ExemptionCertificate = &value
+	}
+	if attr.ParentAccountCode != nil && !IsNull(attr.ParentAccountCode) {
+		value := *attr.ParentAccountCode
+		clone.ParentAccountCode = &value
+	}
+	if attr.ParentAccountId != nil && !IsNull(attr.ParentAccountId) {
+		value := *attr.ParentAccountId
+		clone.ParentAccountId = &value
+	}
+	if attr.BillTo != nil {
+		value := *attr.BillTo
+		clone.BillTo = &value
+	}
+	if attr.TransactionType != nil {
+		value := *attr.TransactionType
+		clone.TransactionType = &value
+	}
+	clone.Address = attr.Address.Clone()
+	clone.BillingInfo = attr.BillingInfo.Clone()
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr AccountPurchase) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *AccountPurchase) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *AccountPurchase) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.Id != nil && !IsNull(attr.Id) {
+		invalid = checkString(invalid, path+"id", *attr.Id, 0, 13, nil, nil)
+	}
+	invalid = checkRequired(invalid, path+"code", attr.Code != nil && !IsNull(attr.Code))
+	if attr.Code != nil && !IsNull(attr.Code) {
+		invalid = checkString(invalid, path+"code", *attr.Code, 0, 50, nil, nil)
+	}
+	if attr.Acquisition != nil {
+		invalid = attr.Acquisition.validate(invalid, path+"acquisition.")
+	}
+	if attr.Username != nil && !IsNull(attr.Username) {
+		invalid = checkString(invalid, path+"username", *attr.Username, 0, 255, nil, nil)
+	}
+	if attr.Email != nil && !IsNull(attr.Email) {
+		invalid = checkString(invalid, path+"email", *attr.Email, 0, 255, nil, nil)
+	}
+	if attr.PreferredLocale != nil && *attr.PreferredLocale != "" {
+		invalid = checkString(invalid, path+"preferred_locale", string(*attr.PreferredLocale), 0, 0, nil, []string{"da-DK", "de-CH", "de-DE", "en-AU", "en-CA", "en-GB", "en-NZ", "en-US", "es-ES", "es-MX", "es-US", "fr-CA", "fr-FR", "hi-IN", "ja-JP", "nl-BE", "nl-NL", "pt-BR", "pt-PT", "ru-RU", "tr-TR", "zh-CN"})
+	}
+	if attr.CcEmails != nil && !IsNull(attr.CcEmails) {
+		invalid = checkString(invalid, path+"cc_emails", *attr.CcEmails, 0, 255, nil, nil)
+	}
+	if attr.FirstName != nil && !IsNull(attr.FirstName) {
+		invalid = checkString(invalid, path+"first_name", *attr.FirstName, 0, 255, nil, nil)
+	}
+	if attr.LastName != nil && !IsNull(attr.LastName) {
+		invalid = checkString(invalid, path+"last_name", *attr.LastName, 0, 255, nil, nil)
+	}
+	if attr.Company != nil && !IsNull(attr.Company) {
+		invalid = checkString(invalid, path+"company", *attr.Company, 0, 50, nil, nil)
+	}
+	if attr.VatNumber != nil && !IsNull(attr.VatNumber) {
+		invalid = checkString(invalid, path+"vat_number", *attr.VatNumber, 0, 20, nil, nil)
+	}
+	if attr.ExemptionCertificate != nil && !IsNull(attr.ExemptionCertificate) {
+		invalid = checkString(invalid, path+"exemption_certificate", *attr.ExemptionCertificate, 0, 30, nil, nil)
+	}
+	if attr.ParentAccountCode != nil && !IsNull(attr.ParentAccountCode) {
+		invalid = checkString(invalid, path+"parent_account_code", *attr.ParentAccountCode, 0, 50, nil, nil)
+	}
+	if attr.ParentAccountId != nil && !IsNull(attr.ParentAccountId) {
+		invalid = checkString(invalid, path+"parent_account_id", *attr.ParentAccountId, 0, 13, nil, nil)
+	}
+	if attr.BillTo != nil && *attr.BillTo != "" {
+		invalid = checkString(invalid, path+"bill_to", string(*attr.BillTo), 0, 6, nil, []string{"self", "parent"})
+	}
+	if attr.TransactionType != nil && *attr.TransactionType != "" {
+		invalid = checkString(invalid, path+"transaction_type", string(*attr.TransactionType), 0, 0, nil, []string{"moto"})
+	}
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	if attr.BillingInfo != nil {
+		invalid = attr.BillingInfo.validate(invalid, path+"billing_info.")
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	return invalid
+}
+
 type ShippingPurchase struct {
 	Params `json:"-"`
 
@@ -1726,8 +5530,59 @@ func (attr *ShippingPurchase) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ShippingPurchase) Clone() *ShippingPurchase {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	if attr.AddressId != nil && !IsNull(attr.AddressId) {
+		value := *attr.AddressId
+		clone.AddressId = &value
+	}
+	clone.Address = attr.Address.Clone()
+	if attr.Fees != nil {
+		clone.Fees = make([]ShippingFeeCreate, len(attr.Fees))
+		for i := range attr.Fees {
+			clone.Fees[i] = *attr.Fees[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ShippingPurchase) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ShippingPurchase) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ShippingPurchase) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.AddressId != nil && !IsNull(attr.AddressId) {
+		invalid = checkString(invalid, path+"address_id", *attr.AddressId, 0, 13, nil, nil)
+	}
+	if attr.Address != nil {
+		invalid = attr.Address.validate(invalid, path+"address.")
+	}
+	for i := range attr.Fees {
+		invalid = attr.Fees[i].validate(invalid, indexPath(path, "fees", i)+".")
+	}
+	return invalid
+}
+
 type ShippingFeeCreate struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// The id of the shipping method used to deliver the purchase. If `method_id` and `method_code` are both present, `method_id` will be used.
 	MethodId *string `json:"method_id,omitempty"`
@@ -1748,8 +5603,67 @@ func (attr *ShippingFeeCreate) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *ShippingFeeCreate) Clone() *ShippingFeeCreate {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		value := *attr.MethodId
+		clone.MethodId = &value
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		value := *attr.MethodCode
+		clone.MethodCode = &value
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		value := *attr.Amount
+		clone.Amount = &value
+	}
+	return &clone
+}
+
+// SetAmountMoney sets Amount to the exact amount m, which is sent
+// with every digit of m, unless Amount is changed since.
+func (attr *ShippingFeeCreate) SetAmountMoney(m Money) {
+	attr.Amount = attr.setMoney("amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr ShippingFeeCreate) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *ShippingFeeCreate) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *ShippingFeeCreate) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		invalid = checkString(invalid, path+"method_id", *attr.MethodId, 0, 13, nil, nil)
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		invalid = checkString(invalid, path+"method_code", *attr.MethodCode, 0, 50, nil, nil)
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		invalid = checkNumber(invalid, path+"amount", float64(*attr.Amount), Float(0), nil)
+	}
+	return invalid
+}
+
 type SubscriptionPurchase struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// Plan code
 	PlanCode *string `json:"plan_code,omitempty"`
@@ -1767,7 +5681,7 @@ type SubscriptionPurchase struct {
 	AddOns []SubscriptionAddOnCreate `json:"add_ons,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomFieldCreate `json:"custom_fields,omitempty"`
+	CustomFields CustomFieldsCreate `json:"custom_fields,omitempty"`
 
 	// Create a shipping address on the account and assign it to the subscription.
 	Shipping *SubscriptionShippingPurchase `json:"shipping,omitempty"`
@@ -1788,7 +5702,7 @@ type SubscriptionPurchase struct {
 	AutoRenew *bool `json:"auto_renew,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType *string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType *RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 }
 
 func (attr *SubscriptionPurchase) toParams() *Params {
@@ -1800,8 +5714,124 @@ func (attr *SubscriptionPurchase) toParams() *Params {
 	}
 }
 
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionPurchase) Clone() *SubscriptionPurchase {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.PlanCode != nil && !IsNull(attr.PlanCode) {
+		value := *attr.PlanCode
+		clone.PlanCode = &value
+	}
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		value := *attr.PlanId
+		clone.PlanId = &value
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		value := *attr.UnitAmount
+		clone.UnitAmount = &value
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		value := *attr.Quantity
+		clone.Quantity = &value
+	}
+	if attr.AddOns != nil {
+		clone.AddOns = make([]SubscriptionAddOnCreate, len(attr.AddOns))
+		for i := range attr.AddOns {
+			clone.AddOns[i] = *attr.AddOns[i].Clone()
+		}
+	}
+	if attr.CustomFields != nil {
+		clone.CustomFields = make(CustomFieldsCreate, len(attr.CustomFields))
+		for i := range attr.CustomFields {
+			clone.CustomFields[i] = *attr.CustomFields[i].Clone()
+		}
+	}
+	clone.Shipping = attr.Shipping.Clone()
+	if attr.TrialEndsAt != nil && !IsNull(attr.TrialEndsAt) {
+		value := *attr.TrialEndsAt
+		clone.TrialEndsAt = &value
+	}
+	if attr.NextBillDate != nil && !IsNull(attr.NextBillDate) {
+		value := *attr.NextBillDate
+		clone.NextBillDate = &value
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		value := *attr.TotalBillingCycles
+		clone.TotalBillingCycles = &value
+	}
+	if attr.RenewalBillingCycles != nil && !IsNull(attr.RenewalBillingCycles) {
+		value := *attr.RenewalBillingCycles
+		clone.RenewalBillingCycles = &value
+	}
+	if attr.AutoRenew != nil && !IsNull(attr.AutoRenew) {
+		value := *attr.AutoRenew
+		clone.AutoRenew = &value
+	}
+	if attr.RevenueScheduleType != nil {
+		value := *attr.RevenueScheduleType
+		clone.RevenueScheduleType = &value
+	}
+	return &clone
+}
+
+// SetUnitAmountMoney sets UnitAmount to the exact amount m, which is sent
+// with every digit of m, unless UnitAmount is changed since.
+func (attr *SubscriptionPurchase) SetUnitAmountMoney(m Money) {
+	attr.UnitAmount = attr.setMoney("unit_amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionPurchase) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionPurchase) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionPurchase) validate(invalid []ErrorParam, path string) []ErrorParam {
+	invalid = checkOneOf(invalid, path, []string{"plan_code", "plan_id"}, attr.PlanCode != nil, attr.PlanId != nil)
+	if attr.PlanId != nil && !IsNull(attr.PlanId) {
+		invalid = checkString(invalid, path+"plan_id", *attr.PlanId, 0, 13, nil, nil)
+	}
+	if attr.UnitAmount != nil && !IsNull(attr.UnitAmount) {
+		invalid = checkNumber(invalid, path+"unit_amount", float64(*attr.UnitAmount), Float(0), Float(100000))
+	}
+	if attr.Quantity != nil && !IsNull(attr.Quantity) {
+		invalid = checkNumber(invalid, path+"quantity", float64(*attr.Quantity), Float(0), nil)
+	}
+	for i := range attr.AddOns {
+		invalid = attr.AddOns[i].validate(invalid, indexPath(path, "add_ons", i)+".")
+	}
+	for i := range attr.CustomFields {
+		invalid = attr.CustomFields[i].validate(invalid, indexPath(path, "custom_fields", i)+".")
+	}
+	if attr.Shipping != nil {
+		invalid = attr.Shipping.validate(invalid, path+"shipping.")
+	}
+	if attr.TotalBillingCycles != nil && !IsNull(attr.TotalBillingCycles) {
+		invalid = checkNumber(invalid, path+"total_billing_cycles", float64(*attr.TotalBillingCycles), Float(1), nil)
+	}
+	if attr.RevenueScheduleType != nil && *attr.RevenueScheduleType != "" {
+		invalid = checkString(invalid, path+"revenue_schedule_type", string(*attr.RevenueScheduleType), 0, 0, nil, []string{"never", "evenly", "at_range_end", "at_range_start"})
+	}
+	return invalid
+}
+
 type SubscriptionShippingPurchase struct {
 	Params `json:"-"`
+	exactAmounts
 
 	// The id of the shipping method used to deliver the subscription. If `method_id` and `method_code` are both present, `method_id` will be used.
 	MethodId *string `json:"method_id,omitempty"`
@@ -1821,3 +5851,65 @@ func (attr *SubscriptionShippingPurchase) toParams() *Params {
 		Data:           attr,
 	}
 }
+
+// Clone returns a deep copy of the request, which shares no data with it.
+// Fields set to a Null pointer stay null, and the context of the params is
+// kept.
+func (attr *SubscriptionShippingPurchase) Clone() *SubscriptionShippingPurchase {
+	if attr == nil {
+		return nil
+	}
+	clone := *attr
+	clone.Params = attr.Params.clone()
+	clone.exactAmounts = attr.exactAmounts.clone()
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		value := *attr.MethodId
+		clone.MethodId = &value
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		value := *attr.MethodCode
+		clone.MethodCode = &value
+	}
+	if attr.Amount != nil && !IsNull(attr.Amount) {
+		value := *attr.Amount
+		clone.Amount = &value
+	}
+	return &clone
+}
+
+// SetAmountMoney sets Amount to the exact amount m, which is sent
+// with every digit of m, unless Amount is changed since.
+func (attr *SubscriptionShippingPurchase) SetAmountMoney(m Money) {
+	attr.Amount = attr.setMoney("amount", m)
+}
+
+// MarshalJSON encodes the request, sending the fields set to a Null pointer as null
+func (attr SubscriptionShippingPurchase) MarshalJSON() ([]byte, error) {
+	return marshalRequest(&attr)
+}
+
+// Validate checks the request against the rules of the API spec: required
+// fields, lengths, patterns, enums and ranges. It returns an *Error of type
+// ErrorTypeValidation listing the invalid fields, or nil.
+func (attr *SubscriptionShippingPurchase) Validate() error {
+	return requestError(attr.validate(nil, ""))
+}
+
+// validate appends the invalid fields of the request to invalid, prefixed
+// with the path of the request in the body
+func (attr *SubscriptionShippingPurchase) validate(invalid []ErrorParam, path string) []ErrorParam {
+	if attr.MethodId != nil && !IsNull(attr.MethodId) {
+		invalid = checkString(invalid, path+"method_id", *attr.MethodId, 0, 13, nil, nil)
+	}
+	if attr.MethodCode != nil && !IsNull(attr.MethodCode) {
+		invalid = checkString(invalid, path+"method_code", *attr.MethodCode, 0, 50, nil, nil)
+	}
+	return invalid
+}
+
+var (
+	currencyPattern = regexp.MustCompile(`(?i)^[a-z]{3}$`)
+	namePattern     = regexp.MustCompile(`(?i)^[a-z0-9_-]+$`)
+	itemCodePattern = regexp.MustCompile(`^[a-z0-9_+-]+$`)
+	codePattern     = regexp.MustCompile(`(?i)^[a-z0-9_+-]+$`)
+)
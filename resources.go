@@ -1,12 +1,12 @@
+// Code generated by recurlygen from openapi/api.yaml. DO NOT EDIT.
+
 package recurly
 
-import (
-	"net/http"
-	"time"
-)
+import "encoding/json"
 
 type Site struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Site ID
 	Id string `json:"id,omitempty"`
@@ -20,23 +20,23 @@ type Site struct {
 	PublicApiKey string `json:"public_api_key,omitempty"`
 
 	// Mode
-	Mode string `json:"mode,omitempty"`
+	Mode SiteMode `json:"mode,omitempty"`
 
 	Address Address `json:"address,omitempty"`
 
 	Settings Settings `json:"settings,omitempty"`
 
 	// A list of features enabled for the site.
-	Features []string `json:"features,omitempty"`
+	Features []SiteFeature `json:"features,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Deleted at
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -49,6 +49,51 @@ func (resource *Site) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Site) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Site) UnmarshalJSON(data []byte) error {
+	type plain Site
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Site) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Site) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Site) Clone() *Site {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Address = *resource.Address.Clone()
+	clone.Settings = *resource.Settings.Clone()
+	if resource.Features != nil {
+		clone.Features = append(make([]SiteFeature, 0, len(resource.Features)), resource.Features...)
+	}
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type siteList struct {
 	ListMetadata
@@ -66,10 +111,11 @@ func (resource *siteList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// SiteList allows you to paginate Site objects
+// SiteList allows you to paginate Site objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type SiteList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Site
@@ -77,13 +123,12 @@ type SiteList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *SiteList) Fetch() error {
-	resources := &siteList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &siteList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*siteList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -91,17 +136,45 @@ func (list *SiteList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *SiteList) Count() (*int64, error) {
-	resources := &siteList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &siteList{} })
+}
+
+// Next advances to the next Site, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *SiteList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Site, or nil if Next has not returned true
+func (list *SiteList) Item() *Site {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeSiteList.
+func (list *SiteList) Cursor() string {
+	return list.cursor("SiteList", list.HasMore)
+}
+
+// ResumeSiteList returns the SiteList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeSiteList(cursor string, params *Params) (*SiteList, error) {
+	p, hasMore, err := resumePager(c, "SiteList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &SiteList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Address struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// First name
 	FirstName string `json:"first_name,omitempty"`
@@ -141,6 +214,46 @@ func (resource *Address) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Address) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Address) UnmarshalJSON(data []byte) error {
+	type plain Address
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Address) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Address) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Address) Clone() *Address {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type addressList struct {
 	ListMetadata
@@ -158,10 +271,11 @@ func (resource *addressList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AddressList allows you to paginate Address objects
+// AddressList allows you to paginate Address objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AddressList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Address
@@ -169,13 +283,12 @@ type AddressList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AddressList) Fetch() error {
-	resources := &addressList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &addressList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*addressList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -183,23 +296,51 @@ func (list *AddressList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AddressList) Count() (*int64, error) {
-	resources := &addressList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &addressList{} })
+}
+
+// Next advances to the next Address, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AddressList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Address, or nil if Next has not returned true
+func (list *AddressList) Item() *Address {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAddressList.
+func (list *AddressList) Cursor() string {
+	return list.cursor("AddressList", list.HasMore)
+}
+
+// ResumeAddressList returns the AddressList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAddressList(cursor string, params *Params) (*AddressList, error) {
+	p, hasMore, err := resumePager(c, "AddressList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AddressList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Settings struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// - full:      Full Address (Street, City, State, Postal Code and Country)
 	// - streetzip: Street and Postal Code only
 	// - zip:       Postal Code only
 	// - none:      No Address
-	BillingAddressRequirement string `json:"billing_address_requirement,omitempty"`
+	BillingAddressRequirement BillingAddressRequirement `json:"billing_address_requirement,omitempty"`
 
 	AcceptedCurrencies []string `json:"accepted_currencies,omitempty"`
 
@@ -217,6 +358,49 @@ func (resource *Settings) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Settings) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Settings) UnmarshalJSON(data []byte) error {
+	type plain Settings
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Settings) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Settings) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Settings) Clone() *Settings {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.AcceptedCurrencies != nil {
+		clone.AcceptedCurrencies = append(make([]string, 0, len(resource.AcceptedCurrencies)), resource.AcceptedCurrencies...)
+	}
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type settingsList struct {
 	ListMetadata
@@ -234,10 +418,11 @@ func (resource *settingsList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// SettingsList allows you to paginate Settings objects
+// SettingsList allows you to paginate Settings objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type SettingsList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Settings
@@ -245,13 +430,12 @@ type SettingsList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *SettingsList) Fetch() error {
-	resources := &settingsList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &settingsList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*settingsList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -259,17 +443,45 @@ func (list *SettingsList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *SettingsList) Count() (*int64, error) {
-	resources := &settingsList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &settingsList{} })
+}
+
+// Next advances to the next Settings, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *SettingsList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Settings, or nil if Next has not returned true
+func (list *SettingsList) Item() *Settings {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeSettingsList.
+func (list *SettingsList) Cursor() string {
+	return list.cursor("SettingsList", list.HasMore)
+}
+
+// ResumeSettingsList returns the SettingsList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeSettingsList(cursor string, params *Params) (*SettingsList, error) {
+	p, hasMore, err := resumePager(c, "SettingsList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &SettingsList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Account struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Id string `json:"id,omitempty"`
 
@@ -277,7 +489,7 @@ type Account struct {
 	Object string `json:"object,omitempty"`
 
 	// Accounts can be either active or inactive.
-	State string `json:"state,omitempty"`
+	State AccountState `json:"state,omitempty"`
 
 	// The unique token for automatically logging the account in to the hosted management pages. You may automatically log the user into their hosted management pages by directing the user to: `https://{subdomain}.recurly.com/account/{hosted_login_token}`.
 	HostedLoginToken string `json:"hosted_login_token,omitempty"`
@@ -304,13 +516,13 @@ type Account struct {
 	HasPastDueInvoice bool `json:"has_past_due_invoice,omitempty"`
 
 	// When the account was created.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// When the account was last changed.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// If present, when the account was last marked inactive.
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 
 	// The unique identifier of the account. This cannot be changed once the account is created.
 	Code string `json:"code,omitempty"`
@@ -322,7 +534,7 @@ type Account struct {
 	Email string `json:"email,omitempty"`
 
 	// Used to determine the language and locale of emails sent on behalf of the merchant to the customer.
-	PreferredLocale string `json:"preferred_locale,omitempty"`
+	PreferredLocale PreferredLocale `json:"preferred_locale,omitempty"`
 
 	// Additional email address that should receive account correspondence. These should be separated only by commas. These CC emails will receive all emails that the `email` field also receives.
 	CcEmails string `json:"cc_emails,omitempty"`
@@ -346,14 +558,14 @@ type Account struct {
 	ParentAccountId string `json:"parent_account_id,omitempty"`
 
 	// An enumerable describing the billing behavior of the account, specifically whether the account is self-paying or will rely on the parent account to pay.
-	BillTo string `json:"bill_to,omitempty"`
+	BillTo BillTo `json:"bill_to,omitempty"`
 
 	Address Address `json:"address,omitempty"`
 
 	BillingInfo BillingInfo `json:"billing_info,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomField `json:"custom_fields,omitempty"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -366,6 +578,60 @@ func (resource *Account) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Account) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Account) UnmarshalJSON(data []byte) error {
+	type plain Account
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Account) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Account) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Account) Clone() *Account {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.ShippingAddresses != nil {
+		clone.ShippingAddresses = make([]ShippingAddress, len(resource.ShippingAddresses))
+		for i := range resource.ShippingAddresses {
+			clone.ShippingAddresses[i] = *resource.ShippingAddresses[i].Clone()
+		}
+	}
+	clone.Address = *resource.Address.Clone()
+	clone.BillingInfo = *resource.BillingInfo.Clone()
+	if resource.CustomFields != nil {
+		clone.CustomFields = make(CustomFields, len(resource.CustomFields))
+		for i := range resource.CustomFields {
+			clone.CustomFields[i] = *resource.CustomFields[i].Clone()
+		}
+	}
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type accountList struct {
 	ListMetadata
@@ -383,10 +649,11 @@ func (resource *accountList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountList allows you to paginate Account objects
+// AccountList allows you to paginate Account objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Account
@@ -394,13 +661,12 @@ type AccountList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountList) Fetch() error {
-	resources := &accountList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -408,17 +674,45 @@ func (list *AccountList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountList) Count() (*int64, error) {
-	resources := &accountList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountList{} })
+}
+
+// Next advances to the next Account, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Account, or nil if Next has not returned true
+func (list *AccountList) Item() *Account {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountList.
+func (list *AccountList) Cursor() string {
+	return list.cursor("AccountList", list.HasMore)
+}
+
+// ResumeAccountList returns the AccountList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountList(cursor string, params *Params) (*AccountList, error) {
+	p, hasMore, err := resumePager(c, "AccountList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type ShippingAddress struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Shipping Address ID
 	Id string `json:"id,omitempty"`
@@ -459,10 +753,10 @@ type ShippingAddress struct {
 	Country string `json:"country,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -475,6 +769,46 @@ func (resource *ShippingAddress) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource ShippingAddress) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *ShippingAddress) UnmarshalJSON(data []byte) error {
+	type plain ShippingAddress
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *ShippingAddress) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *ShippingAddress) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *ShippingAddress) Clone() *ShippingAddress {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type shippingAddressList struct {
 	ListMetadata
@@ -492,10 +826,11 @@ func (resource *shippingAddressList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// ShippingAddressList allows you to paginate ShippingAddress objects
+// ShippingAddressList allows you to paginate ShippingAddress objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type ShippingAddressList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []ShippingAddress
@@ -503,13 +838,12 @@ type ShippingAddressList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *ShippingAddressList) Fetch() error {
-	resources := &shippingAddressList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &shippingAddressList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*shippingAddressList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -517,17 +851,45 @@ func (list *ShippingAddressList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *ShippingAddressList) Count() (*int64, error) {
-	resources := &shippingAddressList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &shippingAddressList{} })
+}
+
+// Next advances to the next ShippingAddress, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *ShippingAddressList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current ShippingAddress, or nil if Next has not returned true
+func (list *ShippingAddressList) Item() *ShippingAddress {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeShippingAddressList.
+func (list *ShippingAddressList) Cursor() string {
+	return list.cursor("ShippingAddressList", list.HasMore)
+}
+
+// ResumeShippingAddressList returns the ShippingAddressList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeShippingAddressList(cursor string, params *Params) (*ShippingAddressList, error) {
+	p, hasMore, err := resumePager(c, "ShippingAddressList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &ShippingAddressList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type BillingInfo struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Id string `json:"id,omitempty"`
 
@@ -555,10 +917,10 @@ type BillingInfo struct {
 	Fraud FraudInfo `json:"fraud,omitempty"`
 
 	// When the billing information was created.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// When the billing information was last changed.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	UpdatedBy BillingInfoUpdatedBy `json:"updated_by,omitempty"`
 }
@@ -573,6 +935,50 @@ func (resource *BillingInfo) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource BillingInfo) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *BillingInfo) UnmarshalJSON(data []byte) error {
+	type plain BillingInfo
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *BillingInfo) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *BillingInfo) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *BillingInfo) Clone() *BillingInfo {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Address = *resource.Address.Clone()
+	clone.PaymentMethod = *resource.PaymentMethod.Clone()
+	clone.Fraud = *resource.Fraud.Clone()
+	clone.UpdatedBy = *resource.UpdatedBy.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type billingInfoList struct {
 	ListMetadata
@@ -590,10 +996,11 @@ func (resource *billingInfoList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// BillingInfoList allows you to paginate BillingInfo objects
+// BillingInfoList allows you to paginate BillingInfo objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type BillingInfoList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []BillingInfo
@@ -601,13 +1008,12 @@ type BillingInfoList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *BillingInfoList) Fetch() error {
-	resources := &billingInfoList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &billingInfoList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*billingInfoList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -615,22 +1021,50 @@ func (list *BillingInfoList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *BillingInfoList) Count() (*int64, error) {
-	resources := &billingInfoList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &billingInfoList{} })
+}
+
+// Next advances to the next BillingInfo, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *BillingInfoList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current BillingInfo, or nil if Next has not returned true
+func (list *BillingInfoList) Item() *BillingInfo {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeBillingInfoList.
+func (list *BillingInfoList) Cursor() string {
+	return list.cursor("BillingInfoList", list.HasMore)
+}
+
+// ResumeBillingInfoList returns the BillingInfoList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeBillingInfoList(cursor string, params *Params) (*BillingInfoList, error) {
+	p, hasMore, err := resumePager(c, "BillingInfoList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &BillingInfoList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type PaymentMethod struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
-	Object string `json:"object,omitempty"`
+	Object PaymentMethodObject `json:"object,omitempty"`
 
 	// Visa, MasterCard, American Express, Discover, JCB, etc.
-	CardType string `json:"card_type,omitempty"`
+	CardType CardType `json:"card_type,omitempty"`
 
 	// Credit card number's first six digits.
 	FirstSix string `json:"first_six,omitempty"`
@@ -657,7 +1091,7 @@ type PaymentMethod struct {
 	BillingAgreementId string `json:"billing_agreement_id,omitempty"`
 
 	// The bank account type. Only present for ACH payment methods.
-	AccountType string `json:"account_type,omitempty"`
+	AccountType AccountType `json:"account_type,omitempty"`
 
 	// The bank account's routing number. Only present for ACH payment methods.
 	RoutingNumber string `json:"routing_number,omitempty"`
@@ -676,6 +1110,46 @@ func (resource *PaymentMethod) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource PaymentMethod) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *PaymentMethod) UnmarshalJSON(data []byte) error {
+	type plain PaymentMethod
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *PaymentMethod) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *PaymentMethod) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *PaymentMethod) Clone() *PaymentMethod {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type paymentMethodList struct {
 	ListMetadata
@@ -693,10 +1167,11 @@ func (resource *paymentMethodList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// PaymentMethodList allows you to paginate PaymentMethod objects
+// PaymentMethodList allows you to paginate PaymentMethod objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type PaymentMethodList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []PaymentMethod
@@ -704,13 +1179,12 @@ type PaymentMethodList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *PaymentMethodList) Fetch() error {
-	resources := &paymentMethodList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &paymentMethodList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*paymentMethodList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -718,23 +1192,51 @@ func (list *PaymentMethodList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *PaymentMethodList) Count() (*int64, error) {
-	resources := &paymentMethodList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &paymentMethodList{} })
+}
+
+// Next advances to the next PaymentMethod, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *PaymentMethodList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current PaymentMethod, or nil if Next has not returned true
+func (list *PaymentMethodList) Item() *PaymentMethod {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumePaymentMethodList.
+func (list *PaymentMethodList) Cursor() string {
+	return list.cursor("PaymentMethodList", list.HasMore)
+}
+
+// ResumePaymentMethodList returns the PaymentMethodList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumePaymentMethodList(cursor string, params *Params) (*PaymentMethodList, error) {
+	p, hasMore, err := resumePager(c, "PaymentMethodList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &PaymentMethodList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type FraudInfo struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Kount score
 	Score int `json:"score,omitempty"`
 
 	// Kount decision
-	Decision string `json:"decision,omitempty"`
+	Decision FraudInfoDecision `json:"decision,omitempty"`
 
 	// Kount rules
 	RiskRulesTriggered map[string]interface{} `json:"risk_rules_triggered,omitempty"`
@@ -750,6 +1252,47 @@ func (resource *FraudInfo) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource FraudInfo) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *FraudInfo) UnmarshalJSON(data []byte) error {
+	type plain FraudInfo
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *FraudInfo) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *FraudInfo) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *FraudInfo) Clone() *FraudInfo {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.RiskRulesTriggered = cloneJSONObject(resource.RiskRulesTriggered)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type fraudInfoList struct {
 	ListMetadata
@@ -767,10 +1310,11 @@ func (resource *fraudInfoList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// FraudInfoList allows you to paginate FraudInfo objects
+// FraudInfoList allows you to paginate FraudInfo objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type FraudInfoList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []FraudInfo
@@ -778,13 +1322,12 @@ type FraudInfoList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *FraudInfoList) Fetch() error {
-	resources := &fraudInfoList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &fraudInfoList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*fraudInfoList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -792,17 +1335,45 @@ func (list *FraudInfoList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *FraudInfoList) Count() (*int64, error) {
-	resources := &fraudInfoList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &fraudInfoList{} })
+}
+
+// Next advances to the next FraudInfo, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *FraudInfoList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current FraudInfo, or nil if Next has not returned true
+func (list *FraudInfoList) Item() *FraudInfo {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeFraudInfoList.
+func (list *FraudInfoList) Cursor() string {
+	return list.cursor("FraudInfoList", list.HasMore)
+}
+
+// ResumeFraudInfoList returns the FraudInfoList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeFraudInfoList(cursor string, params *Params) (*FraudInfoList, error) {
+	p, hasMore, err := resumePager(c, "FraudInfoList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &FraudInfoList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type BillingInfoUpdatedBy struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Customer's IP address when updating their billing information.
 	Ip string `json:"ip,omitempty"`
@@ -821,6 +1392,46 @@ func (resource *BillingInfoUpdatedBy) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource BillingInfoUpdatedBy) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *BillingInfoUpdatedBy) UnmarshalJSON(data []byte) error {
+	type plain BillingInfoUpdatedBy
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *BillingInfoUpdatedBy) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *BillingInfoUpdatedBy) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *BillingInfoUpdatedBy) Clone() *BillingInfoUpdatedBy {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type billingInfoUpdatedByList struct {
 	ListMetadata
@@ -838,10 +1449,11 @@ func (resource *billingInfoUpdatedByList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// BillingInfoUpdatedByList allows you to paginate BillingInfoUpdatedBy objects
+// BillingInfoUpdatedByList allows you to paginate BillingInfoUpdatedBy objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type BillingInfoUpdatedByList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []BillingInfoUpdatedBy
@@ -849,13 +1461,12 @@ type BillingInfoUpdatedByList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *BillingInfoUpdatedByList) Fetch() error {
-	resources := &billingInfoUpdatedByList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &billingInfoUpdatedByList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*billingInfoUpdatedByList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -863,17 +1474,45 @@ func (list *BillingInfoUpdatedByList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *BillingInfoUpdatedByList) Count() (*int64, error) {
-	resources := &billingInfoUpdatedByList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &billingInfoUpdatedByList{} })
+}
+
+// Next advances to the next BillingInfoUpdatedBy, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *BillingInfoUpdatedByList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current BillingInfoUpdatedBy, or nil if Next has not returned true
+func (list *BillingInfoUpdatedByList) Item() *BillingInfoUpdatedBy {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeBillingInfoUpdatedByList.
+func (list *BillingInfoUpdatedByList) Cursor() string {
+	return list.cursor("BillingInfoUpdatedByList", list.HasMore)
+}
+
+// ResumeBillingInfoUpdatedByList returns the BillingInfoUpdatedByList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeBillingInfoUpdatedByList(cursor string, params *Params) (*BillingInfoUpdatedByList, error) {
+	p, hasMore, err := resumePager(c, "BillingInfoUpdatedByList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &BillingInfoUpdatedByList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CustomField struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Fields must be created in the UI before values can be assigned to them.
 	Name string `json:"name,omitempty"`
@@ -892,6 +1531,46 @@ func (resource *CustomField) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CustomField) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CustomField) UnmarshalJSON(data []byte) error {
+	type plain CustomField
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CustomField) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CustomField) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CustomField) Clone() *CustomField {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type customFieldList struct {
 	ListMetadata
@@ -909,10 +1588,11 @@ func (resource *customFieldList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CustomFieldList allows you to paginate CustomField objects
+// CustomFieldList allows you to paginate CustomField objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CustomFieldList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CustomField
@@ -920,13 +1600,12 @@ type CustomFieldList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CustomFieldList) Fetch() error {
-	resources := &customFieldList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &customFieldList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*customFieldList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -934,20 +1613,48 @@ func (list *CustomFieldList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CustomFieldList) Count() (*int64, error) {
-	resources := &customFieldList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &customFieldList{} })
+}
+
+// Next advances to the next CustomField, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CustomFieldList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CustomField, or nil if Next has not returned true
+func (list *CustomFieldList) Item() *CustomField {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCustomFieldList.
+func (list *CustomFieldList) Cursor() string {
+	return list.cursor("CustomFieldList", list.HasMore)
+}
+
+// ResumeCustomFieldList returns the CustomFieldList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCustomFieldList(cursor string, params *Params) (*CustomFieldList, error) {
+	p, hasMore, err := resumePager(c, "CustomFieldList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CustomFieldList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type ErrorMayHaveTransaction struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Type
-	Type string `json:"type,omitempty"`
+	Type ErrorType `json:"type,omitempty"`
 
 	// Message
 	Message string `json:"message,omitempty"`
@@ -969,16 +1676,62 @@ func (resource *ErrorMayHaveTransaction) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// internal struct for deserializing accounts
-type errorMayHaveTransactionList struct {
-	ListMetadata
-	Data            []ErrorMayHaveTransaction `json:"data"`
-	recurlyResponse *ResponseMetadata
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource ErrorMayHaveTransaction) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
 }
 
-// GetResponse returns the ResponseMetadata that generated this resource
-func (resource *errorMayHaveTransactionList) GetResponse() *ResponseMetadata {
-	return resource.recurlyResponse
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *ErrorMayHaveTransaction) UnmarshalJSON(data []byte) error {
+	type plain ErrorMayHaveTransaction
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *ErrorMayHaveTransaction) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *ErrorMayHaveTransaction) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *ErrorMayHaveTransaction) Clone() *ErrorMayHaveTransaction {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.Params != nil {
+		clone.Params = make([]map[string]interface{}, len(resource.Params))
+		for i := range resource.Params {
+			clone.Params[i] = cloneJSONObject(resource.Params[i])
+		}
+	}
+	return &clone
+}
+
+// internal struct for deserializing accounts
+type errorMayHaveTransactionList struct {
+	ListMetadata
+	Data            []ErrorMayHaveTransaction `json:"data"`
+	recurlyResponse *ResponseMetadata
+}
+
+// GetResponse returns the ResponseMetadata that generated this resource
+func (resource *errorMayHaveTransactionList) GetResponse() *ResponseMetadata {
+	return resource.recurlyResponse
 }
 
 // setResponse sets the ResponseMetadata that generated this resource
@@ -986,10 +1739,11 @@ func (resource *errorMayHaveTransactionList) setResponse(res *ResponseMetadata)
 	resource.recurlyResponse = res
 }
 
-// ErrorMayHaveTransactionList allows you to paginate ErrorMayHaveTransaction objects
+// ErrorMayHaveTransactionList allows you to paginate ErrorMayHaveTransaction objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type ErrorMayHaveTransactionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []ErrorMayHaveTransaction
@@ -997,13 +1751,12 @@ type ErrorMayHaveTransactionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *ErrorMayHaveTransactionList) Fetch() error {
-	resources := &errorMayHaveTransactionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &errorMayHaveTransactionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*errorMayHaveTransactionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1011,23 +1764,51 @@ func (list *ErrorMayHaveTransactionList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *ErrorMayHaveTransactionList) Count() (*int64, error) {
-	resources := &errorMayHaveTransactionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &errorMayHaveTransactionList{} })
+}
+
+// Next advances to the next ErrorMayHaveTransaction, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *ErrorMayHaveTransactionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current ErrorMayHaveTransaction, or nil if Next has not returned true
+func (list *ErrorMayHaveTransactionList) Item() *ErrorMayHaveTransaction {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeErrorMayHaveTransactionList.
+func (list *ErrorMayHaveTransactionList) Cursor() string {
+	return list.cursor("ErrorMayHaveTransactionList", list.HasMore)
+}
+
+// ResumeErrorMayHaveTransactionList returns the ErrorMayHaveTransactionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeErrorMayHaveTransactionList(cursor string, params *Params) (*ErrorMayHaveTransactionList, error) {
+	p, hasMore, err := resumePager(c, "ErrorMayHaveTransactionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &ErrorMayHaveTransactionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AccountAcquisition struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Account balance
 	Cost AccountAcquisitionCost `json:"cost,omitempty"`
 
 	// The channel through which the account was acquired.
-	Channel string `json:"channel,omitempty"`
+	Channel AccountAcquisitionChannel `json:"channel,omitempty"`
 
 	// An arbitrary subchannel string representing a distinction/subcategory within a broader channel.
 	Subchannel string `json:"subchannel,omitempty"`
@@ -1044,10 +1825,10 @@ type AccountAcquisition struct {
 	Account AccountMini `json:"account,omitempty"`
 
 	// When the account acquisition data was created.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// When the account acquisition data was last changed.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -1060,6 +1841,48 @@ func (resource *AccountAcquisition) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AccountAcquisition) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AccountAcquisition) UnmarshalJSON(data []byte) error {
+	type plain AccountAcquisition
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AccountAcquisition) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AccountAcquisition) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AccountAcquisition) Clone() *AccountAcquisition {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Cost = *resource.Cost.Clone()
+	clone.Account = *resource.Account.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type accountAcquisitionList struct {
 	ListMetadata
@@ -1077,10 +1900,11 @@ func (resource *accountAcquisitionList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountAcquisitionList allows you to paginate AccountAcquisition objects
+// AccountAcquisitionList allows you to paginate AccountAcquisition objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountAcquisitionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AccountAcquisition
@@ -1088,13 +1912,12 @@ type AccountAcquisitionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountAcquisitionList) Fetch() error {
-	resources := &accountAcquisitionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountAcquisitionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountAcquisitionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1102,17 +1925,45 @@ func (list *AccountAcquisitionList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountAcquisitionList) Count() (*int64, error) {
-	resources := &accountAcquisitionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountAcquisitionList{} })
+}
+
+// Next advances to the next AccountAcquisition, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountAcquisitionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AccountAcquisition, or nil if Next has not returned true
+func (list *AccountAcquisitionList) Item() *AccountAcquisition {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountAcquisitionList.
+func (list *AccountAcquisitionList) Cursor() string {
+	return list.cursor("AccountAcquisitionList", list.HasMore)
+}
+
+// ResumeAccountAcquisitionList returns the AccountAcquisitionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountAcquisitionList(cursor string, params *Params) (*AccountAcquisitionList, error) {
+	p, hasMore, err := resumePager(c, "AccountAcquisitionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountAcquisitionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AccountAcquisitionCost struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -1131,6 +1982,52 @@ func (resource *AccountAcquisitionCost) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AccountAcquisitionCost) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AccountAcquisitionCost) UnmarshalJSON(data []byte) error {
+	type plain AccountAcquisitionCost
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AccountAcquisitionCost) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AccountAcquisitionCost) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AccountAcquisitionCost) Clone() *AccountAcquisitionCost {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *AccountAcquisitionCost) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
 // internal struct for deserializing accounts
 type accountAcquisitionCostList struct {
 	ListMetadata
@@ -1148,10 +2045,11 @@ func (resource *accountAcquisitionCostList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountAcquisitionCostList allows you to paginate AccountAcquisitionCost objects
+// AccountAcquisitionCostList allows you to paginate AccountAcquisitionCost objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountAcquisitionCostList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AccountAcquisitionCost
@@ -1159,13 +2057,12 @@ type AccountAcquisitionCostList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountAcquisitionCostList) Fetch() error {
-	resources := &accountAcquisitionCostList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountAcquisitionCostList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountAcquisitionCostList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1173,17 +2070,45 @@ func (list *AccountAcquisitionCostList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountAcquisitionCostList) Count() (*int64, error) {
-	resources := &accountAcquisitionCostList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountAcquisitionCostList{} })
+}
+
+// Next advances to the next AccountAcquisitionCost, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountAcquisitionCostList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AccountAcquisitionCost, or nil if Next has not returned true
+func (list *AccountAcquisitionCostList) Item() *AccountAcquisitionCost {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountAcquisitionCostList.
+func (list *AccountAcquisitionCostList) Cursor() string {
+	return list.cursor("AccountAcquisitionCostList", list.HasMore)
+}
+
+// ResumeAccountAcquisitionCostList returns the AccountAcquisitionCostList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountAcquisitionCostList(cursor string, params *Params) (*AccountAcquisitionCostList, error) {
+	p, hasMore, err := resumePager(c, "AccountAcquisitionCostList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountAcquisitionCostList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AccountMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Id string `json:"id,omitempty"`
 
@@ -1217,6 +2142,46 @@ func (resource *AccountMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AccountMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AccountMini) UnmarshalJSON(data []byte) error {
+	type plain AccountMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AccountMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AccountMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AccountMini) Clone() *AccountMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type accountMiniList struct {
 	ListMetadata
@@ -1234,10 +2199,11 @@ func (resource *accountMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountMiniList allows you to paginate AccountMini objects
+// AccountMiniList allows you to paginate AccountMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AccountMini
@@ -1245,13 +2211,12 @@ type AccountMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountMiniList) Fetch() error {
-	resources := &accountMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1259,17 +2224,45 @@ func (list *AccountMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountMiniList) Count() (*int64, error) {
-	resources := &accountMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountMiniList{} })
+}
+
+// Next advances to the next AccountMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AccountMini, or nil if Next has not returned true
+func (list *AccountMiniList) Item() *AccountMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountMiniList.
+func (list *AccountMiniList) Cursor() string {
+	return list.cursor("AccountMiniList", list.HasMore)
+}
+
+// ResumeAccountMiniList returns the AccountMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountMiniList(cursor string, params *Params) (*AccountMiniList, error) {
+	p, hasMore, err := resumePager(c, "AccountMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AccountBalance struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Object type
 	Object string `json:"object,omitempty"`
@@ -1292,6 +2285,53 @@ func (resource *AccountBalance) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AccountBalance) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AccountBalance) UnmarshalJSON(data []byte) error {
+	type plain AccountBalance
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AccountBalance) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AccountBalance) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AccountBalance) Clone() *AccountBalance {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	if resource.Balances != nil {
+		clone.Balances = make([]AccountBalanceAmount, len(resource.Balances))
+		for i := range resource.Balances {
+			clone.Balances[i] = *resource.Balances[i].Clone()
+		}
+	}
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type accountBalanceList struct {
 	ListMetadata
@@ -1309,10 +2349,11 @@ func (resource *accountBalanceList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountBalanceList allows you to paginate AccountBalance objects
+// AccountBalanceList allows you to paginate AccountBalance objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountBalanceList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AccountBalance
@@ -1320,13 +2361,12 @@ type AccountBalanceList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountBalanceList) Fetch() error {
-	resources := &accountBalanceList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountBalanceList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountBalanceList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1334,17 +2374,45 @@ func (list *AccountBalanceList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountBalanceList) Count() (*int64, error) {
-	resources := &accountBalanceList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountBalanceList{} })
+}
+
+// Next advances to the next AccountBalance, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountBalanceList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AccountBalance, or nil if Next has not returned true
+func (list *AccountBalanceList) Item() *AccountBalance {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountBalanceList.
+func (list *AccountBalanceList) Cursor() string {
+	return list.cursor("AccountBalanceList", list.HasMore)
+}
+
+// ResumeAccountBalanceList returns the AccountBalanceList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountBalanceList(cursor string, params *Params) (*AccountBalanceList, error) {
+	p, hasMore, err := resumePager(c, "AccountBalanceList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountBalanceList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AccountBalanceAmount struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -1363,6 +2431,52 @@ func (resource *AccountBalanceAmount) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AccountBalanceAmount) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AccountBalanceAmount) UnmarshalJSON(data []byte) error {
+	type plain AccountBalanceAmount
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AccountBalanceAmount) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AccountBalanceAmount) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AccountBalanceAmount) Clone() *AccountBalanceAmount {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *AccountBalanceAmount) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
 // internal struct for deserializing accounts
 type accountBalanceAmountList struct {
 	ListMetadata
@@ -1380,10 +2494,11 @@ func (resource *accountBalanceAmountList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountBalanceAmountList allows you to paginate AccountBalanceAmount objects
+// AccountBalanceAmountList allows you to paginate AccountBalanceAmount objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountBalanceAmountList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AccountBalanceAmount
@@ -1391,13 +2506,12 @@ type AccountBalanceAmountList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountBalanceAmountList) Fetch() error {
-	resources := &accountBalanceAmountList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountBalanceAmountList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountBalanceAmountList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1405,17 +2519,45 @@ func (list *AccountBalanceAmountList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountBalanceAmountList) Count() (*int64, error) {
-	resources := &accountBalanceAmountList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountBalanceAmountList{} })
+}
+
+// Next advances to the next AccountBalanceAmount, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountBalanceAmountList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AccountBalanceAmount, or nil if Next has not returned true
+func (list *AccountBalanceAmountList) Item() *AccountBalanceAmount {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountBalanceAmountList.
+func (list *AccountBalanceAmountList) Cursor() string {
+	return list.cursor("AccountBalanceAmountList", list.HasMore)
+}
+
+// ResumeAccountBalanceAmountList returns the AccountBalanceAmountList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountBalanceAmountList(cursor string, params *Params) (*AccountBalanceAmountList, error) {
+	p, hasMore, err := resumePager(c, "AccountBalanceAmountList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountBalanceAmountList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CouponRedemption struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Coupon Redemption ID
 	Id string `json:"id,omitempty"`
@@ -1429,7 +2571,7 @@ type CouponRedemption struct {
 	Coupon Coupon `json:"coupon,omitempty"`
 
 	// Coupon Redemption state
-	State string `json:"state,omitempty"`
+	State CouponRedemptionState `json:"state,omitempty"`
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -1438,13 +2580,13 @@ type CouponRedemption struct {
 	Discounted float64 `json:"discounted,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// The date and time the redemption was removed from the account (un-redeemed).
-	RemovedAt time.Time `json:"removed_at,omitempty"`
+	RemovedAt NullTime `json:"removed_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -1457,6 +2599,54 @@ func (resource *CouponRedemption) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CouponRedemption) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CouponRedemption) UnmarshalJSON(data []byte) error {
+	type plain CouponRedemption
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CouponRedemption) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CouponRedemption) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CouponRedemption) Clone() *CouponRedemption {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	clone.Coupon = *resource.Coupon.Clone()
+	return &clone
+}
+
+// DiscountedMoney returns the Discounted as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *CouponRedemption) DiscountedMoney() Money {
+	return receivedMoney(resource.rawJSON, "discounted", resource.Discounted)
+}
+
 // internal struct for deserializing accounts
 type couponRedemptionList struct {
 	ListMetadata
@@ -1474,10 +2664,11 @@ func (resource *couponRedemptionList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponRedemptionList allows you to paginate CouponRedemption objects
+// CouponRedemptionList allows you to paginate CouponRedemption objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponRedemptionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CouponRedemption
@@ -1485,13 +2676,12 @@ type CouponRedemptionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponRedemptionList) Fetch() error {
-	resources := &couponRedemptionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponRedemptionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponRedemptionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1499,17 +2689,45 @@ func (list *CouponRedemptionList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponRedemptionList) Count() (*int64, error) {
-	resources := &couponRedemptionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponRedemptionList{} })
+}
+
+// Next advances to the next CouponRedemption, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponRedemptionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CouponRedemption, or nil if Next has not returned true
+func (list *CouponRedemptionList) Item() *CouponRedemption {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponRedemptionList.
+func (list *CouponRedemptionList) Cursor() string {
+	return list.cursor("CouponRedemptionList", list.HasMore)
+}
+
+// ResumeCouponRedemptionList returns the CouponRedemptionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponRedemptionList(cursor string, params *Params) (*CouponRedemptionList, error) {
+	p, hasMore, err := resumePager(c, "CouponRedemptionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponRedemptionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Coupon struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Coupon ID
 	Id string `json:"id,omitempty"`
@@ -1524,7 +2742,7 @@ type Coupon struct {
 	Name string `json:"name,omitempty"`
 
 	// Indicates if the coupon is redeemable, and if it is not, why.
-	State string `json:"state,omitempty"`
+	State CouponState `json:"state,omitempty"`
 
 	// A maximum number of redemptions for the coupon. The coupon will expire when it hits its maximum redemptions.
 	MaxRedemptions int `json:"max_redemptions,omitempty"`
@@ -1540,16 +2758,16 @@ type Coupon struct {
 
 	// - "single_use" coupons applies to the first invoice only.
 	// - "temporal" coupons will apply to invoices for the duration determined by the `temporal_unit` and `temporal_amount` attributes.
-	Duration string `json:"duration,omitempty"`
+	Duration CouponDuration `json:"duration,omitempty"`
 
 	// If `duration` is "temporal" than `temporal_amount` is an integer which is multiplied by `temporal_unit` to define the duration that the coupon will be applied to invoices for.
 	TemporalAmount int `json:"temporal_amount,omitempty"`
 
 	// If `duration` is "temporal" than `temporal_unit` is multiplied by `temporal_amount` to define the duration that the coupon will be applied to invoices for.
-	TemporalUnit string `json:"temporal_unit,omitempty"`
+	TemporalUnit TemporalUnit `json:"temporal_unit,omitempty"`
 
 	// Description of the unit of time the coupon is for. Used with `free_trial_amount` to determine the duration of time the coupon is for.
-	FreeTrialUnit string `json:"free_trial_unit,omitempty"`
+	FreeTrialUnit FreeTrialUnit `json:"free_trial_unit,omitempty"`
 
 	// Sets the duration of time the `free_trial_unit` is for.
 	FreeTrialAmount int `json:"free_trial_amount,omitempty"`
@@ -1567,14 +2785,14 @@ type Coupon struct {
 	Plans []PlanMini `json:"plans,omitempty"`
 
 	// Whether the discount is for all eligible charges on the account, or only a specific subscription.
-	RedemptionResource string `json:"redemption_resource,omitempty"`
+	RedemptionResource RedemptionResource `json:"redemption_resource,omitempty"`
 
 	// Details of the discount a coupon applies. Will contain a `type`
 	// property and one of the following properties: `percent`, `fixed`, `trial`.
 	Discount CouponDiscount `json:"discount,omitempty"`
 
 	// Whether the coupon is "single_code" or "bulk". Bulk coupons will require a `unique_code_template` and will generate unique codes through the `/generate` endpoint.
-	CouponType string `json:"coupon_type,omitempty"`
+	CouponType CouponType `json:"coupon_type,omitempty"`
 
 	// This description will show up when a customer redeems a coupon on your Hosted Payment Pages, or if you choose to show the description on your own checkout page.
 	HostedPageDescription string `json:"hosted_page_description,omitempty"`
@@ -1583,19 +2801,19 @@ type Coupon struct {
 	InvoiceDescription string `json:"invoice_description,omitempty"`
 
 	// The date and time the coupon will expire and can no longer be redeemed. Time is always 11:59:59, the end-of-day Pacific time.
-	RedeemBy time.Time `json:"redeem_by,omitempty"`
+	RedeemBy NullTime `json:"redeem_by,omitempty"`
 
 	// The date and time the unique coupon code was redeemed. This is only present for bulk coupons.
-	RedeemedAt time.Time `json:"redeemed_at,omitempty"`
+	RedeemedAt NullTime `json:"redeemed_at,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// The date and time the coupon was expired early or reached its `max_redemptions`.
-	ExpiredAt time.Time `json:"expired_at,omitempty"`
+	ExpiredAt NullTime `json:"expired_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -1608,6 +2826,56 @@ func (resource *Coupon) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Coupon) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Coupon) UnmarshalJSON(data []byte) error {
+	type plain Coupon
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Coupon) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Coupon) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Coupon) Clone() *Coupon {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.PlansNames != nil {
+		clone.PlansNames = append(make([]string, 0, len(resource.PlansNames)), resource.PlansNames...)
+	}
+	if resource.Plans != nil {
+		clone.Plans = make([]PlanMini, len(resource.Plans))
+		for i := range resource.Plans {
+			clone.Plans[i] = *resource.Plans[i].Clone()
+		}
+	}
+	clone.Discount = *resource.Discount.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type couponList struct {
 	ListMetadata
@@ -1625,10 +2893,11 @@ func (resource *couponList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponList allows you to paginate Coupon objects
+// CouponList allows you to paginate Coupon objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Coupon
@@ -1636,13 +2905,12 @@ type CouponList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponList) Fetch() error {
-	resources := &couponList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1650,17 +2918,45 @@ func (list *CouponList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponList) Count() (*int64, error) {
-	resources := &couponList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponList{} })
+}
+
+// Next advances to the next Coupon, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Coupon, or nil if Next has not returned true
+func (list *CouponList) Item() *Coupon {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponList.
+func (list *CouponList) Cursor() string {
+	return list.cursor("CouponList", list.HasMore)
+}
+
+// ResumeCouponList returns the CouponList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponList(cursor string, params *Params) (*CouponList, error) {
+	p, hasMore, err := resumePager(c, "CouponList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type PlanMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Plan ID
 	Id string `json:"id,omitempty"`
@@ -1685,6 +2981,46 @@ func (resource *PlanMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource PlanMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *PlanMini) UnmarshalJSON(data []byte) error {
+	type plain PlanMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *PlanMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *PlanMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *PlanMini) Clone() *PlanMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type planMiniList struct {
 	ListMetadata
@@ -1702,10 +3038,11 @@ func (resource *planMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// PlanMiniList allows you to paginate PlanMini objects
+// PlanMiniList allows you to paginate PlanMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type PlanMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []PlanMini
@@ -1713,13 +3050,12 @@ type PlanMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *PlanMiniList) Fetch() error {
-	resources := &planMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &planMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*planMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1727,19 +3063,47 @@ func (list *PlanMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *PlanMiniList) Count() (*int64, error) {
-	resources := &planMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &planMiniList{} })
+}
+
+// Next advances to the next PlanMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *PlanMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current PlanMini, or nil if Next has not returned true
+func (list *PlanMiniList) Item() *PlanMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumePlanMiniList.
+func (list *PlanMiniList) Cursor() string {
+	return list.cursor("PlanMiniList", list.HasMore)
+}
+
+// ResumePlanMiniList returns the PlanMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumePlanMiniList(cursor string, params *Params) (*PlanMiniList, error) {
+	p, hasMore, err := resumePager(c, "PlanMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &PlanMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CouponDiscount struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
-	Type string `json:"type,omitempty"`
+	Type CouponDiscountType `json:"type,omitempty"`
 
 	// This is only present when `type=percent`.
 	Percent int `json:"percent,omitempty"`
@@ -1761,6 +3125,53 @@ func (resource *CouponDiscount) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CouponDiscount) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CouponDiscount) UnmarshalJSON(data []byte) error {
+	type plain CouponDiscount
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CouponDiscount) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CouponDiscount) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CouponDiscount) Clone() *CouponDiscount {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.Currencies != nil {
+		clone.Currencies = make([]CouponDiscountPricing, len(resource.Currencies))
+		for i := range resource.Currencies {
+			clone.Currencies[i] = *resource.Currencies[i].Clone()
+		}
+	}
+	clone.Trial = *resource.Trial.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type couponDiscountList struct {
 	ListMetadata
@@ -1778,10 +3189,11 @@ func (resource *couponDiscountList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponDiscountList allows you to paginate CouponDiscount objects
+// CouponDiscountList allows you to paginate CouponDiscount objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponDiscountList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CouponDiscount
@@ -1789,13 +3201,12 @@ type CouponDiscountList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponDiscountList) Fetch() error {
-	resources := &couponDiscountList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponDiscountList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponDiscountList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1803,17 +3214,45 @@ func (list *CouponDiscountList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponDiscountList) Count() (*int64, error) {
-	resources := &couponDiscountList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponDiscountList{} })
+}
+
+// Next advances to the next CouponDiscount, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponDiscountList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CouponDiscount, or nil if Next has not returned true
+func (list *CouponDiscountList) Item() *CouponDiscount {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponDiscountList.
+func (list *CouponDiscountList) Cursor() string {
+	return list.cursor("CouponDiscountList", list.HasMore)
+}
+
+// ResumeCouponDiscountList returns the CouponDiscountList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponDiscountList(cursor string, params *Params) (*CouponDiscountList, error) {
+	p, hasMore, err := resumePager(c, "CouponDiscountList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponDiscountList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CouponDiscountPricing struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -1832,6 +3271,52 @@ func (resource *CouponDiscountPricing) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CouponDiscountPricing) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CouponDiscountPricing) UnmarshalJSON(data []byte) error {
+	type plain CouponDiscountPricing
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CouponDiscountPricing) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CouponDiscountPricing) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CouponDiscountPricing) Clone() *CouponDiscountPricing {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *CouponDiscountPricing) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
 // internal struct for deserializing accounts
 type couponDiscountPricingList struct {
 	ListMetadata
@@ -1849,10 +3334,11 @@ func (resource *couponDiscountPricingList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponDiscountPricingList allows you to paginate CouponDiscountPricing objects
+// CouponDiscountPricingList allows you to paginate CouponDiscountPricing objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponDiscountPricingList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CouponDiscountPricing
@@ -1860,13 +3346,12 @@ type CouponDiscountPricingList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponDiscountPricingList) Fetch() error {
-	resources := &couponDiscountPricingList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponDiscountPricingList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponDiscountPricingList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1874,20 +3359,48 @@ func (list *CouponDiscountPricingList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponDiscountPricingList) Count() (*int64, error) {
-	resources := &couponDiscountPricingList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponDiscountPricingList{} })
+}
+
+// Next advances to the next CouponDiscountPricing, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponDiscountPricingList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CouponDiscountPricing, or nil if Next has not returned true
+func (list *CouponDiscountPricingList) Item() *CouponDiscountPricing {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponDiscountPricingList.
+func (list *CouponDiscountPricingList) Cursor() string {
+	return list.cursor("CouponDiscountPricingList", list.HasMore)
+}
+
+// ResumeCouponDiscountPricingList returns the CouponDiscountPricingList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponDiscountPricingList(cursor string, params *Params) (*CouponDiscountPricingList, error) {
+	p, hasMore, err := resumePager(c, "CouponDiscountPricingList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponDiscountPricingList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CouponDiscountTrial struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Temporal unit of the free trial
-	Unit string `json:"unit,omitempty"`
+	Unit CouponDiscountTrialUnit `json:"unit,omitempty"`
 
 	// Trial length measured in the units specified by the sibling `unit` property
 	Length int `json:"length,omitempty"`
@@ -1903,6 +3416,46 @@ func (resource *CouponDiscountTrial) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CouponDiscountTrial) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CouponDiscountTrial) UnmarshalJSON(data []byte) error {
+	type plain CouponDiscountTrial
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CouponDiscountTrial) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CouponDiscountTrial) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CouponDiscountTrial) Clone() *CouponDiscountTrial {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type couponDiscountTrialList struct {
 	ListMetadata
@@ -1920,10 +3473,11 @@ func (resource *couponDiscountTrialList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponDiscountTrialList allows you to paginate CouponDiscountTrial objects
+// CouponDiscountTrialList allows you to paginate CouponDiscountTrial objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponDiscountTrialList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CouponDiscountTrial
@@ -1931,13 +3485,12 @@ type CouponDiscountTrialList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponDiscountTrialList) Fetch() error {
-	resources := &couponDiscountTrialList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponDiscountTrialList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponDiscountTrialList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -1945,17 +3498,45 @@ func (list *CouponDiscountTrialList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponDiscountTrialList) Count() (*int64, error) {
-	resources := &couponDiscountTrialList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponDiscountTrialList{} })
+}
+
+// Next advances to the next CouponDiscountTrial, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponDiscountTrialList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CouponDiscountTrial, or nil if Next has not returned true
+func (list *CouponDiscountTrialList) Item() *CouponDiscountTrial {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponDiscountTrialList.
+func (list *CouponDiscountTrialList) Cursor() string {
+	return list.cursor("CouponDiscountTrialList", list.HasMore)
+}
+
+// ResumeCouponDiscountTrialList returns the CouponDiscountTrialList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponDiscountTrialList(cursor string, params *Params) (*CouponDiscountTrialList, error) {
+	p, hasMore, err := resumePager(c, "CouponDiscountTrialList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponDiscountTrialList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CreditPayment struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Credit Payment ID
 	Id string `json:"id,omitempty"`
@@ -1967,7 +3548,7 @@ type CreditPayment struct {
 	Uuid string `json:"uuid,omitempty"`
 
 	// The action for which the credit was created.
-	Action string `json:"action,omitempty"`
+	Action CreditPaymentAction `json:"action,omitempty"`
 
 	// Account mini details
 	Account AccountMini `json:"account,omitempty"`
@@ -1990,13 +3571,13 @@ type CreditPayment struct {
 	RefundTransaction Transaction `json:"refund_transaction,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Voided at
-	VoidedAt time.Time `json:"voided_at,omitempty"`
+	VoidedAt NullTime `json:"voided_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -2009,6 +3590,56 @@ func (resource *CreditPayment) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CreditPayment) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CreditPayment) UnmarshalJSON(data []byte) error {
+	type plain CreditPayment
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CreditPayment) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CreditPayment) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CreditPayment) Clone() *CreditPayment {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	clone.AppliedToInvoice = *resource.AppliedToInvoice.Clone()
+	clone.OriginalInvoice = *resource.OriginalInvoice.Clone()
+	clone.RefundTransaction = *resource.RefundTransaction.Clone()
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *CreditPayment) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
 // internal struct for deserializing accounts
 type creditPaymentList struct {
 	ListMetadata
@@ -2026,10 +3657,11 @@ func (resource *creditPaymentList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CreditPaymentList allows you to paginate CreditPayment objects
+// CreditPaymentList allows you to paginate CreditPayment objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CreditPaymentList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CreditPayment
@@ -2037,13 +3669,12 @@ type CreditPaymentList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CreditPaymentList) Fetch() error {
-	resources := &creditPaymentList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &creditPaymentList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*creditPaymentList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2051,17 +3682,45 @@ func (list *CreditPaymentList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CreditPaymentList) Count() (*int64, error) {
-	resources := &creditPaymentList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &creditPaymentList{} })
+}
+
+// Next advances to the next CreditPayment, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CreditPaymentList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CreditPayment, or nil if Next has not returned true
+func (list *CreditPaymentList) Item() *CreditPayment {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCreditPaymentList.
+func (list *CreditPaymentList) Cursor() string {
+	return list.cursor("CreditPaymentList", list.HasMore)
+}
+
+// ResumeCreditPaymentList returns the CreditPaymentList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCreditPaymentList(cursor string, params *Params) (*CreditPaymentList, error) {
+	p, hasMore, err := resumePager(c, "CreditPaymentList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CreditPaymentList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type InvoiceMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Invoice ID
 	Id string `json:"id,omitempty"`
@@ -2073,10 +3732,10 @@ type InvoiceMini struct {
 	Number string `json:"number,omitempty"`
 
 	// Invoice type
-	Type string `json:"type,omitempty"`
+	Type InvoiceType `json:"type,omitempty"`
 
 	// Invoice state
-	State string `json:"state,omitempty"`
+	State InvoiceState `json:"state,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -2089,6 +3748,46 @@ func (resource *InvoiceMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource InvoiceMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *InvoiceMini) UnmarshalJSON(data []byte) error {
+	type plain InvoiceMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *InvoiceMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *InvoiceMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *InvoiceMini) Clone() *InvoiceMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type invoiceMiniList struct {
 	ListMetadata
@@ -2106,10 +3805,11 @@ func (resource *invoiceMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// InvoiceMiniList allows you to paginate InvoiceMini objects
+// InvoiceMiniList allows you to paginate InvoiceMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type InvoiceMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []InvoiceMini
@@ -2117,13 +3817,12 @@ type InvoiceMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *InvoiceMiniList) Fetch() error {
-	resources := &invoiceMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &invoiceMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*invoiceMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2131,17 +3830,45 @@ func (list *InvoiceMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *InvoiceMiniList) Count() (*int64, error) {
-	resources := &invoiceMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &invoiceMiniList{} })
+}
+
+// Next advances to the next InvoiceMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *InvoiceMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current InvoiceMini, or nil if Next has not returned true
+func (list *InvoiceMiniList) Item() *InvoiceMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeInvoiceMiniList.
+func (list *InvoiceMiniList) Cursor() string {
+	return list.cursor("InvoiceMiniList", list.HasMore)
+}
+
+// ResumeInvoiceMiniList returns the InvoiceMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeInvoiceMiniList(cursor string, params *Params) (*InvoiceMiniList, error) {
+	p, hasMore, err := resumePager(c, "InvoiceMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &InvoiceMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Transaction struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Transaction ID
 	Id string `json:"id,omitempty"`
@@ -2172,10 +3899,10 @@ type Transaction struct {
 	// - `purchase` – combines the authorization and capture in one transaction.
 	// - `refund` – returns all or a portion of the money collected in a previous transaction to the customer.
 	// - `verify` – a $0 or $1 transaction used to verify billing information which is immediately voided.
-	Type string `json:"type,omitempty"`
+	Type TransactionType `json:"type,omitempty"`
 
 	// Describes how the transaction was triggered.
-	Origin string `json:"origin,omitempty"`
+	Origin TransactionOrigin `json:"origin,omitempty"`
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -2184,7 +3911,7 @@ type Transaction struct {
 	Amount float64 `json:"amount,omitempty"`
 
 	// The current transaction status. Note that the status may change, e.g. a `pending` transaction may become `declined` or `success` may later become `void`.
-	Status string `json:"status,omitempty"`
+	Status TransactionStatus `json:"status,omitempty"`
 
 	// Did this transaction complete successfully?
 	Success bool `json:"success,omitempty"`
@@ -2195,7 +3922,7 @@ type Transaction struct {
 	BillingAddress Address `json:"billing_address,omitempty"`
 
 	// The method by which the payment was collected.
-	CollectionMethod string `json:"collection_method,omitempty"`
+	CollectionMethod CollectionMethod `json:"collection_method,omitempty"`
 
 	PaymentMethod PaymentMethod `json:"payment_method,omitempty"`
 
@@ -2241,19 +3968,19 @@ type Transaction struct {
 	GatewayResponseValues map[string]interface{} `json:"gateway_response_values,omitempty"`
 
 	// When processed, result from checking the CVV/CVC value on the transaction.
-	CvvCheck string `json:"cvv_check,omitempty"`
+	CvvCheck CvvCheck `json:"cvv_check,omitempty"`
 
 	// When processed, result from checking the overall AVS on the transaction.
-	AvsCheck string `json:"avs_check,omitempty"`
+	AvsCheck AvsCheck `json:"avs_check,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Voided at
-	VoidedAt time.Time `json:"voided_at,omitempty"`
+	VoidedAt NullTime `json:"voided_at,omitempty"`
 
 	// Collected at, or if not collected yet, the time the transaction was created.
-	CollectedAt time.Time `json:"collected_at,omitempty"`
+	CollectedAt NullTime `json:"collected_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -2266,6 +3993,62 @@ func (resource *Transaction) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Transaction) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Transaction) UnmarshalJSON(data []byte) error {
+	type plain Transaction
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Transaction) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Transaction) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Transaction) Clone() *Transaction {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	clone.Invoice = *resource.Invoice.Clone()
+	clone.VoidedByInvoice = *resource.VoidedByInvoice.Clone()
+	if resource.SubscriptionIds != nil {
+		clone.SubscriptionIds = append(make([]string, 0, len(resource.SubscriptionIds)), resource.SubscriptionIds...)
+	}
+	clone.BillingAddress = *resource.BillingAddress.Clone()
+	clone.PaymentMethod = *resource.PaymentMethod.Clone()
+	clone.PaymentGateway = *resource.PaymentGateway.Clone()
+	clone.GatewayResponseValues = cloneJSONObject(resource.GatewayResponseValues)
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Transaction) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
 // internal struct for deserializing accounts
 type transactionList struct {
 	ListMetadata
@@ -2283,10 +4066,11 @@ func (resource *transactionList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// TransactionList allows you to paginate Transaction objects
+// TransactionList allows you to paginate Transaction objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type TransactionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Transaction
@@ -2294,13 +4078,12 @@ type TransactionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *TransactionList) Fetch() error {
-	resources := &transactionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &transactionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*transactionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2308,17 +4091,45 @@ func (list *TransactionList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *TransactionList) Count() (*int64, error) {
-	resources := &transactionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &transactionList{} })
+}
+
+// Next advances to the next Transaction, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *TransactionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Transaction, or nil if Next has not returned true
+func (list *TransactionList) Item() *Transaction {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeTransactionList.
+func (list *TransactionList) Cursor() string {
+	return list.cursor("TransactionList", list.HasMore)
+}
+
+// ResumeTransactionList returns the TransactionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeTransactionList(cursor string, params *Params) (*TransactionList, error) {
+	p, hasMore, err := resumePager(c, "TransactionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &TransactionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type TransactionPaymentGateway struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Id string `json:"id,omitempty"`
 
@@ -2340,6 +4151,46 @@ func (resource *TransactionPaymentGateway) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource TransactionPaymentGateway) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *TransactionPaymentGateway) UnmarshalJSON(data []byte) error {
+	type plain TransactionPaymentGateway
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *TransactionPaymentGateway) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *TransactionPaymentGateway) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *TransactionPaymentGateway) Clone() *TransactionPaymentGateway {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type transactionPaymentGatewayList struct {
 	ListMetadata
@@ -2357,10 +4208,11 @@ func (resource *transactionPaymentGatewayList) setResponse(res *ResponseMetadata
 	resource.recurlyResponse = res
 }
 
-// TransactionPaymentGatewayList allows you to paginate TransactionPaymentGateway objects
+// TransactionPaymentGatewayList allows you to paginate TransactionPaymentGateway objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type TransactionPaymentGatewayList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []TransactionPaymentGateway
@@ -2368,13 +4220,12 @@ type TransactionPaymentGatewayList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *TransactionPaymentGatewayList) Fetch() error {
-	resources := &transactionPaymentGatewayList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &transactionPaymentGatewayList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*transactionPaymentGatewayList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2382,17 +4233,45 @@ func (list *TransactionPaymentGatewayList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *TransactionPaymentGatewayList) Count() (*int64, error) {
-	resources := &transactionPaymentGatewayList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &transactionPaymentGatewayList{} })
+}
+
+// Next advances to the next TransactionPaymentGateway, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *TransactionPaymentGatewayList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current TransactionPaymentGateway, or nil if Next has not returned true
+func (list *TransactionPaymentGatewayList) Item() *TransactionPaymentGateway {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeTransactionPaymentGatewayList.
+func (list *TransactionPaymentGatewayList) Cursor() string {
+	return list.cursor("TransactionPaymentGatewayList", list.HasMore)
+}
+
+// ResumeTransactionPaymentGatewayList returns the TransactionPaymentGatewayList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeTransactionPaymentGatewayList(cursor string, params *Params) (*TransactionPaymentGatewayList, error) {
+	p, hasMore, err := resumePager(c, "TransactionPaymentGatewayList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &TransactionPaymentGatewayList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Invoice struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Invoice ID
 	Id string `json:"id,omitempty"`
@@ -2401,13 +4280,13 @@ type Invoice struct {
 	Object string `json:"object,omitempty"`
 
 	// Invoices are either charge, credit, or legacy invoices.
-	Type string `json:"type,omitempty"`
+	Type InvoiceType `json:"type,omitempty"`
 
 	// The event that created the invoice.
-	Origin string `json:"origin,omitempty"`
+	Origin InvoiceOrigin `json:"origin,omitempty"`
 
 	// Invoice state
-	State string `json:"state,omitempty"`
+	State InvoiceState `json:"state,omitempty"`
 
 	// Account mini details
 	Account AccountMini `json:"account,omitempty"`
@@ -2422,7 +4301,7 @@ type Invoice struct {
 	Number string `json:"number,omitempty"`
 
 	// An automatic invoice means a corresponding transaction is run using the account's billing information at the same time the invoice is created. Manual invoices are created without a corresponding transaction. The merchant must enter a manual payment transaction or have the customer pay the invoice with an automatic method, like credit card, PayPal, Amazon, or ACH bank payment.
-	CollectionMethod string `json:"collection_method,omitempty"`
+	CollectionMethod CollectionMethod `json:"collection_method,omitempty"`
 
 	// For manual invoicing, this identifies the PO number associated with the subscription.
 	PoNumber string `json:"po_number,omitempty"`
@@ -2482,16 +4361,16 @@ type Invoice struct {
 	CreditPayments []CreditPayment `json:"credit_payments,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Date invoice is due. This is the date the net terms are reached.
-	DueAt time.Time `json:"due_at,omitempty"`
+	DueAt NullTime `json:"due_at,omitempty"`
 
 	// Date invoice was marked paid or failed.
-	ClosedAt time.Time `json:"closed_at,omitempty"`
+	ClosedAt NullTime `json:"closed_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -2504,6 +4383,114 @@ func (resource *Invoice) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Invoice) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Invoice) UnmarshalJSON(data []byte) error {
+	type plain Invoice
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Invoice) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Invoice) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Invoice) Clone() *Invoice {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	if resource.SubscriptionIds != nil {
+		clone.SubscriptionIds = append(make([]string, 0, len(resource.SubscriptionIds)), resource.SubscriptionIds...)
+	}
+	clone.Address = *resource.Address.Clone()
+	clone.ShippingAddress = *resource.ShippingAddress.Clone()
+	clone.TaxInfo = *resource.TaxInfo.Clone()
+	clone.LineItems = resource.LineItems.clone()
+	if resource.Transactions != nil {
+		clone.Transactions = make([]Transaction, len(resource.Transactions))
+		for i := range resource.Transactions {
+			clone.Transactions[i] = *resource.Transactions[i].Clone()
+		}
+	}
+	if resource.CreditPayments != nil {
+		clone.CreditPayments = make([]CreditPayment, len(resource.CreditPayments))
+		for i := range resource.CreditPayments {
+			clone.CreditPayments[i] = *resource.CreditPayments[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// DiscountMoney returns the Discount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) DiscountMoney() Money {
+	return receivedMoney(resource.rawJSON, "discount", resource.Discount)
+}
+
+// SubtotalMoney returns the Subtotal as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) SubtotalMoney() Money {
+	return receivedMoney(resource.rawJSON, "subtotal", resource.Subtotal)
+}
+
+// TaxMoney returns the Tax as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) TaxMoney() Money {
+	return receivedMoney(resource.rawJSON, "tax", resource.Tax)
+}
+
+// TotalMoney returns the Total as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) TotalMoney() Money {
+	return receivedMoney(resource.rawJSON, "total", resource.Total)
+}
+
+// RefundableAmountMoney returns the RefundableAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) RefundableAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "refundable_amount", resource.RefundableAmount)
+}
+
+// PaidMoney returns the Paid as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) PaidMoney() Money {
+	return receivedMoney(resource.rawJSON, "paid", resource.Paid)
+}
+
+// BalanceMoney returns the Balance as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Invoice) BalanceMoney() Money {
+	return receivedMoney(resource.rawJSON, "balance", resource.Balance)
+}
+
+// attachClient lets the lists embedded in the resource fetch their next pages
+// with the client
+func (resource *Invoice) attachClient(c *Client) {
+	resource.LineItems.attach(c, c.InterpolatePath("/invoices/{invoice_id}/line_items", resource.Id))
+}
+
 // internal struct for deserializing accounts
 type invoiceList struct {
 	ListMetadata
@@ -2521,10 +4508,19 @@ func (resource *invoiceList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// InvoiceList allows you to paginate Invoice objects
+// attachClient lets the lists embedded in the resources fetch their next pages
+// with the client
+func (resource *invoiceList) attachClient(c *Client) {
+	for i := range resource.Data {
+		resource.Data[i].attachClient(c)
+	}
+}
+
+// InvoiceList allows you to paginate Invoice objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type InvoiceList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Invoice
@@ -2532,13 +4528,12 @@ type InvoiceList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *InvoiceList) Fetch() error {
-	resources := &invoiceList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &invoiceList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*invoiceList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2546,17 +4541,45 @@ func (list *InvoiceList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *InvoiceList) Count() (*int64, error) {
-	resources := &invoiceList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &invoiceList{} })
+}
+
+// Next advances to the next Invoice, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *InvoiceList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Invoice, or nil if Next has not returned true
+func (list *InvoiceList) Item() *Invoice {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeInvoiceList.
+func (list *InvoiceList) Cursor() string {
+	return list.cursor("InvoiceList", list.HasMore)
+}
+
+// ResumeInvoiceList returns the InvoiceList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeInvoiceList(cursor string, params *Params) (*InvoiceList, error) {
+	p, hasMore, err := resumePager(c, "InvoiceList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &InvoiceList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type InvoiceAddress struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Name on account
 	NameOnAccount string `json:"name_on_account,omitempty"`
@@ -2602,6 +4625,46 @@ func (resource *InvoiceAddress) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource InvoiceAddress) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *InvoiceAddress) UnmarshalJSON(data []byte) error {
+	type plain InvoiceAddress
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *InvoiceAddress) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *InvoiceAddress) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *InvoiceAddress) Clone() *InvoiceAddress {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type invoiceAddressList struct {
 	ListMetadata
@@ -2619,10 +4682,11 @@ func (resource *invoiceAddressList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// InvoiceAddressList allows you to paginate InvoiceAddress objects
+// InvoiceAddressList allows you to paginate InvoiceAddress objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type InvoiceAddressList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []InvoiceAddress
@@ -2630,13 +4694,12 @@ type InvoiceAddressList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *InvoiceAddressList) Fetch() error {
-	resources := &invoiceAddressList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &invoiceAddressList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*invoiceAddressList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2644,17 +4707,45 @@ func (list *InvoiceAddressList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *InvoiceAddressList) Count() (*int64, error) {
-	resources := &invoiceAddressList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &invoiceAddressList{} })
+}
+
+// Next advances to the next InvoiceAddress, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *InvoiceAddressList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current InvoiceAddress, or nil if Next has not returned true
+func (list *InvoiceAddressList) Item() *InvoiceAddress {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeInvoiceAddressList.
+func (list *InvoiceAddressList) Cursor() string {
+	return list.cursor("InvoiceAddressList", list.HasMore)
+}
+
+// ResumeInvoiceAddressList returns the InvoiceAddressList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeInvoiceAddressList(cursor string, params *Params) (*InvoiceAddressList, error) {
+	p, hasMore, err := resumePager(c, "InvoiceAddressList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &InvoiceAddressList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type TaxInfo struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Provides the tax type as "vat" for EU VAT, "usst" for U.S. Sales Tax, or the 2 letter country code for country level tax types like Canada, Australia, New Zealand, Israel, and all non-EU European countries.
 	Type string `json:"type,omitempty"`
@@ -2676,6 +4767,46 @@ func (resource *TaxInfo) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource TaxInfo) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *TaxInfo) UnmarshalJSON(data []byte) error {
+	type plain TaxInfo
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *TaxInfo) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *TaxInfo) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *TaxInfo) Clone() *TaxInfo {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type taxInfoList struct {
 	ListMetadata
@@ -2693,10 +4824,11 @@ func (resource *taxInfoList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// TaxInfoList allows you to paginate TaxInfo objects
+// TaxInfoList allows you to paginate TaxInfo objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type TaxInfoList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []TaxInfo
@@ -2704,13 +4836,12 @@ type TaxInfoList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *TaxInfoList) Fetch() error {
-	resources := &taxInfoList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &taxInfoList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*taxInfoList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2718,17 +4849,45 @@ func (list *TaxInfoList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *TaxInfoList) Count() (*int64, error) {
-	resources := &taxInfoList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &taxInfoList{} })
+}
+
+// Next advances to the next TaxInfo, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *TaxInfoList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current TaxInfo, or nil if Next has not returned true
+func (list *TaxInfoList) Item() *TaxInfo {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeTaxInfoList.
+func (list *TaxInfoList) Cursor() string {
+	return list.cursor("TaxInfoList", list.HasMore)
+}
+
+// ResumeTaxInfoList returns the TaxInfoList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeTaxInfoList(cursor string, params *Params) (*TaxInfoList, error) {
+	p, hasMore, err := resumePager(c, "TaxInfoList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &TaxInfoList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type LineItem struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Line item ID
 	Id string `json:"id,omitempty"`
@@ -2740,7 +4899,7 @@ type LineItem struct {
 	Uuid string `json:"uuid,omitempty"`
 
 	// Charges are positive line items that debit the account. Credits are negative line items that credit the account.
-	Type string `json:"type,omitempty"`
+	Type LineItemType `json:"type,omitempty"`
 
 	// Unique code to identify an item. Available when the Credit Invoices and Subscription Billing Terms features are enabled.
 	ItemCode string `json:"item_code,omitempty"`
@@ -2752,17 +4911,17 @@ type LineItem struct {
 	ExternalSku string `json:"external_sku,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Pending line items are charges or credits on an account that have not been applied to an invoice yet. Invoiced line items will always have an `invoice_id` value.
-	State string `json:"state,omitempty"`
+	State LineItemState `json:"state,omitempty"`
 
 	// Category to describe the role of a line item on a legacy invoice:
 	// - "charges" refers to charges being billed for on this invoice.
 	// - "credits" refers to refund or proration credits. This portion of the invoice can be considered a credit memo.
 	// - "applied_credits" refers to previous credits applied to this invoice. See their original_line_item_id to determine where the credit first originated.
 	// - "carryforwards" can be ignored. They exist to consume any remaining credit balance. A new credit with the same amount will be created and placed back on the account.
-	LegacyCategory string `json:"legacy_category,omitempty"`
+	LegacyCategory LegacyCategory `json:"legacy_category,omitempty"`
 
 	// Account mini details
 	Account AccountMini `json:"account,omitempty"`
@@ -2795,7 +4954,7 @@ type LineItem struct {
 	OriginalLineItemInvoiceId string `json:"original_line_item_invoice_id,omitempty"`
 
 	// A credit created from an original charge will have the value of the charge's origin.
-	Origin string `json:"origin,omitempty"`
+	Origin LineItemOrigin `json:"origin,omitempty"`
 
 	// Internal accounting code to help you reconcile your revenue to the correct ledger. Line items created as part of a subscription invoice will use the plan or add-on's accounting code, otherwise the value will only be present if you define an accounting code when creating the line item.
 	AccountingCode string `json:"accounting_code,omitempty"`
@@ -2804,7 +4963,7 @@ type LineItem struct {
 	ProductCode string `json:"product_code,omitempty"`
 
 	// The reason the credit was given when line item is `type=credit`.
-	CreditReasonCode string `json:"credit_reason_code,omitempty"`
+	CreditReasonCode CreditReasonCode `json:"credit_reason_code,omitempty"`
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -2857,16 +5016,16 @@ type LineItem struct {
 	ShippingAddress ShippingAddress `json:"shipping_address,omitempty"`
 
 	// If an end date is present, this is value indicates the beginning of a billing time range. If no end date is present it indicates billing for a specific date.
-	StartDate time.Time `json:"start_date,omitempty"`
+	StartDate NullTime `json:"start_date,omitempty"`
 
 	// If this date is provided, it indicates the end of a time range.
-	EndDate time.Time `json:"end_date,omitempty"`
+	EndDate NullTime `json:"end_date,omitempty"`
 
 	// When the line item was created.
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// When the line item was last changed.
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -2879,6 +5038,85 @@ func (resource *LineItem) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource LineItem) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *LineItem) UnmarshalJSON(data []byte) error {
+	type plain LineItem
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *LineItem) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *LineItem) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *LineItem) Clone() *LineItem {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	clone.TaxInfo = *resource.TaxInfo.Clone()
+	clone.ShippingAddress = *resource.ShippingAddress.Clone()
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *LineItem) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *LineItem) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
+}
+
+// SubtotalMoney returns the Subtotal as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *LineItem) SubtotalMoney() Money {
+	return receivedMoney(resource.rawJSON, "subtotal", resource.Subtotal)
+}
+
+// DiscountMoney returns the Discount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *LineItem) DiscountMoney() Money {
+	return receivedMoney(resource.rawJSON, "discount", resource.Discount)
+}
+
+// TaxMoney returns the Tax as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *LineItem) TaxMoney() Money {
+	return receivedMoney(resource.rawJSON, "tax", resource.Tax)
+}
+
+// CreditAppliedMoney returns the CreditApplied as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *LineItem) CreditAppliedMoney() Money {
+	return receivedMoney(resource.rawJSON, "credit_applied", resource.CreditApplied)
+}
+
 // internal struct for deserializing accounts
 type lineItemList struct {
 	ListMetadata
@@ -2896,24 +5134,56 @@ func (resource *lineItemList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// LineItemList allows you to paginate LineItem objects
+// LineItemList allows you to paginate LineItem objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type LineItemList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []LineItem
 }
 
+// UnmarshalJSON decodes the list embedded in a resource. The embedded items
+// are its first page, and Next fetches the rest from the client which
+// returned the resource.
+func (list *LineItemList) UnmarshalJSON(data []byte) error {
+	page := &lineItemList{}
+	if err := json.Unmarshal(data, page); err != nil {
+		return err
+	}
+	list.embed(page.ListMetadata, len(page.Data), data)
+	list.HasMore = page.HasMore
+	list.Data = page.Data
+	return nil
+}
+
+// MarshalJSON encodes the list as it was embedded in its resource
+func (list LineItemList) MarshalJSON() ([]byte, error) {
+	return list.embeddedJSON()
+}
+
+// clone returns a copy of the embedded list and its items
+func (list *LineItemList) clone() LineItemList {
+	clone := *list
+	clone.pager = list.pager.clone()
+	if list.Data != nil {
+		clone.Data = make([]LineItem, len(list.Data))
+		for i := range list.Data {
+			clone.Data[i] = *list.Data[i].Clone()
+		}
+	}
+	return clone
+}
+
 // Fetch fetches the next page of data into the `Data` property
 func (list *LineItemList) Fetch() error {
-	resources := &lineItemList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &lineItemList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*lineItemList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2921,17 +5191,45 @@ func (list *LineItemList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *LineItemList) Count() (*int64, error) {
-	resources := &lineItemList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &lineItemList{} })
+}
+
+// Next advances to the next LineItem, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *LineItemList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current LineItem, or nil if Next has not returned true
+func (list *LineItemList) Item() *LineItem {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeLineItemList.
+func (list *LineItemList) Cursor() string {
+	return list.cursor("LineItemList", list.HasMore)
+}
+
+// ResumeLineItemList returns the LineItemList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeLineItemList(cursor string, params *Params) (*LineItemList, error) {
+	p, hasMore, err := resumePager(c, "LineItemList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &LineItemList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type InvoiceCollection struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Object type
 	Object string `json:"object,omitempty"`
@@ -2952,6 +5250,62 @@ func (resource *InvoiceCollection) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource InvoiceCollection) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *InvoiceCollection) UnmarshalJSON(data []byte) error {
+	type plain InvoiceCollection
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *InvoiceCollection) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *InvoiceCollection) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *InvoiceCollection) Clone() *InvoiceCollection {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.ChargeInvoice = *resource.ChargeInvoice.Clone()
+	if resource.CreditInvoices != nil {
+		clone.CreditInvoices = make([]Invoice, len(resource.CreditInvoices))
+		for i := range resource.CreditInvoices {
+			clone.CreditInvoices[i] = *resource.CreditInvoices[i].Clone()
+		}
+	}
+	return &clone
+}
+
+// attachClient lets the lists embedded in the resource fetch their next pages
+// with the client
+func (resource *InvoiceCollection) attachClient(c *Client) {
+	resource.ChargeInvoice.attachClient(c)
+	for i := range resource.CreditInvoices {
+		resource.CreditInvoices[i].attachClient(c)
+	}
+}
+
 // internal struct for deserializing accounts
 type invoiceCollectionList struct {
 	ListMetadata
@@ -2969,10 +5323,19 @@ func (resource *invoiceCollectionList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// InvoiceCollectionList allows you to paginate InvoiceCollection objects
+// attachClient lets the lists embedded in the resources fetch their next pages
+// with the client
+func (resource *invoiceCollectionList) attachClient(c *Client) {
+	for i := range resource.Data {
+		resource.Data[i].attachClient(c)
+	}
+}
+
+// InvoiceCollectionList allows you to paginate InvoiceCollection objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type InvoiceCollectionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []InvoiceCollection
@@ -2980,13 +5343,12 @@ type InvoiceCollectionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *InvoiceCollectionList) Fetch() error {
-	resources := &invoiceCollectionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &invoiceCollectionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*invoiceCollectionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -2994,17 +5356,45 @@ func (list *InvoiceCollectionList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *InvoiceCollectionList) Count() (*int64, error) {
-	resources := &invoiceCollectionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &invoiceCollectionList{} })
+}
+
+// Next advances to the next InvoiceCollection, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *InvoiceCollectionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current InvoiceCollection, or nil if Next has not returned true
+func (list *InvoiceCollectionList) Item() *InvoiceCollection {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeInvoiceCollectionList.
+func (list *InvoiceCollectionList) Cursor() string {
+	return list.cursor("InvoiceCollectionList", list.HasMore)
+}
+
+// ResumeInvoiceCollectionList returns the InvoiceCollectionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeInvoiceCollectionList(cursor string, params *Params) (*InvoiceCollectionList, error) {
+	p, hasMore, err := resumePager(c, "InvoiceCollectionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &InvoiceCollectionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AccountNote struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Id string `json:"id,omitempty"`
 
@@ -3017,7 +5407,7 @@ type AccountNote struct {
 
 	Message string `json:"message,omitempty"`
 
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -3030,6 +5420,47 @@ func (resource *AccountNote) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AccountNote) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AccountNote) UnmarshalJSON(data []byte) error {
+	type plain AccountNote
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AccountNote) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AccountNote) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AccountNote) Clone() *AccountNote {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.User = *resource.User.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type accountNoteList struct {
 	ListMetadata
@@ -3047,10 +5478,11 @@ func (resource *accountNoteList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AccountNoteList allows you to paginate AccountNote objects
+// AccountNoteList allows you to paginate AccountNote objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AccountNoteList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AccountNote
@@ -3058,13 +5490,12 @@ type AccountNoteList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AccountNoteList) Fetch() error {
-	resources := &accountNoteList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &accountNoteList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*accountNoteList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3072,17 +5503,45 @@ func (list *AccountNoteList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AccountNoteList) Count() (*int64, error) {
-	resources := &accountNoteList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &accountNoteList{} })
+}
+
+// Next advances to the next AccountNote, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AccountNoteList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AccountNote, or nil if Next has not returned true
+func (list *AccountNoteList) Item() *AccountNote {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAccountNoteList.
+func (list *AccountNoteList) Cursor() string {
+	return list.cursor("AccountNoteList", list.HasMore)
+}
+
+// ResumeAccountNoteList returns the AccountNoteList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAccountNoteList(cursor string, params *Params) (*AccountNoteList, error) {
+	p, hasMore, err := resumePager(c, "AccountNoteList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AccountNoteList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type User struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Id string `json:"id,omitempty"`
 
@@ -3097,9 +5556,9 @@ type User struct {
 
 	TimeZone string `json:"time_zone,omitempty"`
 
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -3112,6 +5571,46 @@ func (resource *User) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource User) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *User) UnmarshalJSON(data []byte) error {
+	type plain User
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *User) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *User) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *User) Clone() *User {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type userList struct {
 	ListMetadata
@@ -3129,10 +5628,11 @@ func (resource *userList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// UserList allows you to paginate User objects
+// UserList allows you to paginate User objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type UserList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []User
@@ -3140,13 +5640,12 @@ type UserList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *UserList) Fetch() error {
-	resources := &userList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &userList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*userList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3154,17 +5653,45 @@ func (list *UserList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *UserList) Count() (*int64, error) {
-	resources := &userList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &userList{} })
+}
+
+// Next advances to the next User, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *UserList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current User, or nil if Next has not returned true
+func (list *UserList) Item() *User {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeUserList.
+func (list *UserList) Cursor() string {
+	return list.cursor("UserList", list.HasMore)
+}
+
+// ResumeUserList returns the UserList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeUserList(cursor string, params *Params) (*UserList, error) {
+	p, hasMore, err := resumePager(c, "UserList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &UserList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Subscription struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Subscription ID
 	Id string `json:"id,omitempty"`
@@ -3182,7 +5709,7 @@ type Subscription struct {
 	Plan PlanMini `json:"plan,omitempty"`
 
 	// State
-	State string `json:"state,omitempty"`
+	State SubscriptionState `json:"state,omitempty"`
 
 	// Subscription shipping details
 	Shipping SubscriptionShipping `json:"shipping,omitempty"`
@@ -3194,22 +5721,22 @@ type Subscription struct {
 	PendingChange SubscriptionChange `json:"pending_change,omitempty"`
 
 	// Current billing period started at
-	CurrentPeriodStartedAt time.Time `json:"current_period_started_at,omitempty"`
+	CurrentPeriodStartedAt NullTime `json:"current_period_started_at,omitempty"`
 
 	// Current billing period ends at
-	CurrentPeriodEndsAt time.Time `json:"current_period_ends_at,omitempty"`
+	CurrentPeriodEndsAt NullTime `json:"current_period_ends_at,omitempty"`
 
 	// The start date of the term when the first billing period starts. The subscription term is the length of time that a customer will be committed to a subscription. A term can span multiple billing periods.
-	CurrentTermStartedAt time.Time `json:"current_term_started_at,omitempty"`
+	CurrentTermStartedAt NullTime `json:"current_term_started_at,omitempty"`
 
 	// When the term ends. This is calculated by a plan's interval and `total_billing_cycles` in a term. Subscription changes with a `timeframe=renewal` will be applied on this date.
-	CurrentTermEndsAt time.Time `json:"current_term_ends_at,omitempty"`
+	CurrentTermEndsAt NullTime `json:"current_term_ends_at,omitempty"`
 
 	// Trial period started at
-	TrialStartedAt time.Time `json:"trial_started_at,omitempty"`
+	TrialStartedAt NullTime `json:"trial_started_at,omitempty"`
 
 	// Trial period ends at
-	TrialEndsAt time.Time `json:"trial_ends_at,omitempty"`
+	TrialEndsAt NullTime `json:"trial_ends_at,omitempty"`
 
 	// The remaining billing cycles in the current term.
 	RemainingBillingCycles int `json:"remaining_billing_cycles,omitempty"`
@@ -3224,7 +5751,7 @@ type Subscription struct {
 	AutoRenew bool `json:"auto_renew,omitempty"`
 
 	// Null unless subscription is paused or will pause at the end of the current billing period.
-	PausedAt time.Time `json:"paused_at,omitempty"`
+	PausedAt NullTime `json:"paused_at,omitempty"`
 
 	// Null unless subscription is paused or will pause at the end of the current billing period.
 	RemainingPauseCycles int `json:"remaining_pause_cycles,omitempty"`
@@ -3233,7 +5760,7 @@ type Subscription struct {
 	Currency string `json:"currency,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Subscription unit price
 	UnitAmount float64 `json:"unit_amount,omitempty"`
@@ -3251,7 +5778,7 @@ type Subscription struct {
 	Subtotal float64 `json:"subtotal,omitempty"`
 
 	// Collection method
-	CollectionMethod string `json:"collection_method,omitempty"`
+	CollectionMethod CollectionMethod `json:"collection_method,omitempty"`
 
 	// For manual invoicing, this identifies the PO number associated with the subscription.
 	PoNumber string `json:"po_number,omitempty"`
@@ -3269,35 +5796,115 @@ type Subscription struct {
 	ExpirationReason string `json:"expiration_reason,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomField `json:"custom_fields,omitempty"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
+
+	// Activated at
+	ActivatedAt NullTime `json:"activated_at,omitempty"`
+
+	// Canceled at
+	CanceledAt NullTime `json:"canceled_at,omitempty"`
+
+	// Expires at
+	ExpiresAt NullTime `json:"expires_at,omitempty"`
+
+	// Recurring subscriptions paid with ACH will have this attribute set. This timestamp is used for alerting customers to reauthorize in 3 years in accordance with NACHA rules. If a subscription becomes inactive or the billing info is no longer a bank account, this timestamp is cleared.
+	BankAccountAuthorizedAt NullTime `json:"bank_account_authorized_at,omitempty"`
+}
+
+// GetResponse returns the ResponseMetadata that generated this resource
+func (resource *Subscription) GetResponse() *ResponseMetadata {
+	return resource.recurlyResponse
+}
+
+// setResponse sets the ResponseMetadata that generated this resource
+func (resource *Subscription) setResponse(res *ResponseMetadata) {
+	resource.recurlyResponse = res
+}
+
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Subscription) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Subscription) UnmarshalJSON(data []byte) error {
+	type plain Subscription
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
 
-	// Activated at
-	ActivatedAt time.Time `json:"activated_at,omitempty"`
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Subscription) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
 
-	// Canceled at
-	CanceledAt time.Time `json:"canceled_at,omitempty"`
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Subscription) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
 
-	// Expires at
-	ExpiresAt time.Time `json:"expires_at,omitempty"`
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Subscription) Clone() *Subscription {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Account = *resource.Account.Clone()
+	clone.Plan = *resource.Plan.Clone()
+	clone.Shipping = *resource.Shipping.Clone()
+	if resource.CouponRedemptions != nil {
+		clone.CouponRedemptions = make([]CouponRedemptionMini, len(resource.CouponRedemptions))
+		for i := range resource.CouponRedemptions {
+			clone.CouponRedemptions[i] = *resource.CouponRedemptions[i].Clone()
+		}
+	}
+	clone.PendingChange = *resource.PendingChange.Clone()
+	if resource.AddOns != nil {
+		clone.AddOns = make([]SubscriptionAddOn, len(resource.AddOns))
+		for i := range resource.AddOns {
+			clone.AddOns[i] = *resource.AddOns[i].Clone()
+		}
+	}
+	if resource.CustomFields != nil {
+		clone.CustomFields = make(CustomFields, len(resource.CustomFields))
+		for i := range resource.CustomFields {
+			clone.CustomFields[i] = *resource.CustomFields[i].Clone()
+		}
+	}
+	return &clone
+}
 
-	// Recurring subscriptions paid with ACH will have this attribute set. This timestamp is used for alerting customers to reauthorize in 3 years in accordance with NACHA rules. If a subscription becomes inactive or the billing info is no longer a bank account, this timestamp is cleared.
-	BankAccountAuthorizedAt time.Time `json:"bank_account_authorized_at,omitempty"`
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Subscription) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
 }
 
-// GetResponse returns the ResponseMetadata that generated this resource
-func (resource *Subscription) GetResponse() *ResponseMetadata {
-	return resource.recurlyResponse
+// AddOnsTotalMoney returns the AddOnsTotal as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Subscription) AddOnsTotalMoney() Money {
+	return receivedMoney(resource.rawJSON, "add_ons_total", resource.AddOnsTotal)
 }
 
-// setResponse sets the ResponseMetadata that generated this resource
-func (resource *Subscription) setResponse(res *ResponseMetadata) {
-	resource.recurlyResponse = res
+// SubtotalMoney returns the Subtotal as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Subscription) SubtotalMoney() Money {
+	return receivedMoney(resource.rawJSON, "subtotal", resource.Subtotal)
 }
 
 // internal struct for deserializing accounts
@@ -3317,10 +5924,11 @@ func (resource *subscriptionList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// SubscriptionList allows you to paginate Subscription objects
+// SubscriptionList allows you to paginate Subscription objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type SubscriptionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Subscription
@@ -3328,13 +5936,12 @@ type SubscriptionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *SubscriptionList) Fetch() error {
-	resources := &subscriptionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &subscriptionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*subscriptionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3342,17 +5949,45 @@ func (list *SubscriptionList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *SubscriptionList) Count() (*int64, error) {
-	resources := &subscriptionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &subscriptionList{} })
+}
+
+// Next advances to the next Subscription, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *SubscriptionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Subscription, or nil if Next has not returned true
+func (list *SubscriptionList) Item() *Subscription {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeSubscriptionList.
+func (list *SubscriptionList) Cursor() string {
+	return list.cursor("SubscriptionList", list.HasMore)
+}
+
+// ResumeSubscriptionList returns the SubscriptionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeSubscriptionList(cursor string, params *Params) (*SubscriptionList, error) {
+	p, hasMore, err := resumePager(c, "SubscriptionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &SubscriptionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type SubscriptionShipping struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Object type
 	Object string `json:"object,omitempty"`
@@ -3375,6 +6010,54 @@ func (resource *SubscriptionShipping) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource SubscriptionShipping) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *SubscriptionShipping) UnmarshalJSON(data []byte) error {
+	type plain SubscriptionShipping
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *SubscriptionShipping) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *SubscriptionShipping) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *SubscriptionShipping) Clone() *SubscriptionShipping {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Address = *resource.Address.Clone()
+	clone.Method = *resource.Method.Clone()
+	return &clone
+}
+
+// AmountMoney returns the Amount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *SubscriptionShipping) AmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "amount", resource.Amount)
+}
+
 // internal struct for deserializing accounts
 type subscriptionShippingList struct {
 	ListMetadata
@@ -3392,10 +6075,11 @@ func (resource *subscriptionShippingList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// SubscriptionShippingList allows you to paginate SubscriptionShipping objects
+// SubscriptionShippingList allows you to paginate SubscriptionShipping objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type SubscriptionShippingList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []SubscriptionShipping
@@ -3403,13 +6087,12 @@ type SubscriptionShippingList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *SubscriptionShippingList) Fetch() error {
-	resources := &subscriptionShippingList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &subscriptionShippingList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*subscriptionShippingList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3417,17 +6100,45 @@ func (list *SubscriptionShippingList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *SubscriptionShippingList) Count() (*int64, error) {
-	resources := &subscriptionShippingList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &subscriptionShippingList{} })
+}
+
+// Next advances to the next SubscriptionShipping, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *SubscriptionShippingList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current SubscriptionShipping, or nil if Next has not returned true
+func (list *SubscriptionShippingList) Item() *SubscriptionShipping {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeSubscriptionShippingList.
+func (list *SubscriptionShippingList) Cursor() string {
+	return list.cursor("SubscriptionShippingList", list.HasMore)
+}
+
+// ResumeSubscriptionShippingList returns the SubscriptionShippingList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeSubscriptionShippingList(cursor string, params *Params) (*SubscriptionShippingList, error) {
+	p, hasMore, err := resumePager(c, "SubscriptionShippingList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &SubscriptionShippingList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type ShippingMethodMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Shipping Method ID
 	Id string `json:"id,omitempty"`
@@ -3452,6 +6163,46 @@ func (resource *ShippingMethodMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource ShippingMethodMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *ShippingMethodMini) UnmarshalJSON(data []byte) error {
+	type plain ShippingMethodMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *ShippingMethodMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *ShippingMethodMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *ShippingMethodMini) Clone() *ShippingMethodMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type shippingMethodMiniList struct {
 	ListMetadata
@@ -3469,10 +6220,11 @@ func (resource *shippingMethodMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// ShippingMethodMiniList allows you to paginate ShippingMethodMini objects
+// ShippingMethodMiniList allows you to paginate ShippingMethodMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type ShippingMethodMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []ShippingMethodMini
@@ -3480,13 +6232,12 @@ type ShippingMethodMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *ShippingMethodMiniList) Fetch() error {
-	resources := &shippingMethodMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &shippingMethodMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*shippingMethodMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3494,17 +6245,45 @@ func (list *ShippingMethodMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *ShippingMethodMiniList) Count() (*int64, error) {
-	resources := &shippingMethodMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &shippingMethodMiniList{} })
+}
+
+// Next advances to the next ShippingMethodMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *ShippingMethodMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current ShippingMethodMini, or nil if Next has not returned true
+func (list *ShippingMethodMiniList) Item() *ShippingMethodMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeShippingMethodMiniList.
+func (list *ShippingMethodMiniList) Cursor() string {
+	return list.cursor("ShippingMethodMiniList", list.HasMore)
+}
+
+// ResumeShippingMethodMiniList returns the ShippingMethodMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeShippingMethodMiniList(cursor string, params *Params) (*ShippingMethodMiniList, error) {
+	p, hasMore, err := resumePager(c, "ShippingMethodMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &ShippingMethodMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CouponRedemptionMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Coupon Redemption ID
 	Id string `json:"id,omitempty"`
@@ -3515,13 +6294,13 @@ type CouponRedemptionMini struct {
 	Coupon CouponMini `json:"coupon,omitempty"`
 
 	// Invoice state
-	State string `json:"state,omitempty"`
+	State CouponRedemptionState `json:"state,omitempty"`
 
 	// The amount that was discounted upon the application of the coupon, formatted with the currency.
 	Discounted float64 `json:"discounted,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -3534,6 +6313,53 @@ func (resource *CouponRedemptionMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CouponRedemptionMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CouponRedemptionMini) UnmarshalJSON(data []byte) error {
+	type plain CouponRedemptionMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CouponRedemptionMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CouponRedemptionMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CouponRedemptionMini) Clone() *CouponRedemptionMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Coupon = *resource.Coupon.Clone()
+	return &clone
+}
+
+// DiscountedMoney returns the Discounted as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *CouponRedemptionMini) DiscountedMoney() Money {
+	return receivedMoney(resource.rawJSON, "discounted", resource.Discounted)
+}
+
 // internal struct for deserializing accounts
 type couponRedemptionMiniList struct {
 	ListMetadata
@@ -3551,10 +6377,11 @@ func (resource *couponRedemptionMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponRedemptionMiniList allows you to paginate CouponRedemptionMini objects
+// CouponRedemptionMiniList allows you to paginate CouponRedemptionMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponRedemptionMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CouponRedemptionMini
@@ -3562,13 +6389,12 @@ type CouponRedemptionMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponRedemptionMiniList) Fetch() error {
-	resources := &couponRedemptionMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponRedemptionMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponRedemptionMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3576,17 +6402,45 @@ func (list *CouponRedemptionMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponRedemptionMiniList) Count() (*int64, error) {
-	resources := &couponRedemptionMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponRedemptionMiniList{} })
+}
+
+// Next advances to the next CouponRedemptionMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponRedemptionMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CouponRedemptionMini, or nil if Next has not returned true
+func (list *CouponRedemptionMiniList) Item() *CouponRedemptionMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponRedemptionMiniList.
+func (list *CouponRedemptionMiniList) Cursor() string {
+	return list.cursor("CouponRedemptionMiniList", list.HasMore)
+}
+
+// ResumeCouponRedemptionMiniList returns the CouponRedemptionMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponRedemptionMiniList(cursor string, params *Params) (*CouponRedemptionMiniList, error) {
+	p, hasMore, err := resumePager(c, "CouponRedemptionMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponRedemptionMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CouponMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Coupon ID
 	Id string `json:"id,omitempty"`
@@ -3601,17 +6455,17 @@ type CouponMini struct {
 	Name string `json:"name,omitempty"`
 
 	// Indicates if the coupon is redeemable, and if it is not, why.
-	State string `json:"state,omitempty"`
+	State CouponState `json:"state,omitempty"`
 
 	// Details of the discount a coupon applies. Will contain a `type`
 	// property and one of the following properties: `percent`, `fixed`, `trial`.
 	Discount CouponDiscount `json:"discount,omitempty"`
 
 	// Whether the coupon is "single_code" or "bulk". Bulk coupons will require a `unique_code_template` and will generate unique codes through the `/generate` endpoint.
-	CouponType string `json:"coupon_type,omitempty"`
+	CouponType CouponType `json:"coupon_type,omitempty"`
 
 	// The date and time the coupon was expired early or reached its `max_redemptions`.
-	ExpiredAt time.Time `json:"expired_at,omitempty"`
+	ExpiredAt NullTime `json:"expired_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -3624,6 +6478,47 @@ func (resource *CouponMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CouponMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CouponMini) UnmarshalJSON(data []byte) error {
+	type plain CouponMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CouponMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CouponMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CouponMini) Clone() *CouponMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Discount = *resource.Discount.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type couponMiniList struct {
 	ListMetadata
@@ -3641,10 +6536,11 @@ func (resource *couponMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CouponMiniList allows you to paginate CouponMini objects
+// CouponMiniList allows you to paginate CouponMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CouponMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CouponMini
@@ -3652,13 +6548,12 @@ type CouponMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CouponMiniList) Fetch() error {
-	resources := &couponMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &couponMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*couponMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3666,17 +6561,45 @@ func (list *CouponMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *CouponMiniList) Count() (*int64, error) {
-	resources := &couponMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &couponMiniList{} })
+}
+
+// Next advances to the next CouponMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CouponMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CouponMini, or nil if Next has not returned true
+func (list *CouponMiniList) Item() *CouponMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCouponMiniList.
+func (list *CouponMiniList) Cursor() string {
+	return list.cursor("CouponMiniList", list.HasMore)
+}
+
+// ResumeCouponMiniList returns the CouponMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCouponMiniList(cursor string, params *Params) (*CouponMiniList, error) {
+	p, hasMore, err := resumePager(c, "CouponMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CouponMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type SubscriptionChange struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// The ID of the Subscription Change.
 	Id string `json:"id,omitempty"`
@@ -3703,25 +6626,25 @@ type SubscriptionChange struct {
 	Shipping SubscriptionShipping `json:"shipping,omitempty"`
 
 	// Activated at
-	ActivateAt time.Time `json:"activate_at,omitempty"`
+	ActivateAt NullTime `json:"activate_at,omitempty"`
 
 	// Returns `true` if the subscription change is activated.
 	Activated bool `json:"activated,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Setup fee revenue schedule type
-	SetupFeeRevenueScheduleType string `json:"setup_fee_revenue_schedule_type,omitempty"`
+	SetupFeeRevenueScheduleType SetupFeeRevenueScheduleType `json:"setup_fee_revenue_schedule_type,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Deleted at
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -3734,6 +6657,60 @@ func (resource *SubscriptionChange) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource SubscriptionChange) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *SubscriptionChange) UnmarshalJSON(data []byte) error {
+	type plain SubscriptionChange
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *SubscriptionChange) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *SubscriptionChange) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *SubscriptionChange) Clone() *SubscriptionChange {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.Plan = *resource.Plan.Clone()
+	if resource.AddOns != nil {
+		clone.AddOns = make([]SubscriptionAddOn, len(resource.AddOns))
+		for i := range resource.AddOns {
+			clone.AddOns[i] = *resource.AddOns[i].Clone()
+		}
+	}
+	clone.Shipping = *resource.Shipping.Clone()
+	return &clone
+}
+
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *SubscriptionChange) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
+}
+
 // internal struct for deserializing accounts
 type subscriptionChangeList struct {
 	ListMetadata
@@ -3751,10 +6728,11 @@ func (resource *subscriptionChangeList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// SubscriptionChangeList allows you to paginate SubscriptionChange objects
+// SubscriptionChangeList allows you to paginate SubscriptionChange objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type SubscriptionChangeList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []SubscriptionChange
@@ -3762,13 +6740,12 @@ type SubscriptionChangeList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *SubscriptionChangeList) Fetch() error {
-	resources := &subscriptionChangeList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &subscriptionChangeList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*subscriptionChangeList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3776,17 +6753,45 @@ func (list *SubscriptionChangeList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *SubscriptionChangeList) Count() (*int64, error) {
-	resources := &subscriptionChangeList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &subscriptionChangeList{} })
+}
+
+// Next advances to the next SubscriptionChange, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *SubscriptionChangeList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current SubscriptionChange, or nil if Next has not returned true
+func (list *SubscriptionChangeList) Item() *SubscriptionChange {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeSubscriptionChangeList.
+func (list *SubscriptionChangeList) Cursor() string {
+	return list.cursor("SubscriptionChangeList", list.HasMore)
+}
+
+// ResumeSubscriptionChangeList returns the SubscriptionChangeList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeSubscriptionChangeList(cursor string, params *Params) (*SubscriptionChangeList, error) {
+	p, hasMore, err := resumePager(c, "SubscriptionChangeList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &SubscriptionChangeList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type SubscriptionAddOn struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Subscription Add-on ID
 	Id string `json:"id,omitempty"`
@@ -3807,13 +6812,13 @@ type SubscriptionAddOn struct {
 	UnitAmount float64 `json:"unit_amount,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Expired at
-	ExpiredAt time.Time `json:"expired_at,omitempty"`
+	ExpiredAt NullTime `json:"expired_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -3826,6 +6831,53 @@ func (resource *SubscriptionAddOn) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource SubscriptionAddOn) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *SubscriptionAddOn) UnmarshalJSON(data []byte) error {
+	type plain SubscriptionAddOn
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *SubscriptionAddOn) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *SubscriptionAddOn) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *SubscriptionAddOn) Clone() *SubscriptionAddOn {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	clone.AddOn = *resource.AddOn.Clone()
+	return &clone
+}
+
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *SubscriptionAddOn) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
+}
+
 // internal struct for deserializing accounts
 type subscriptionAddOnList struct {
 	ListMetadata
@@ -3843,10 +6895,11 @@ func (resource *subscriptionAddOnList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// SubscriptionAddOnList allows you to paginate SubscriptionAddOn objects
+// SubscriptionAddOnList allows you to paginate SubscriptionAddOn objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type SubscriptionAddOnList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []SubscriptionAddOn
@@ -3854,13 +6907,12 @@ type SubscriptionAddOnList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *SubscriptionAddOnList) Fetch() error {
-	resources := &subscriptionAddOnList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &subscriptionAddOnList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*subscriptionAddOnList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3868,17 +6920,45 @@ func (list *SubscriptionAddOnList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *SubscriptionAddOnList) Count() (*int64, error) {
-	resources := &subscriptionAddOnList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &subscriptionAddOnList{} })
+}
+
+// Next advances to the next SubscriptionAddOn, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *SubscriptionAddOnList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current SubscriptionAddOn, or nil if Next has not returned true
+func (list *SubscriptionAddOnList) Item() *SubscriptionAddOn {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeSubscriptionAddOnList.
+func (list *SubscriptionAddOnList) Cursor() string {
+	return list.cursor("SubscriptionAddOnList", list.HasMore)
+}
+
+// ResumeSubscriptionAddOnList returns the SubscriptionAddOnList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeSubscriptionAddOnList(cursor string, params *Params) (*SubscriptionAddOnList, error) {
+	p, hasMore, err := resumePager(c, "SubscriptionAddOnList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &SubscriptionAddOnList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AddOnMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Add-on ID
 	Id string `json:"id,omitempty"`
@@ -3912,6 +6992,46 @@ func (resource *AddOnMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AddOnMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AddOnMini) UnmarshalJSON(data []byte) error {
+	type plain AddOnMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AddOnMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AddOnMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AddOnMini) Clone() *AddOnMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type addOnMiniList struct {
 	ListMetadata
@@ -3929,10 +7049,11 @@ func (resource *addOnMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AddOnMiniList allows you to paginate AddOnMini objects
+// AddOnMiniList allows you to paginate AddOnMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AddOnMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AddOnMini
@@ -3940,13 +7061,12 @@ type AddOnMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AddOnMiniList) Fetch() error {
-	resources := &addOnMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &addOnMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*addOnMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -3954,17 +7074,45 @@ func (list *AddOnMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AddOnMiniList) Count() (*int64, error) {
-	resources := &addOnMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &addOnMiniList{} })
+}
+
+// Next advances to the next AddOnMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AddOnMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AddOnMini, or nil if Next has not returned true
+func (list *AddOnMiniList) Item() *AddOnMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAddOnMiniList.
+func (list *AddOnMiniList) Cursor() string {
+	return list.cursor("AddOnMiniList", list.HasMore)
+}
+
+// ResumeAddOnMiniList returns the AddOnMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAddOnMiniList(cursor string, params *Params) (*AddOnMiniList, error) {
+	p, hasMore, err := resumePager(c, "AddOnMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AddOnMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type UniqueCouponCode struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Unique Coupon Code ID
 	Id string `json:"id,omitempty"`
@@ -3976,19 +7124,19 @@ type UniqueCouponCode struct {
 	Code string `json:"code,omitempty"`
 
 	// Indicates if the unique coupon code is redeemable or why not.
-	State string `json:"state,omitempty"`
+	State UniqueCouponCodeState `json:"state,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// The date and time the unique coupon code was redeemed.
-	RedeemedAt time.Time `json:"redeemed_at,omitempty"`
+	RedeemedAt NullTime `json:"redeemed_at,omitempty"`
 
 	// The date and time the coupon was expired early or reached its `max_redemptions`.
-	ExpiredAt time.Time `json:"expired_at,omitempty"`
+	ExpiredAt NullTime `json:"expired_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -4001,6 +7149,46 @@ func (resource *UniqueCouponCode) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource UniqueCouponCode) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *UniqueCouponCode) UnmarshalJSON(data []byte) error {
+	type plain UniqueCouponCode
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *UniqueCouponCode) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *UniqueCouponCode) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *UniqueCouponCode) Clone() *UniqueCouponCode {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type uniqueCouponCodeList struct {
 	ListMetadata
@@ -4018,10 +7206,11 @@ func (resource *uniqueCouponCodeList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// UniqueCouponCodeList allows you to paginate UniqueCouponCode objects
+// UniqueCouponCodeList allows you to paginate UniqueCouponCode objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type UniqueCouponCodeList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []UniqueCouponCode
@@ -4029,13 +7218,12 @@ type UniqueCouponCodeList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *UniqueCouponCodeList) Fetch() error {
-	resources := &uniqueCouponCodeList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &uniqueCouponCodeList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*uniqueCouponCodeList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4043,17 +7231,45 @@ func (list *UniqueCouponCodeList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *UniqueCouponCodeList) Count() (*int64, error) {
-	resources := &uniqueCouponCodeList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &uniqueCouponCodeList{} })
+}
+
+// Next advances to the next UniqueCouponCode, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *UniqueCouponCodeList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current UniqueCouponCode, or nil if Next has not returned true
+func (list *UniqueCouponCodeList) Item() *UniqueCouponCode {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeUniqueCouponCodeList.
+func (list *UniqueCouponCodeList) Cursor() string {
+	return list.cursor("UniqueCouponCodeList", list.HasMore)
+}
+
+// ResumeUniqueCouponCodeList returns the UniqueCouponCodeList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeUniqueCouponCodeList(cursor string, params *Params) (*UniqueCouponCodeList, error) {
+	p, hasMore, err := resumePager(c, "UniqueCouponCodeList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &UniqueCouponCodeList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type CustomFieldDefinition struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Custom field definition ID
 	Id string `json:"id,omitempty"`
@@ -4062,7 +7278,7 @@ type CustomFieldDefinition struct {
 	Object string `json:"object,omitempty"`
 
 	// Related Recurly object type
-	RelatedType string `json:"related_type,omitempty"`
+	RelatedType RelatedType `json:"related_type,omitempty"`
 
 	// Used by the API to identify the field or reading and writing. The name can only be used once per Recurly object type.
 	Name string `json:"name,omitempty"`
@@ -4072,7 +7288,7 @@ type CustomFieldDefinition struct {
 	// - `read_only` - Users with the Customers role will be able to view this field's data via the admin UI, but
 	//   editing will only be available via the API.
 	// - `write` - Users with the Customers role will be able to view and edit this field's data via the admin UI.
-	UserAccess string `json:"user_access,omitempty"`
+	UserAccess UserAccess `json:"user_access,omitempty"`
 
 	// Used to label the field when viewing and editing the field in Recurly's admin UI.
 	DisplayName string `json:"display_name,omitempty"`
@@ -4081,13 +7297,13 @@ type CustomFieldDefinition struct {
 	Tooltip string `json:"tooltip,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Definitions are initially soft deleted, and once all the values are removed from the accouts or subscriptions, will be hard deleted an no longer visible.
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -4100,6 +7316,46 @@ func (resource *CustomFieldDefinition) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource CustomFieldDefinition) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *CustomFieldDefinition) UnmarshalJSON(data []byte) error {
+	type plain CustomFieldDefinition
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *CustomFieldDefinition) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *CustomFieldDefinition) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *CustomFieldDefinition) Clone() *CustomFieldDefinition {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type customFieldDefinitionList struct {
 	ListMetadata
@@ -4117,10 +7373,11 @@ func (resource *customFieldDefinitionList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// CustomFieldDefinitionList allows you to paginate CustomFieldDefinition objects
+// CustomFieldDefinitionList allows you to paginate CustomFieldDefinition objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type CustomFieldDefinitionList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []CustomFieldDefinition
@@ -4128,31 +7385,58 @@ type CustomFieldDefinitionList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *CustomFieldDefinitionList) Fetch() error {
-	resources := &customFieldDefinitionList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &customFieldDefinitionList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*customFieldDefinitionList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
 }
 
-// Count returns the count of items on the server that match this pager
-func (list *CustomFieldDefinitionList) Count() (*int64, error) {
-	resources := &customFieldDefinitionList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+// Count returns the count of items on the server that match this pager
+func (list *CustomFieldDefinitionList) Count() (*int64, error) {
+	return list.count(func() listPage { return &customFieldDefinitionList{} })
+}
+
+// Next advances to the next CustomFieldDefinition, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *CustomFieldDefinitionList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current CustomFieldDefinition, or nil if Next has not returned true
+func (list *CustomFieldDefinitionList) Item() *CustomFieldDefinition {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeCustomFieldDefinitionList.
+func (list *CustomFieldDefinitionList) Cursor() string {
+	return list.cursor("CustomFieldDefinitionList", list.HasMore)
+}
+
+// ResumeCustomFieldDefinitionList returns the CustomFieldDefinitionList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeCustomFieldDefinitionList(cursor string, params *Params) (*CustomFieldDefinitionList, error) {
+	p, hasMore, err := resumePager(c, "CustomFieldDefinitionList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &CustomFieldDefinitionList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Item struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Item ID
 	Id string `json:"id,omitempty"`
@@ -4164,7 +7448,7 @@ type Item struct {
 	Code string `json:"code,omitempty"`
 
 	// The current state of the item.
-	State string `json:"state,omitempty"`
+	State ItemState `json:"state,omitempty"`
 
 	// This name describes your item and will appear on the invoice when it's purchased on a one time basis.
 	Name string `json:"name,omitempty"`
@@ -4179,7 +7463,7 @@ type Item struct {
 	AccountingCode string `json:"accounting_code,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Used by Avalara, Vertex, and Recurly’s EU VAT tax feature. The tax code values are specific to each tax system. If you are using Recurly’s EU VAT feature you can use `unknown`, `physical`, or `digital`.
 	TaxCode string `json:"tax_code,omitempty"`
@@ -4188,19 +7472,19 @@ type Item struct {
 	TaxExempt bool `json:"tax_exempt,omitempty"`
 
 	// The custom fields will only be altered when they are included in a request. Sending an empty array will not remove any existing values. To remove a field send the name with a null or empty value.
-	CustomFields []CustomField `json:"custom_fields,omitempty"`
+	CustomFields CustomFields `json:"custom_fields,omitempty"`
 
 	// Item Pricing
 	Currencies []Pricing `json:"currencies,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Deleted at
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -4213,6 +7497,58 @@ func (resource *Item) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Item) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Item) UnmarshalJSON(data []byte) error {
+	type plain Item
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Item) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Item) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Item) Clone() *Item {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.CustomFields != nil {
+		clone.CustomFields = make(CustomFields, len(resource.CustomFields))
+		for i := range resource.CustomFields {
+			clone.CustomFields[i] = *resource.CustomFields[i].Clone()
+		}
+	}
+	if resource.Currencies != nil {
+		clone.Currencies = make([]Pricing, len(resource.Currencies))
+		for i := range resource.Currencies {
+			clone.Currencies[i] = *resource.Currencies[i].Clone()
+		}
+	}
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type itemList struct {
 	ListMetadata
@@ -4230,10 +7566,11 @@ func (resource *itemList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// ItemList allows you to paginate Item objects
+// ItemList allows you to paginate Item objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type ItemList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Item
@@ -4241,13 +7578,12 @@ type ItemList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *ItemList) Fetch() error {
-	resources := &itemList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &itemList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*itemList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4255,17 +7591,45 @@ func (list *ItemList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *ItemList) Count() (*int64, error) {
-	resources := &itemList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &itemList{} })
+}
+
+// Next advances to the next Item, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *ItemList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Item, or nil if Next has not returned true
+func (list *ItemList) Item() *Item {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeItemList.
+func (list *ItemList) Cursor() string {
+	return list.cursor("ItemList", list.HasMore)
+}
+
+// ResumeItemList returns the ItemList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeItemList(cursor string, params *Params) (*ItemList, error) {
+	p, hasMore, err := resumePager(c, "ItemList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &ItemList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Pricing struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -4284,6 +7648,52 @@ func (resource *Pricing) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Pricing) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Pricing) UnmarshalJSON(data []byte) error {
+	type plain Pricing
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Pricing) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Pricing) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Pricing) Clone() *Pricing {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *Pricing) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
+}
+
 // internal struct for deserializing accounts
 type pricingList struct {
 	ListMetadata
@@ -4301,10 +7711,11 @@ func (resource *pricingList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// PricingList allows you to paginate Pricing objects
+// PricingList allows you to paginate Pricing objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type PricingList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Pricing
@@ -4312,13 +7723,12 @@ type PricingList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *PricingList) Fetch() error {
-	resources := &pricingList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &pricingList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*pricingList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4326,17 +7736,45 @@ func (list *PricingList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *PricingList) Count() (*int64, error) {
-	resources := &pricingList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &pricingList{} })
+}
+
+// Next advances to the next Pricing, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *PricingList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Pricing, or nil if Next has not returned true
+func (list *PricingList) Item() *Pricing {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumePricingList.
+func (list *PricingList) Cursor() string {
+	return list.cursor("PricingList", list.HasMore)
+}
+
+// ResumePricingList returns the PricingList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumePricingList(cursor string, params *Params) (*PricingList, error) {
+	p, hasMore, err := resumePager(c, "PricingList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &PricingList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type BinaryFile struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	Data string `json:"data,omitempty"`
 }
@@ -4351,6 +7789,46 @@ func (resource *BinaryFile) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource BinaryFile) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *BinaryFile) UnmarshalJSON(data []byte) error {
+	type plain BinaryFile
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *BinaryFile) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *BinaryFile) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *BinaryFile) Clone() *BinaryFile {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type binaryFileList struct {
 	ListMetadata
@@ -4368,10 +7846,11 @@ func (resource *binaryFileList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// BinaryFileList allows you to paginate BinaryFile objects
+// BinaryFileList allows you to paginate BinaryFile objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type BinaryFileList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []BinaryFile
@@ -4379,13 +7858,12 @@ type BinaryFileList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *BinaryFileList) Fetch() error {
-	resources := &binaryFileList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &binaryFileList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*binaryFileList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4393,17 +7871,45 @@ func (list *BinaryFileList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *BinaryFileList) Count() (*int64, error) {
-	resources := &binaryFileList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &binaryFileList{} })
+}
+
+// Next advances to the next BinaryFile, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *BinaryFileList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current BinaryFile, or nil if Next has not returned true
+func (list *BinaryFileList) Item() *BinaryFile {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeBinaryFileList.
+func (list *BinaryFileList) Cursor() string {
+	return list.cursor("BinaryFileList", list.HasMore)
+}
+
+// ResumeBinaryFileList returns the BinaryFileList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeBinaryFileList(cursor string, params *Params) (*BinaryFileList, error) {
+	p, hasMore, err := resumePager(c, "BinaryFileList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &BinaryFileList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type Plan struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Plan ID
 	Id string `json:"id,omitempty"`
@@ -4415,7 +7921,7 @@ type Plan struct {
 	Code string `json:"code,omitempty"`
 
 	// The current state of the plan.
-	State string `json:"state,omitempty"`
+	State PlanState `json:"state,omitempty"`
 
 	// This name describes your plan and will appear on the Hosted Payment Page and the subscriber's invoice.
 	Name string `json:"name,omitempty"`
@@ -4424,13 +7930,13 @@ type Plan struct {
 	Description string `json:"description,omitempty"`
 
 	// Unit for the plan's billing interval.
-	IntervalUnit string `json:"interval_unit,omitempty"`
+	IntervalUnit IntervalUnit `json:"interval_unit,omitempty"`
 
 	// Length of the plan's billing interval in `interval_unit`.
 	IntervalLength int `json:"interval_length,omitempty"`
 
 	// Units for the plan's trial period.
-	TrialUnit string `json:"trial_unit,omitempty"`
+	TrialUnit TrialUnit `json:"trial_unit,omitempty"`
 
 	// Length of plan's trial period in `trial_units`. `0` means `no trial`.
 	TrialLength int `json:"trial_length,omitempty"`
@@ -4445,10 +7951,10 @@ type Plan struct {
 	AccountingCode string `json:"accounting_code,omitempty"`
 
 	// Revenue schedule type
-	RevenueScheduleType string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Setup fee revenue schedule type
-	SetupFeeRevenueScheduleType string `json:"setup_fee_revenue_schedule_type,omitempty"`
+	SetupFeeRevenueScheduleType SetupFeeRevenueScheduleType `json:"setup_fee_revenue_schedule_type,omitempty"`
 
 	// Accounting code for invoice line items for the plan's setup fee. If no value is provided, it defaults to plan's accounting code.
 	SetupFeeAccountingCode string `json:"setup_fee_accounting_code,omitempty"`
@@ -4466,13 +7972,13 @@ type Plan struct {
 	HostedPages PlanHostedPages `json:"hosted_pages,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Deleted at
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -4485,6 +7991,53 @@ func (resource *Plan) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource Plan) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *Plan) UnmarshalJSON(data []byte) error {
+	type plain Plan
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *Plan) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *Plan) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *Plan) Clone() *Plan {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.Currencies != nil {
+		clone.Currencies = make([]PlanPricing, len(resource.Currencies))
+		for i := range resource.Currencies {
+			clone.Currencies[i] = *resource.Currencies[i].Clone()
+		}
+	}
+	clone.HostedPages = *resource.HostedPages.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type planList struct {
 	ListMetadata
@@ -4502,10 +8055,11 @@ func (resource *planList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// PlanList allows you to paginate Plan objects
+// PlanList allows you to paginate Plan objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type PlanList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []Plan
@@ -4513,13 +8067,12 @@ type PlanList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *PlanList) Fetch() error {
-	resources := &planList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &planList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*planList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4527,17 +8080,45 @@ func (list *PlanList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *PlanList) Count() (*int64, error) {
-	resources := &planList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &planList{} })
+}
+
+// Next advances to the next Plan, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *PlanList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current Plan, or nil if Next has not returned true
+func (list *PlanList) Item() *Plan {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumePlanList.
+func (list *PlanList) Cursor() string {
+	return list.cursor("PlanList", list.HasMore)
+}
+
+// ResumePlanList returns the PlanList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumePlanList(cursor string, params *Params) (*PlanList, error) {
+	p, hasMore, err := resumePager(c, "PlanList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &PlanList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type PlanPricing struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -4559,6 +8140,58 @@ func (resource *PlanPricing) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource PlanPricing) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *PlanPricing) UnmarshalJSON(data []byte) error {
+	type plain PlanPricing
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *PlanPricing) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *PlanPricing) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *PlanPricing) Clone() *PlanPricing {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
+// SetupFeeMoney returns the SetupFee as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *PlanPricing) SetupFeeMoney() Money {
+	return receivedMoney(resource.rawJSON, "setup_fee", resource.SetupFee)
+}
+
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *PlanPricing) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
+}
+
 // internal struct for deserializing accounts
 type planPricingList struct {
 	ListMetadata
@@ -4576,10 +8209,11 @@ func (resource *planPricingList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// PlanPricingList allows you to paginate PlanPricing objects
+// PlanPricingList allows you to paginate PlanPricing objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type PlanPricingList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []PlanPricing
@@ -4587,13 +8221,12 @@ type PlanPricingList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *PlanPricingList) Fetch() error {
-	resources := &planPricingList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &planPricingList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*planPricingList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4601,17 +8234,45 @@ func (list *PlanPricingList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *PlanPricingList) Count() (*int64, error) {
-	resources := &planPricingList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &planPricingList{} })
+}
+
+// Next advances to the next PlanPricing, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *PlanPricingList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current PlanPricing, or nil if Next has not returned true
+func (list *PlanPricingList) Item() *PlanPricing {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumePlanPricingList.
+func (list *PlanPricingList) Cursor() string {
+	return list.cursor("PlanPricingList", list.HasMore)
+}
+
+// ResumePlanPricingList returns the PlanPricingList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumePlanPricingList(cursor string, params *Params) (*PlanPricingList, error) {
+	p, hasMore, err := resumePager(c, "PlanPricingList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &PlanPricingList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type PlanHostedPages struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// URL to redirect to after signup on the hosted payment pages.
 	SuccessUrl string `json:"success_url,omitempty"`
@@ -4636,6 +8297,46 @@ func (resource *PlanHostedPages) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource PlanHostedPages) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *PlanHostedPages) UnmarshalJSON(data []byte) error {
+	type plain PlanHostedPages
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *PlanHostedPages) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *PlanHostedPages) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *PlanHostedPages) Clone() *PlanHostedPages {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type planHostedPagesList struct {
 	ListMetadata
@@ -4653,10 +8354,11 @@ func (resource *planHostedPagesList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// PlanHostedPagesList allows you to paginate PlanHostedPages objects
+// PlanHostedPagesList allows you to paginate PlanHostedPages objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type PlanHostedPagesList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []PlanHostedPages
@@ -4664,13 +8366,12 @@ type PlanHostedPagesList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *PlanHostedPagesList) Fetch() error {
-	resources := &planHostedPagesList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &planHostedPagesList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*planHostedPagesList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4678,17 +8379,45 @@ func (list *PlanHostedPagesList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *PlanHostedPagesList) Count() (*int64, error) {
-	resources := &planHostedPagesList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &planHostedPagesList{} })
+}
+
+// Next advances to the next PlanHostedPages, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *PlanHostedPagesList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current PlanHostedPages, or nil if Next has not returned true
+func (list *PlanHostedPagesList) Item() *PlanHostedPages {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumePlanHostedPagesList.
+func (list *PlanHostedPagesList) Cursor() string {
+	return list.cursor("PlanHostedPagesList", list.HasMore)
+}
+
+// ResumePlanHostedPagesList returns the PlanHostedPagesList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumePlanHostedPagesList(cursor string, params *Params) (*PlanHostedPagesList, error) {
+	p, hasMore, err := resumePager(c, "PlanHostedPagesList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &PlanHostedPagesList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AddOn struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Add-on ID
 	Id string `json:"id,omitempty"`
@@ -4703,7 +8432,7 @@ type AddOn struct {
 	Code string `json:"code,omitempty"`
 
 	// Add-ons can be either active or inactive.
-	State string `json:"state,omitempty"`
+	State AddOnState `json:"state,omitempty"`
 
 	// Describes your add-on and will appear in subscribers' invoices.
 	Name string `json:"name,omitempty"`
@@ -4712,7 +8441,7 @@ type AddOn struct {
 	AccountingCode string `json:"accounting_code,omitempty"`
 
 	// When this add-on is invoiced, the line item will use this revenue schedule. If `item_code`/`item_id` is part of the request then `revenue_schedule_type` must be absent in the request as the value will be set from the item.
-	RevenueScheduleType string `json:"revenue_schedule_type,omitempty"`
+	RevenueScheduleType RevenueScheduleType `json:"revenue_schedule_type,omitempty"`
 
 	// Used by Avalara, Vertex, and Recurly’s EU VAT tax feature. The tax code values are specific to each tax system. If you are using Recurly’s EU VAT feature you can use `unknown`, `physical`, or `digital`.
 	TaxCode string `json:"tax_code,omitempty"`
@@ -4736,13 +8465,13 @@ type AddOn struct {
 	ExternalSku string `json:"external_sku,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Deleted at
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -4755,6 +8484,53 @@ func (resource *AddOn) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AddOn) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AddOn) UnmarshalJSON(data []byte) error {
+	type plain AddOn
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AddOn) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AddOn) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AddOn) Clone() *AddOn {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	if resource.Currencies != nil {
+		clone.Currencies = make([]AddOnPricing, len(resource.Currencies))
+		for i := range resource.Currencies {
+			clone.Currencies[i] = *resource.Currencies[i].Clone()
+		}
+	}
+	clone.Item = *resource.Item.Clone()
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type addOnList struct {
 	ListMetadata
@@ -4772,10 +8548,11 @@ func (resource *addOnList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AddOnList allows you to paginate AddOn objects
+// AddOnList allows you to paginate AddOn objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AddOnList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AddOn
@@ -4783,13 +8560,12 @@ type AddOnList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AddOnList) Fetch() error {
-	resources := &addOnList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &addOnList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*addOnList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4797,17 +8573,45 @@ func (list *AddOnList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AddOnList) Count() (*int64, error) {
-	resources := &addOnList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &addOnList{} })
+}
+
+// Next advances to the next AddOn, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AddOnList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AddOn, or nil if Next has not returned true
+func (list *AddOnList) Item() *AddOn {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAddOnList.
+func (list *AddOnList) Cursor() string {
+	return list.cursor("AddOnList", list.HasMore)
+}
+
+// ResumeAddOnList returns the AddOnList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAddOnList(cursor string, params *Params) (*AddOnList, error) {
+	p, hasMore, err := resumePager(c, "AddOnList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AddOnList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type AddOnPricing struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// 3-letter ISO 4217 currency code.
 	Currency string `json:"currency,omitempty"`
@@ -4826,6 +8630,52 @@ func (resource *AddOnPricing) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource AddOnPricing) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *AddOnPricing) UnmarshalJSON(data []byte) error {
+	type plain AddOnPricing
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *AddOnPricing) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *AddOnPricing) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *AddOnPricing) Clone() *AddOnPricing {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
+// UnitAmountMoney returns the UnitAmount as an exact Money. It has every
+// digit the API sent, unless the field was changed since.
+func (resource *AddOnPricing) UnitAmountMoney() Money {
+	return receivedMoney(resource.rawJSON, "unit_amount", resource.UnitAmount)
+}
+
 // internal struct for deserializing accounts
 type addOnPricingList struct {
 	ListMetadata
@@ -4843,10 +8693,11 @@ func (resource *addOnPricingList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// AddOnPricingList allows you to paginate AddOnPricing objects
+// AddOnPricingList allows you to paginate AddOnPricing objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type AddOnPricingList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []AddOnPricing
@@ -4854,13 +8705,12 @@ type AddOnPricingList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *AddOnPricingList) Fetch() error {
-	resources := &addOnPricingList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &addOnPricingList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*addOnPricingList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4868,17 +8718,45 @@ func (list *AddOnPricingList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *AddOnPricingList) Count() (*int64, error) {
-	resources := &addOnPricingList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &addOnPricingList{} })
+}
+
+// Next advances to the next AddOnPricing, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *AddOnPricingList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current AddOnPricing, or nil if Next has not returned true
+func (list *AddOnPricingList) Item() *AddOnPricing {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeAddOnPricingList.
+func (list *AddOnPricingList) Cursor() string {
+	return list.cursor("AddOnPricingList", list.HasMore)
+}
+
+// ResumeAddOnPricingList returns the AddOnPricingList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeAddOnPricingList(cursor string, params *Params) (*AddOnPricingList, error) {
+	p, hasMore, err := resumePager(c, "AddOnPricingList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &AddOnPricingList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type ItemMini struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Item ID
 	Id string `json:"id,omitempty"`
@@ -4890,7 +8768,7 @@ type ItemMini struct {
 	Code string `json:"code,omitempty"`
 
 	// The current state of the item.
-	State string `json:"state,omitempty"`
+	State ItemState `json:"state,omitempty"`
 
 	// This name describes your item and will appear on the invoice when it's purchased on a one time basis.
 	Name string `json:"name,omitempty"`
@@ -4909,6 +8787,46 @@ func (resource *ItemMini) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource ItemMini) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *ItemMini) UnmarshalJSON(data []byte) error {
+	type plain ItemMini
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *ItemMini) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *ItemMini) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *ItemMini) Clone() *ItemMini {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type itemMiniList struct {
 	ListMetadata
@@ -4926,10 +8844,11 @@ func (resource *itemMiniList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// ItemMiniList allows you to paginate ItemMini objects
+// ItemMiniList allows you to paginate ItemMini objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type ItemMiniList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []ItemMini
@@ -4937,13 +8856,12 @@ type ItemMiniList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *ItemMiniList) Fetch() error {
-	resources := &itemMiniList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &itemMiniList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*itemMiniList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -4951,17 +8869,45 @@ func (list *ItemMiniList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *ItemMiniList) Count() (*int64, error) {
-	resources := &itemMiniList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &itemMiniList{} })
+}
+
+// Next advances to the next ItemMini, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *ItemMiniList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current ItemMini, or nil if Next has not returned true
+func (list *ItemMiniList) Item() *ItemMini {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeItemMiniList.
+func (list *ItemMiniList) Cursor() string {
+	return list.cursor("ItemMiniList", list.HasMore)
+}
+
+// ResumeItemMiniList returns the ItemMiniList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeItemMiniList(cursor string, params *Params) (*ItemMiniList, error) {
+	p, hasMore, err := resumePager(c, "ItemMiniList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &ItemMiniList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
 
 type ShippingMethod struct {
 	recurlyResponse *ResponseMetadata
+	rawJSON         json.RawMessage
 
 	// Shipping Method ID
 	Id string `json:"id,omitempty"`
@@ -4988,13 +8934,13 @@ type ShippingMethod struct {
 	TaxCode string `json:"tax_code,omitempty"`
 
 	// Created at
-	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedAt NullTime `json:"created_at,omitempty"`
 
 	// Last updated at
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedAt NullTime `json:"updated_at,omitempty"`
 
 	// Deleted at
-	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	DeletedAt NullTime `json:"deleted_at,omitempty"`
 }
 
 // GetResponse returns the ResponseMetadata that generated this resource
@@ -5007,6 +8953,46 @@ func (resource *ShippingMethod) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
+// MarshalJSON encodes the resource, leaving out the timestamps it was decoded
+// without and keeping its unknown fields
+func (resource ShippingMethod) MarshalJSON() ([]byte, error) {
+	return marshalResource(&resource, resource.rawJSON)
+}
+
+// UnmarshalJSON decodes the resource and keeps the JSON it was decoded from
+func (resource *ShippingMethod) UnmarshalJSON(data []byte) error {
+	type plain ShippingMethod
+	if err := json.Unmarshal(data, (*plain)(resource)); err != nil {
+		return err
+	}
+	resource.rawJSON = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// RawJSON returns the JSON the resource was decoded from, or nil if it was
+// not decoded
+func (resource *ShippingMethod) RawJSON() json.RawMessage {
+	return resource.rawJSON
+}
+
+// UnknownFields returns the fields of the JSON the resource was decoded from
+// which it has no field for, such as fields added to the API after the client
+// was generated
+func (resource *ShippingMethod) UnknownFields() map[string]json.RawMessage {
+	return unknownFields(resource, resource.rawJSON)
+}
+
+// Clone returns a deep copy of the resource, which shares no data with it.
+// The copy keeps the ResponseMetadata of the resource.
+func (resource *ShippingMethod) Clone() *ShippingMethod {
+	if resource == nil {
+		return nil
+	}
+	clone := *resource
+	clone.rawJSON = cloneBytes(resource.rawJSON)
+	return &clone
+}
+
 // internal struct for deserializing accounts
 type shippingMethodList struct {
 	ListMetadata
@@ -5024,10 +9010,11 @@ func (resource *shippingMethodList) setResponse(res *ResponseMetadata) {
 	resource.recurlyResponse = res
 }
 
-// ShippingMethodList allows you to paginate ShippingMethod objects
+// ShippingMethodList allows you to paginate ShippingMethod objects. Call Close when
+// a loop over the list can end before its last page, to stop the fetching of
+// pages in the background.
 type ShippingMethodList struct {
-	client       *Client
-	nextPagePath string
+	pager
 
 	HasMore bool
 	Data    []ShippingMethod
@@ -5035,13 +9022,12 @@ type ShippingMethodList struct {
 
 // Fetch fetches the next page of data into the `Data` property
 func (list *ShippingMethodList) Fetch() error {
-	resources := &shippingMethodList{}
-	err := list.client.Call(http.MethodGet, list.nextPagePath, nil, resources)
+	page, err := list.fetchPage(func() listPage { return &shippingMethodList{} })
 	if err != nil {
 		return err
 	}
+	resources := page.(*shippingMethodList)
 	// copy over properties from the response
-	list.nextPagePath = resources.Next
 	list.HasMore = resources.HasMore
 	list.Data = resources.Data
 	return nil
@@ -5049,11 +9035,38 @@ func (list *ShippingMethodList) Fetch() error {
 
 // Count returns the count of items on the server that match this pager
 func (list *ShippingMethodList) Count() (*int64, error) {
-	resources := &shippingMethodList{}
-	err := list.client.Call(http.MethodHead, list.nextPagePath, nil, resources)
+	return list.count(func() listPage { return &shippingMethodList{} })
+}
+
+// Next advances to the next ShippingMethod, fetching the next page when needed.
+// It returns false after the last item or when an error occurs, see Err.
+func (list *ShippingMethodList) Next() bool {
+	return list.next(list.Fetch, func() bool { return list.HasMore }, func() int { return len(list.Data) })
+}
+
+// Item returns the current ShippingMethod, or nil if Next has not returned true
+func (list *ShippingMethodList) Item() *ShippingMethod {
+	if i := list.current(len(list.Data)); i >= 0 {
+		return &list.Data[i]
+	}
+	return nil
+}
+
+// Cursor returns an opaque cursor to the page after the last fetched one. It
+// can be saved after each page to resume the list with ResumeShippingMethodList.
+func (list *ShippingMethodList) Cursor() string {
+	return list.cursor("ShippingMethodList", list.HasMore)
+}
+
+// ResumeShippingMethodList returns the ShippingMethodList saved by its Cursor. The headers
+// and context of the params, if any, are used to fetch every page.
+func (c *Client) ResumeShippingMethodList(cursor string, params *Params) (*ShippingMethodList, error) {
+	p, hasMore, err := resumePager(c, "ShippingMethodList", cursor, params)
 	if err != nil {
 		return nil, err
 	}
-	resp := resources.GetResponse()
-	return resp.TotalRecords, nil
+	return &ShippingMethodList{
+		pager:   p,
+		HasMore: hasMore,
+	}, nil
 }
@@ -0,0 +1,65 @@
+package recurly
+
+import "time"
+
+const (
+	// DefaultMaxAttempts is the number of times a RetryPolicy sends a
+	// request when its MaxAttempts is 0
+	DefaultMaxAttempts = 3
+	// DefaultMaxRetryDelay is the longest a RetryPolicy waits before a
+	// retry when its MaxDelay is 0
+	DefaultMaxRetryDelay = 30 * time.Second
+)
+
+// RetryPolicy makes a client send requests again when they fail with a
+// retryable *Error, see Error.IsRetryable, after waiting for its RetryDelay.
+// Other errors are returned at once. Set it on Client.Retry.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a request is sent at most,
+	// including the first. It defaults to DefaultMaxAttempts.
+	MaxAttempts int
+	// MaxDelay caps the wait before each retry. It defaults to
+	// DefaultMaxRetryDelay.
+	MaxDelay time.Duration
+}
+
+// retryDelay returns how long to wait before sending the request again after
+// the attempt failed with err, and false if it shouldn't be sent again
+func (policy *RetryPolicy) retryDelay(attempt int, err error) (time.Duration, bool) {
+	if policy == nil || err == nil {
+		return 0, false
+	}
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	e, ok := err.(*Error)
+	if !ok || !e.IsRetryable() || attempt >= maxAttempts {
+		return 0, false
+	}
+	maxDelay := policy.MaxDelay
+	if maxDelay == 0 {
+		maxDelay = DefaultMaxRetryDelay
+	}
+	delay := e.RetryDelay()
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay, true
+}
+
+// waitRetry waits for the delay, or until the context of the params is done
+func waitRetry(params *Params, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	var done <-chan struct{}
+	if params != nil && params.Context != nil {
+		done = params.Context.Done()
+	}
+	select {
+	case <-timer.C:
+		return nil
+	case <-done:
+		return params.Context.Err()
+	}
+}
@@ -0,0 +1,102 @@
+package recurly
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// retryScenario fails the first requests with the given responses, then
+// returns an account
+func retryScenario(t *T, requests *int, failures ...string) *Client {
+	scenario := &Scenario{
+		T:             t,
+		AssertRequest: func(req *http.Request) { *requests++ },
+		MakeResponse: func(req *http.Request) *http.Response {
+			if *requests <= len(failures) {
+				return mockResponse(req, 429, String(failures[*requests-1]))
+			}
+			return mockResponse(req, 200, String(`{"id": "abcd1234"}`))
+		},
+	}
+	client := scenario.MockHTTPClient()
+	client.Log = NewLogger(LevelError)
+	return client
+}
+
+const (
+	rateLimitedBody   = `{"error": {"type": "rate_limited", "message": "Too many requests"}}`
+	notFoundBody      = `{"error": {"type": "not_found", "message": "Not found"}}`
+	simultaneousBody  = `{"error": {"type": "simultaneous_request", "message": "Simultaneous request"}}`
+	communicationBody = `{"error": {"type": "transaction", "message": "Gateway unreachable", "transaction_error": {"category": "communication"}}}`
+)
+
+func TestRequestsAreNotRetriedByDefault(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	client := retryScenario(t, &requests, rateLimitedBody)
+
+	_, err := client.GetAccount("abcd1234")
+	t.Assert(err.(*Error).Type, ErrorTypeRateLimited, "Error.Type")
+	t.Assert(requests, 1, "Number of requests")
+}
+
+func TestRetryableErrorsAreRetried(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	client := retryScenario(t, &requests, rateLimitedBody, simultaneousBody)
+	client.Retry = &RetryPolicy{MaxDelay: time.Millisecond}
+
+	account, err := client.GetAccount("abcd1234")
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(account.Id, "abcd1234", "Account.Id")
+	t.Assert(requests, 3, "Number of requests")
+}
+
+func TestRetryStopsAtMaxAttempts(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	client := retryScenario(t, &requests, rateLimitedBody, rateLimitedBody, rateLimitedBody)
+	client.Retry = &RetryPolicy{MaxAttempts: 2, MaxDelay: time.Millisecond}
+
+	_, err := client.GetAccount("abcd1234")
+	t.Assert(err.(*Error).Type, ErrorTypeRateLimited, "Error.Type")
+	t.Assert(requests, 2, "Number of requests")
+}
+
+func TestOtherErrorsAreNotRetried(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	client := retryScenario(t, &requests, notFoundBody)
+	client.Retry = &RetryPolicy{MaxDelay: time.Millisecond}
+
+	_, err := client.GetAccount("abcd1234")
+	t.Assert(err.(*Error).Type, ErrorTypeNotFound, "Error.Type")
+	t.Assert(requests, 1, "Number of requests")
+}
+
+func TestRetryWaitStopsWithContext(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	client := retryScenario(t, &requests, rateLimitedBody)
+	client.Retry = &RetryPolicy{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	params := &Params{Context: ctx}
+	err := client.Call(http.MethodGet, "/accounts/abcd1234", params, &Account{})
+	t.Assert(err, context.DeadlineExceeded, "Error after the context is done")
+	t.Assert(requests, 1, "Number of requests")
+}
+
+func TestUnreachableGatewayIsNotRetried(test *testing.T) {
+	t := &T{test}
+	requests := 0
+	client := retryScenario(t, &requests, communicationBody)
+	client.Retry = &RetryPolicy{MaxDelay: time.Millisecond}
+
+	_, err := client.CreatePurchase(&PurchaseCreate{})
+	t.Assert(err.(*Error).TransactionError.IsCommunication(), true, "IsCommunication()")
+	t.Assert(requests, 1, "Number of requests")
+}
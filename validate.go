@@ -0,0 +1,115 @@
+package recurly
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// requestValidator is implemented by the request bodies, see Validate
+type requestValidator interface {
+	Validate() error
+}
+
+// validateRequest checks the body of the request, if any
+func validateRequest(params *Params) error {
+	if params == nil {
+		return nil
+	}
+	if v, ok := params.Data.(requestValidator); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// requestError returns a validation error for the invalid fields of a request
+// body, or nil if there are none
+func requestError(invalid []ErrorParam) error {
+	return validationError("invalid request", invalid)
+}
+
+// checkRequired checks that a required field is set
+func checkRequired(invalid []ErrorParam, property string, set bool) []ErrorParam {
+	if set {
+		return invalid
+	}
+	return append(invalid, ErrorParam{Property: property, Message: "can't be blank"})
+}
+
+// checkOneOf checks that exactly one of the properties is set
+func checkOneOf(invalid []ErrorParam, path string, properties []string, set ...bool) []ErrorParam {
+	var first string
+	for i, s := range set {
+		if !s {
+			continue
+		}
+		if first != "" {
+			return append(invalid, ErrorParam{
+				Property: path + properties[i],
+				Message:  "can't be set with " + first,
+			})
+		}
+		first = properties[i]
+	}
+	if first == "" {
+		return append(invalid, ErrorParam{
+			Property: path + properties[0],
+			Message:  "can't be blank unless " + strings.Join(properties[1:], " or ") + " is set",
+		})
+	}
+	return invalid
+}
+
+// checkString checks the length of a string, that it matches the pattern if
+// not nil, and that it is one of the values if any. A maximum length of 0
+// means there is no maximum.
+func checkString(invalid []ErrorParam, property string, value string, minLength int, maxLength int, pattern *regexp.Regexp, values []string) []ErrorParam {
+	length := utf8.RuneCountInString(value)
+	switch {
+	case length < minLength:
+		return append(invalid, ErrorParam{
+			Property: property,
+			Message:  "is too short (minimum is " + strconv.Itoa(minLength) + " characters)",
+		})
+	case maxLength > 0 && length > maxLength:
+		return append(invalid, ErrorParam{
+			Property: property,
+			Message:  "is too long (maximum is " + strconv.Itoa(maxLength) + " characters)",
+		})
+	case pattern != nil && !pattern.MatchString(value):
+		return append(invalid, ErrorParam{Property: property, Message: "is invalid"})
+	case len(values) > 0 && !containsString(values, value):
+		return append(invalid, ErrorParam{
+			Property: property,
+			Message:  "must be one of " + strings.Join(values, ", ") + ", got \"" + value + "\"",
+		})
+	}
+	return invalid
+}
+
+// checkNumber checks that a number is within its bounds, if any
+func checkNumber(invalid []ErrorParam, property string, value float64, minimum *float64, maximum *float64) []ErrorParam {
+	switch {
+	case minimum != nil && value < *minimum:
+		return append(invalid, ErrorParam{
+			Property: property,
+			Message:  "must be greater than or equal to " + formatNumber(*minimum),
+		})
+	case maximum != nil && value > *maximum:
+		return append(invalid, ErrorParam{
+			Property: property,
+			Message:  "must be less than or equal to " + formatNumber(*maximum),
+		})
+	}
+	return invalid
+}
+
+// indexPath returns the path of an item of a list in the body
+func indexPath(path string, property string, i int) string {
+	return path + property + "[" + strconv.Itoa(i) + "]"
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
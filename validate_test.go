@@ -0,0 +1,130 @@
+package recurly
+
+import (
+	"net/http"
+	"testing"
+)
+
+func assertInvalid(t *T, err error, expected ...ErrorParam) {
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Expected *Error, got %v", err)
+	}
+	t.Assert(e.Type, ErrorTypeValidation, "Error.Type")
+	t.Assert(e.Class, ErrorClassClient, "Error.Class")
+	t.Assert(len(e.Params), len(expected), "len(Error.Params)")
+	for i := range expected {
+		if i < len(e.Params) {
+			t.Assert(e.Params[i], expected[i], "Error.Params")
+		}
+	}
+}
+
+func TestValidateValidRequests(test *testing.T) {
+	t := &T{test}
+	account := &AccountCreate{Code: String("acct-1"), PreferredLocale: PreferredLocaleEnUS.Ptr()}
+	t.Assert(account.Validate(), nil, "AccountCreate.Validate()")
+
+	plan := &PlanCreate{
+		Code:       String("Gold_Plan"),
+		Name:       String("Gold"),
+		Currencies: []PlanPricingCreate{{Currency: String("USD"), UnitAmount: NewAmount("10")}},
+	}
+	t.Assert(plan.Validate(), nil, "PlanCreate.Validate()")
+
+	sub := &SubscriptionCreate{PlanId: String("e28zov4fw0v2"), Currency: String("EUR"), Account: account}
+	t.Assert(sub.Validate(), nil, "SubscriptionCreate.Validate()")
+}
+
+func TestValidateRequiredAndLengths(test *testing.T) {
+	t := &T{test}
+	account := &AccountCreate{
+		Email:   String("a@example.com"),
+		Address: &AddressCreate{Country: String("US")},
+	}
+	assertInvalid(t, account.Validate(), ErrorParam{Property: "code", Message: "can't be blank"})
+
+	account.Code = String("0123456789012345678901234567890123456789012345678901")
+	assertInvalid(t, account.Validate(), ErrorParam{Property: "code", Message: "is too long (maximum is 50 characters)"})
+}
+
+func TestValidatePatternsEnumsAndRanges(test *testing.T) {
+	t := &T{test}
+	plan := &PlanCreate{
+		Code:           String("gold plan"),
+		Name:           String("Gold"),
+		IntervalLength: Int(0),
+		IntervalUnit:   IntervalUnit("weeks").Ptr(),
+		Currencies:     []PlanPricingCreate{{Currency: String("dollars"), UnitAmount: NewAmount("10")}},
+	}
+	assertInvalid(t, plan.Validate(),
+		ErrorParam{Property: "code", Message: "is invalid"},
+		ErrorParam{Property: "interval_unit", Message: `must be one of days, months, got "weeks"`},
+		ErrorParam{Property: "interval_length", Message: "must be greater than or equal to 1"},
+		ErrorParam{Property: "currencies[0].currency", Message: "is too long (maximum is 3 characters)"},
+	)
+}
+
+func TestValidateNestedAndOneOf(test *testing.T) {
+	t := &T{test}
+	sub := &SubscriptionCreate{
+		PlanCode: String("gold"),
+		PlanId:   String("e28zov4fw0v2"),
+		Currency: String("US1"),
+		Account:  &AccountCreate{},
+	}
+	assertInvalid(t, sub.Validate(),
+		ErrorParam{Property: "plan_id", Message: "can't be set with plan_code"},
+		ErrorParam{Property: "account.code", Message: "can't be blank"},
+		ErrorParam{Property: "currency", Message: "is invalid"},
+	)
+
+	sub = &SubscriptionCreate{Currency: String("USD"), Account: &AccountCreate{Code: String("a")}}
+	assertInvalid(t, sub.Validate(), ErrorParam{Property: "plan_code", Message: "can't be blank unless plan_id is set"})
+}
+
+func TestValidateRequestsBeforeSending(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T: t,
+		AssertRequest: func(req *http.Request) {
+			t.Error("Request not expected")
+		},
+		MakeResponse: func(req *http.Request) *http.Response {
+			return mockResponse(req, 201, String(`{"id": "abcd1234"}`))
+		},
+	}
+	client := scenario.MockHTTPClient()
+	client.ValidateRequests = true
+
+	_, err := client.CreateAccount(&AccountCreate{})
+	assertInvalid(t, err, ErrorParam{Property: "code", Message: "can't be blank"})
+
+	_, err = client.CreatePlanAddOn("plan", &AddOnCreate{Code: String("gold")})
+	assertInvalid(t, err,
+		ErrorParam{Property: "name", Message: "can't be blank"},
+		ErrorParam{Property: "currencies", Message: "can't be blank"},
+	)
+
+	// optional bodies of params are validated too
+	_, err = client.CancelSubscription("abcd1234", &CancelSubscriptionParams{
+		Body: &SubscriptionCancel{Timeframe: SubscriptionTimeframe("now").Ptr()},
+	})
+	assertInvalid(t, err, ErrorParam{Property: "timeframe", Message: `must be one of bill_date, term_end, got "now"`})
+}
+
+func TestRequestsAreNotValidatedByDefault(test *testing.T) {
+	t := &T{test}
+	scenario := &Scenario{
+		T:             t,
+		AssertRequest: func(req *http.Request) {},
+		MakeResponse: func(req *http.Request) *http.Response {
+			return mockResponse(req, 201, String(`{"id": "abcd1234"}`))
+		},
+	}
+	client := scenario.MockHTTPClient()
+
+	account, err := client.CreateAccount(&AccountCreate{})
+	t.Assert(err, nil, "Error not expected")
+	t.Assert(account.Id, "abcd1234", "Account.Id")
+}